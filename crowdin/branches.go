@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -24,6 +25,7 @@ type BranchesService struct {
 func (s *BranchesService) List(ctx context.Context, projectID int, opts *model.BranchesListOptions) (
 	[]*model.Branch, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Branches.List")
 	res := new(model.BranchesListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/branches", projectID), opts, res)
 
@@ -35,10 +37,31 @@ func (s *BranchesService) List(ctx context.Context, projectID int, opts *model.B
 	return branches, resp, err
 }
 
+// All returns an iterator over all project branches.
+// It fetches the pages with List until a page shorter than the limit is returned.
+func (s *BranchesService) All(
+	ctx context.Context, projectID int, opts *model.BranchesListOptions,
+) iter.Seq2[*model.Branch, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.Branch, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.List(ctx, projectID, &pageOpts)
+	})
+}
+
+// ListAll returns all project branches by fetching every page with List.
+func (s *BranchesService) ListAll(ctx context.Context, projectID int, opts *model.BranchesListOptions) (
+	[]*model.Branch, error,
+) {
+	return Collect(s.All(ctx, projectID, opts))
+}
+
 // Get returns a single project branch.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.branches.get
 func (s *BranchesService) Get(ctx context.Context, projectID, branchID int) (*model.Branch, *Response, error) {
+	ctx = WithOperationName(ctx, "Branches.Get")
 	res := new(model.BranchesGetResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/branches/%d", projectID, branchID), nil, res)
 
@@ -51,6 +74,7 @@ func (s *BranchesService) Get(ctx context.Context, projectID, branchID int) (*mo
 func (s *BranchesService) Add(ctx context.Context, projectID int, req *model.BranchesAddRequest) (
 	*model.Branch, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Branches.Add")
 	res := new(model.BranchesGetResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/branches", projectID), req, res)
 
@@ -64,10 +88,13 @@ func (s *BranchesService) Add(ctx context.Context, projectID int, req *model.Bra
 // - path: A JSON Pointer as defined in RFC 6901.  Enum: "/name", "/title", "/exportPattern", "/priority".
 // - value: The value to be used within the operations. The value must be one of string.
 //
+// Use model.BranchPatch to build the request with the checked paths.
+//
 // https://developer.crowdin.com/api/v2/#operation/api.projects.branches.patch
 func (s *BranchesService) Edit(ctx context.Context, projectID, branchID int, req []*model.UpdateRequest) (
 	*model.Branch, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Branches.Edit")
 	res := new(model.BranchesGetResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/branches/%d", projectID, branchID), req, res)
 
@@ -78,6 +105,7 @@ func (s *BranchesService) Edit(ctx context.Context, projectID, branchID int, req
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.branches.delete
 func (s *BranchesService) Delete(ctx context.Context, projectID, branchID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Branches.Delete")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/branches/%d", projectID, branchID))
 }
 
@@ -87,6 +115,7 @@ func (s *BranchesService) Delete(ctx context.Context, projectID, branchID int) (
 func (s *BranchesService) Merge(ctx context.Context, projectID, branchID int, req *model.BranchesMergeRequest) (
 	*model.BranchMerge, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Branches.Merge")
 	res := new(model.BranchesMergeResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/branches/%d/merges", projectID, branchID), req, res)
 
@@ -99,18 +128,40 @@ func (s *BranchesService) Merge(ctx context.Context, projectID, branchID int, re
 func (s *BranchesService) CheckMergeStatus(ctx context.Context, projectID, branchID int, mergeID string) (
 	*model.BranchMerge, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Branches.CheckMergeStatus")
 	res := new(model.BranchesMergeResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/branches/%d/merges/%s", projectID, branchID, mergeID), nil, res)
 
 	return res.Data, resp, err
 }
 
+// MergeAndWait merges a project branch and waits until the merge is completed.
+func (s *BranchesService) MergeAndWait(
+	ctx context.Context,
+	projectID, branchID int,
+	req *model.BranchesMergeRequest,
+	opts ...WaitOption,
+) (*model.BranchMerge, error) {
+	return startAndWait(ctx,
+		func() (*model.BranchMerge, *Response, error) {
+			return s.Merge(ctx, projectID, branchID, req)
+		},
+		func(m *model.BranchMerge) PollFunc[*model.BranchMerge] {
+			return func(ctx context.Context) (*model.BranchMerge, *Response, error) {
+				return s.CheckMergeStatus(ctx, projectID, branchID, m.Identifier)
+			}
+		},
+		opts,
+	)
+}
+
 // GetMergeSummary returns a summary of a branch merge.
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.branches.merges.summary.get
 func (s *BranchesService) GetMergeSummary(ctx context.Context, projectID, branchID int, mergeID string) (
 	*model.BranchMergeSummary, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Branches.GetMergeSummary")
 	path := fmt.Sprintf("/api/v2/projects/%d/branches/%d/merges/%s/summary", projectID, branchID, mergeID)
 	res := new(model.BranchesMergeSummaryResponse)
 	resp, err := s.client.Get(ctx, path, nil, res)
@@ -125,6 +176,7 @@ func (s *BranchesService) GetMergeSummary(ctx context.Context, projectID, branch
 func (s *BranchesService) Clone(ctx context.Context, projectID, branchID int, req *model.BranchesCloneRequest) (
 	*model.BranchMerge, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Branches.Clone")
 	path := fmt.Sprintf("/api/v2/projects/%d/branches/%d/clones", projectID, branchID)
 	res := new(model.BranchesMergeResponse)
 	resp, err := s.client.Post(ctx, path, req, res)
@@ -136,6 +188,7 @@ func (s *BranchesService) Clone(ctx context.Context, projectID, branchID int, re
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.branches.clones.branch.get
 func (s *BranchesService) GetClone(ctx context.Context, projectID, branchID int, cloneID string) (*model.Branch, *Response, error) {
+	ctx = WithOperationName(ctx, "Branches.GetClone")
 	path := fmt.Sprintf("/api/v2/projects/%d/branches/%d/clones/%s/branch", projectID, branchID, cloneID)
 	res := new(model.BranchesGetResponse)
 	resp, err := s.client.Get(ctx, path, nil, res)
@@ -149,9 +202,31 @@ func (s *BranchesService) GetClone(ctx context.Context, projectID, branchID int,
 func (s *BranchesService) CheckCloneStatus(ctx context.Context, projectID, branchID int, cloneID string) (
 	*model.BranchMerge, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Branches.CheckCloneStatus")
 	path := fmt.Sprintf("/api/v2/projects/%d/branches/%d/clones/%s", projectID, branchID, cloneID)
 	res := new(model.BranchesMergeResponse)
 	resp, err := s.client.Get(ctx, path, nil, res)
 
 	return res.Data, resp, err
 }
+
+// CloneAndWait clones a project branch and waits until the clone is completed.
+// Use GetClone with the returned identifier to get the cloned branch.
+func (s *BranchesService) CloneAndWait(
+	ctx context.Context,
+	projectID, branchID int,
+	req *model.BranchesCloneRequest,
+	opts ...WaitOption,
+) (*model.BranchMerge, error) {
+	return startAndWait(ctx,
+		func() (*model.BranchMerge, *Response, error) {
+			return s.Clone(ctx, projectID, branchID, req)
+		},
+		func(c *model.BranchMerge) PollFunc[*model.BranchMerge] {
+			return func(ctx context.Context) (*model.BranchMerge, *Response, error) {
+				return s.CheckCloneStatus(ctx, projectID, branchID, c.Identifier)
+			}
+		},
+		opts,
+	)
+}
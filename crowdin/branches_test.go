@@ -51,8 +51,8 @@ func TestBranchesService_List(t *testing.T) {
 			ProjectID:     2,
 			Name:          "develop-master",
 			Title:         "Master branch",
-			CreatedAt:     "2023-09-16T13:48:04+00:00",
-			UpdatedAt:     "2023-09-19T13:25:27+00:00",
+			CreatedAt:     testTime("2023-09-16T13:48:04+00:00"),
+			UpdatedAt:     testTime("2023-09-19T13:25:27+00:00"),
 			ExportPattern: ToPtr("%_three_letters_code%"),
 			Priority:      ToPtr("normal"),
 		},
@@ -111,8 +111,8 @@ func TestBranchesService_ListWithQueryParams(t *testing.T) {
 			ProjectID:     2,
 			Name:          "develop-master",
 			Title:         "Master branch",
-			CreatedAt:     "2023-09-16T13:48:04+00:00",
-			UpdatedAt:     "2023-09-19T13:25:27+00:00",
+			CreatedAt:     testTime("2023-09-16T13:48:04+00:00"),
+			UpdatedAt:     testTime("2023-09-19T13:25:27+00:00"),
 			ExportPattern: ToPtr("%_three_letters_code%"),
 			Priority:      ToPtr("normal"),
 		},
@@ -153,8 +153,8 @@ func TestBranchesService_Get(t *testing.T) {
 		ProjectID:     2,
 		Name:          "develop-master",
 		Title:         "Master branch",
-		CreatedAt:     "2023-09-16T13:48:04+00:00",
-		UpdatedAt:     "2023-09-19T13:25:27+00:00",
+		CreatedAt:     testTime("2023-09-16T13:48:04+00:00"),
+		UpdatedAt:     testTime("2023-09-19T13:25:27+00:00"),
 		ExportPattern: ToPtr("%_three_letters_code%"),
 		Priority:      ToPtr("normal"),
 	}
@@ -227,8 +227,8 @@ func TestBranchesService_Add(t *testing.T) {
 		ProjectID:     2,
 		Name:          "develop-master",
 		Title:         "Master branch",
-		CreatedAt:     "2023-09-16T13:48:04+00:00",
-		UpdatedAt:     "2023-09-19T13:25:27+00:00",
+		CreatedAt:     testTime("2023-09-16T13:48:04+00:00"),
+		UpdatedAt:     testTime("2023-09-19T13:25:27+00:00"),
 		ExportPattern: ToPtr("%_three_letters_code%"),
 		Priority:      ToPtr("normal"),
 	}
@@ -271,8 +271,8 @@ func TestBranchesService_AddWithRequiredBodyParams(t *testing.T) {
 		ProjectID:     2,
 		Name:          "develop-master",
 		Title:         "Master branch",
-		CreatedAt:     "2023-09-16T13:48:04+00:00",
-		UpdatedAt:     "2023-09-19T13:25:27+00:00",
+		CreatedAt:     testTime("2023-09-16T13:48:04+00:00"),
+		UpdatedAt:     testTime("2023-09-19T13:25:27+00:00"),
 		ExportPattern: ToPtr("%_three_letters_code%"),
 		Priority:      ToPtr("normal"),
 	}
@@ -417,10 +417,10 @@ func TestBranchesService_Merge(t *testing.T) {
 			SourceBranchID:   38,
 			DeleteAfterMerge: false,
 		},
-		CreatedAt:  "2023-09-23T11:26:54+00:00",
-		UpdatedAt:  "2023-09-23T11:26:54+00:00",
-		StartedAt:  "2023-09-23T11:26:54+00:00",
-		FinishedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		StartedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		FinishedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	if !reflect.DeepEqual(merge, want) {
 		t.Errorf("Branches.Merge returned %+v, want %+v", merge, want)
@@ -524,10 +524,10 @@ func TestBranchesService_CheckMergeStatus(t *testing.T) {
 			SourceBranchID:   38,
 			DeleteAfterMerge: false,
 		},
-		CreatedAt:  "2023-09-23T11:26:54+00:00",
-		UpdatedAt:  "2023-09-23T11:26:54+00:00",
-		StartedAt:  "2023-09-23T11:26:54+00:00",
-		FinishedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		StartedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		FinishedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	if !reflect.DeepEqual(status, want) {
 		t.Errorf("Branches.CheckMergeStatus returned %+v, want %+v", status, want)
@@ -615,10 +615,10 @@ func TestBranchesService_Clone(t *testing.T) {
 		Identifier: "50fb3506-4127-4ba8-8296-f97dc7e3e0c3",
 		Status:     "finished",
 		Progress:   100,
-		CreatedAt:  "2023-09-23T11:26:54+00:00",
-		UpdatedAt:  "2023-09-23T11:26:54+00:00",
-		StartedAt:  "2023-09-23T11:26:54+00:00",
-		FinishedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		StartedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		FinishedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	if !reflect.DeepEqual(clone, want) {
 		t.Errorf("Branches.Clone returned %+v, want %+v", clone, want)
@@ -712,8 +712,8 @@ func TestBranchesService_GetClone(t *testing.T) {
 		ProjectID: 2,
 		Name:      "develop-master",
 		Title:     "Master branch",
-		CreatedAt: "2023-09-16T13:48:04+00:00",
-		UpdatedAt: "2023-09-19T13:25:27+00:00",
+		CreatedAt: testTime("2023-09-16T13:48:04+00:00"),
+		UpdatedAt: testTime("2023-09-19T13:25:27+00:00"),
 	}
 	if !reflect.DeepEqual(clone, want) {
 		t.Errorf("Branches.GetClone returned %+v, want %+v", clone, want)
@@ -750,10 +750,10 @@ func TestBranchesService_CheckCloneStatus(t *testing.T) {
 		Identifier: "50fb3506-4127-4ba8-8296-f97dc7e3e0c3",
 		Status:     "finished",
 		Progress:   100,
-		CreatedAt:  "2023-09-23T11:26:54+00:00",
-		UpdatedAt:  "2023-09-23T11:26:54+00:00",
-		StartedAt:  "2023-09-23T11:26:54+00:00",
-		FinishedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		StartedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		FinishedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	if !reflect.DeepEqual(status, want) {
 		t.Errorf("Branches.CheckCloneStatus returned %+v, want %+v", status, want)
@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -18,6 +19,7 @@ type BundlesService struct {
 func (s *BundlesService) List(ctx context.Context, projectID int, opts *model.ListOptions) (
 	[]*model.Bundle, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Bundles.List")
 	res := new(model.BundlesListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles", projectID), opts, res)
 	if err != nil {
@@ -32,10 +34,27 @@ func (s *BundlesService) List(ctx context.Context, projectID int, opts *model.Li
 	return list, resp, err
 }
 
+// All returns an iterator over all bundles.
+// It fetches the pages with List until a page shorter than the limit is returned.
+func (s *BundlesService) All(
+	ctx context.Context, projectID int, opts *model.ListOptions,
+) iter.Seq2[*model.Bundle, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, *o, func(ctx context.Context, page model.ListOptions) ([]*model.Bundle, *Response, error) {
+		return s.List(ctx, projectID, &page)
+	})
+}
+
+// ListAll returns all bundles by fetching every page with List.
+func (s *BundlesService) ListAll(ctx context.Context, projectID int, opts *model.ListOptions) ([]*model.Bundle, error) {
+	return Collect(s.All(ctx, projectID, opts))
+}
+
 // Get returns the bundle by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.bundles.get
 func (s *BundlesService) Get(ctx context.Context, projectID, bundleID int) (*model.Bundle, *Response, error) {
+	ctx = WithOperationName(ctx, "Bundles.Get")
 	res := new(model.BundleResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles/%d", projectID, bundleID), nil, res)
 
@@ -48,6 +67,7 @@ func (s *BundlesService) Get(ctx context.Context, projectID, bundleID int) (*mod
 func (s *BundlesService) Add(ctx context.Context, projectID int, req *model.BundleAddRequest) (
 	*model.Bundle, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Bundles.Add")
 	res := new(model.BundleResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles", projectID), req, res)
 
@@ -68,6 +88,7 @@ func (s *BundlesService) Add(ctx context.Context, projectID int, req *model.Bund
 func (s *BundlesService) Edit(ctx context.Context, projectID, bundleID int, req []*model.UpdateRequest) (
 	*model.Bundle, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Bundles.Edit")
 	res := new(model.BundleResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles/%d", projectID, bundleID), req, res)
 
@@ -78,6 +99,7 @@ func (s *BundlesService) Edit(ctx context.Context, projectID, bundleID int, req
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.bundles.delete
 func (s *BundlesService) Delete(ctx context.Context, projectID, bundleID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Bundles.Delete")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles/%d", projectID, bundleID))
 }
 
@@ -87,6 +109,7 @@ func (s *BundlesService) Delete(ctx context.Context, projectID, bundleID int) (*
 func (s *BundlesService) Download(ctx context.Context, projectID, bundleID int, exportID string) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Bundles.Download")
 	res := new(model.DownloadLinkResponse)
 	path := fmt.Sprintf("/api/v2/projects/%d/bundles/%d/exports/%s/download", projectID, bundleID, exportID)
 	resp, err := s.client.Get(ctx, path, nil, res)
@@ -100,6 +123,7 @@ func (s *BundlesService) Download(ctx context.Context, projectID, bundleID int,
 func (s *BundlesService) Export(ctx context.Context, projectID, bundleID int) (
 	*model.BundleExport, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Bundles.Export")
 	res := new(model.BundleExportResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles/%d/exports", projectID, bundleID), "", res)
 
@@ -112,6 +136,7 @@ func (s *BundlesService) Export(ctx context.Context, projectID, bundleID int) (
 func (s *BundlesService) CheckExportStatus(ctx context.Context, projectID, bundleID int, exportID string) (
 	*model.BundleExport, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Bundles.CheckExportStatus")
 	res := new(model.BundleExportResponse)
 	path := fmt.Sprintf("/api/v2/projects/%d/bundles/%d/exports/%s", projectID, bundleID, exportID)
 	resp, err := s.client.Get(ctx, path, nil, res)
@@ -125,6 +150,7 @@ func (s *BundlesService) CheckExportStatus(ctx context.Context, projectID, bundl
 func (s *BundlesService) ListFiles(ctx context.Context, projectID, bundleID int, opts *model.ListOptions) (
 	[]*model.File, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Bundles.ListFiles")
 	res := new(model.FileListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles/%d/files", projectID, bundleID), opts, res)
 	if err != nil {
@@ -139,12 +165,31 @@ func (s *BundlesService) ListFiles(ctx context.Context, projectID, bundleID int,
 	return list, resp, err
 }
 
+// AllFiles returns an iterator over all files included in the bundle.
+// It fetches the pages with ListFiles until a page shorter than the limit is returned.
+func (s *BundlesService) AllFiles(
+	ctx context.Context, projectID, bundleID int, opts *model.ListOptions,
+) iter.Seq2[*model.File, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, *o, func(ctx context.Context, page model.ListOptions) ([]*model.File, *Response, error) {
+		return s.ListFiles(ctx, projectID, bundleID, &page)
+	})
+}
+
+// ListAllFiles returns all files included in the bundle by fetching every page with ListFiles.
+func (s *BundlesService) ListAllFiles(ctx context.Context, projectID, bundleID int, opts *model.ListOptions) (
+	[]*model.File, error,
+) {
+	return Collect(s.AllFiles(ctx, projectID, bundleID, opts))
+}
+
 // ListBranches returns a list of branches included in the bundle.
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.bundles.branches.getMany
 func (s *BundlesService) ListBranches(ctx context.Context, projectID, bundleID int, opts *model.ListOptions) (
 	[]*model.Branch, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Bundles.ListBranches")
 	res := new(model.BranchesListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles/%d/branches", projectID, bundleID), opts, res)
 	if err != nil {
@@ -158,3 +203,21 @@ func (s *BundlesService) ListBranches(ctx context.Context, projectID, bundleID i
 
 	return list, resp, err
 }
+
+// AllBranches returns an iterator over all branches included in the bundle.
+// It fetches the pages with ListBranches until a page shorter than the limit is returned.
+func (s *BundlesService) AllBranches(
+	ctx context.Context, projectID, bundleID int, opts *model.ListOptions,
+) iter.Seq2[*model.Branch, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, *o, func(ctx context.Context, page model.ListOptions) ([]*model.Branch, *Response, error) {
+		return s.ListBranches(ctx, projectID, bundleID, &page)
+	})
+}
+
+// ListAllBranches returns all branches included in the bundle by fetching every page with ListBranches.
+func (s *BundlesService) ListAllBranches(ctx context.Context, projectID, bundleID int, opts *model.ListOptions) (
+	[]*model.Branch, error,
+) {
+	return Collect(s.AllBranches(ctx, projectID, bundleID, opts))
+}
@@ -57,8 +57,8 @@ func TestBundlesService_Get(t *testing.T) {
 		IncludeProjectSourceLanguage: false,
 		LabelIDs:                     []int{13, 27},
 		ExcludeLabelIDs:              []int{5, 8},
-		CreatedAt:                    "2023-09-20T11:11:05+00:00",
-		UpdatedAt:                    "2023-09-20T12:22:20+00:00",
+		CreatedAt:                    testTime("2023-09-20T11:11:05+00:00"),
+		UpdatedAt:                    testTime("2023-09-20T12:22:20+00:00"),
 	}
 	assert.Equal(t, expected, bundle)
 }
@@ -139,8 +139,8 @@ func TestBundlesService_List(t *testing.T) {
 			IncludeProjectSourceLanguage: false,
 			LabelIDs:                     []int{13, 27},
 			ExcludeLabelIDs:              []int{5, 8},
-			CreatedAt:                    "2023-09-20T11:11:05+00:00",
-			UpdatedAt:                    "2023-09-20T12:22:20+00:00",
+			CreatedAt:                    testTime("2023-09-20T11:11:05+00:00"),
+			UpdatedAt:                    testTime("2023-09-20T12:22:20+00:00"),
 		},
 	}
 	assert.Equal(t, expected, bundle)
@@ -218,8 +218,8 @@ func TestBundlesService_Add(t *testing.T) {
 		IncludeProjectSourceLanguage: false,
 		LabelIDs:                     []int{13, 27},
 		ExcludeLabelIDs:              []int{5, 8},
-		CreatedAt:                    "2023-09-20T11:11:05+00:00",
-		UpdatedAt:                    "2023-09-20T12:22:20+00:00",
+		CreatedAt:                    testTime("2023-09-20T11:11:05+00:00"),
+		UpdatedAt:                    testTime("2023-09-20T12:22:20+00:00"),
 	}
 	assert.Equal(t, expected, bundle)
 }
@@ -311,8 +311,8 @@ func TestBundlesService_Edit(t *testing.T) {
 		IncludeProjectSourceLanguage: false,
 		LabelIDs:                     []int{13, 27},
 		ExcludeLabelIDs:              []int{5, 8},
-		CreatedAt:                    "2023-09-20T11:11:05+00:00",
-		UpdatedAt:                    "2023-09-20T12:22:20+00:00",
+		CreatedAt:                    testTime("2023-09-20T11:11:05+00:00"),
+		UpdatedAt:                    testTime("2023-09-20T12:22:20+00:00"),
 	}
 	assert.Equal(t, expected, bundle)
 }
@@ -355,10 +355,10 @@ func TestBundlesService_Export(t *testing.T) {
 		Attributes: struct {
 			BundleID int `json:"bundleId"`
 		}{BundleID: 38},
-		CreatedAt:  "2023-09-23T11:26:54+00:00",
-		UpdatedAt:  "2023-09-23T11:26:54+00:00",
-		StartedAt:  "2023-09-23T11:26:54+00:00",
-		FinishedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		StartedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		FinishedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	assert.Equal(t, expected, export)
 }
@@ -402,7 +402,7 @@ func TestBundlesService_Download(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, resp)
 	assert.Equal(t, "https://production-enterprise-importer.downloads.crowdin.com/992000002/2/14.xliff", downloadLink.URL)
-	assert.Equal(t, "2023-09-20T10:31:21+00:00", downloadLink.ExpireIn)
+	assert.Equal(t, "2023-09-20T10:31:21+00:00", downloadLink.ExpireIn.String())
 }
 
 func TestBundlesService_CheckExportStatus(t *testing.T) {
@@ -442,10 +442,10 @@ func TestBundlesService_CheckExportStatus(t *testing.T) {
 		Attributes: struct {
 			BundleID int `json:"bundleId"`
 		}{BundleID: 38},
-		CreatedAt:  "2023-09-23T11:26:54+00:00",
-		UpdatedAt:  "2023-09-23T11:26:54+00:00",
-		StartedAt:  "2023-09-23T11:26:54+00:00",
-		FinishedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		StartedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		FinishedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	assert.Equal(t, expected, status)
 }
@@ -544,16 +544,16 @@ func TestBundlesService_ListBranches(t *testing.T) {
 			ProjectID: 2,
 			Name:      "develop-master",
 			Title:     "Master branch",
-			CreatedAt: "2023-09-16T13:48:04+00:00",
-			UpdatedAt: "2023-09-19T13:25:27+00:00",
+			CreatedAt: testTime("2023-09-16T13:48:04+00:00"),
+			UpdatedAt: testTime("2023-09-19T13:25:27+00:00"),
 		},
 		{
 			ID:        36,
 			ProjectID: 2,
 			Name:      "develop-master-2",
 			Title:     "Test branch",
-			CreatedAt: "2023-09-16T13:48:04+00:00",
-			UpdatedAt: "2023-09-19T13:25:27+00:00",
+			CreatedAt: testTime("2023-09-16T13:48:04+00:00"),
+			UpdatedAt: testTime("2023-09-19T13:25:27+00:00"),
 		},
 	}
 	assert.Equal(t, expected, branches)
@@ -0,0 +1,425 @@
+package crowdin
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultCacheTTL is the lifetime of the cached responses if no TTL is set.
+const defaultCacheTTL = time.Hour
+
+// Cache is a storage of the cached API responses. Implementations must be
+// safe for concurrent use. The package provides the in-memory LRU cache
+// (NewMemoryCache) and the on-disk cache (NewDiskCache).
+//
+// Keys are the request URLs. A cache should not be shared by clients
+// with the access tokens of different users.
+type Cache interface {
+	// Get returns the entry of the key.
+	Get(key string) (*CacheEntry, bool)
+	// Set stores the entry of the key.
+	Set(key string, entry *CacheEntry)
+	// Delete deletes the entry of the key.
+	Delete(key string)
+	// DeleteFunc deletes the entries whose keys match.
+	DeleteFunc(match func(key string) bool)
+}
+
+// CacheEntry is a cached API response.
+type CacheEntry struct {
+	// ETag of the response.
+	ETag string `json:"etag"`
+	// Body of the response.
+	Body []byte `json:"body"`
+	// Expires is the time after which the entry is not used.
+	Expires time.Time `json:"expires"`
+}
+
+// CacheOption configures the response cache.
+type CacheOption func(*responseCache) error
+
+// WithCacheTTL sets the lifetime of the cached responses. Default: 1 hour.
+func WithCacheTTL(ttl time.Duration) CacheOption {
+	return func(c *responseCache) error {
+		if ttl <= 0 {
+			return errors.New("cache: TTL must be positive")
+		}
+		c.ttl = ttl
+		return nil
+	}
+}
+
+// WithEndpointTTL sets the lifetime of the cached responses of the endpoint.
+// The pattern is the API path, where the segments in braces match any value:
+//
+//	crowdin.WithEndpointTTL("/api/v2/projects/{projectId}/languages/progress", time.Minute)
+//
+// A zero TTL disables the caching of the endpoint. The first matching
+// pattern is used.
+func WithEndpointTTL(pattern string, ttl time.Duration) CacheOption {
+	return func(c *responseCache) error {
+		if !strings.HasPrefix(pattern, "/") {
+			return fmt.Errorf("cache: invalid pattern %q: must start with a slash", pattern)
+		}
+		if ttl < 0 {
+			return errors.New("cache: TTL cannot be negative")
+		}
+		c.endpoints = append(c.endpoints, endpointTTL{segments: strings.Split(pattern, "/"), ttl: ttl})
+		return nil
+	}
+}
+
+// WithCache enables the caching of the GET responses with ETags.
+//
+// The responses with an ETag header are stored in the cache. Subsequent
+// requests to the same URL are sent with the If-None-Match header, and if
+// the API responds with 304 Not Modified, the cached body is decoded instead,
+// and the Response is returned as 200 OK with FromCache set. Requests are
+// always revalidated, so the cache saves the transfer of the unchanged bodies.
+//
+// A successful POST, PUT, PATCH or DELETE request invalidates the cached
+// responses of the resource, its parent collections and its subresources,
+// e.g. editing the project 1 invalidates "/api/v2/projects",
+// "/api/v2/projects/1" and "/api/v2/projects/1/languages/progress".
+//
+// Example:
+//
+//	client, err := crowdin.NewClient("token", crowdin.WithCache(crowdin.NewMemoryCache(1000),
+//		crowdin.WithEndpointTTL("/api/v2/projects/{projectId}/languages/progress", 5*time.Minute),
+//	))
+func WithCache(cache Cache, opts ...CacheOption) ClientOption {
+	return func(c *Client) error {
+		if cache == nil {
+			return errors.New("cache cannot be nil")
+		}
+
+		rc := &responseCache{backend: cache, ttl: defaultCacheTTL, now: time.Now}
+		for _, opt := range opts {
+			if err := opt(rc); err != nil {
+				return err
+			}
+		}
+
+		c.cache = rc
+		return nil
+	}
+}
+
+// endpointTTL is the lifetime of the cached responses of the endpoint.
+type endpointTTL struct {
+	segments []string
+	ttl      time.Duration
+}
+
+// match reports whether the API path matches the endpoint pattern.
+func (e endpointTTL) match(path string) bool {
+	segments := strings.Split(path, "/")
+	if len(segments) != len(e.segments) {
+		return false
+	}
+	for i, s := range e.segments {
+		if (!strings.HasPrefix(s, "{") || !strings.HasSuffix(s, "}")) && s != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// responseCache caches the API responses in the backend.
+type responseCache struct {
+	backend   Cache
+	ttl       time.Duration
+	endpoints []endpointTTL
+
+	now func() time.Time
+}
+
+// lookup returns the valid cached entry of the GET request and sets its
+// ETag to the If-None-Match header. `path` is the API path of the request.
+func (c *responseCache) lookup(r *http.Request, path string) *CacheEntry {
+	if c == nil || r.Method != http.MethodGet || c.ttlOf(path) == 0 {
+		return nil
+	}
+
+	key := r.URL.String()
+	entry, ok := c.backend.Get(key)
+	if !ok {
+		return nil
+	}
+	if !c.now().Before(entry.Expires) {
+		c.backend.Delete(key)
+		return nil
+	}
+
+	r.Header.Set("If-None-Match", entry.ETag)
+	return entry
+}
+
+// update stores the response of the GET request, extends the lifetime of
+// the revalidated entry or invalidates the entries of the mutated resource.
+func (c *responseCache) update(r *http.Request, path string, resp *http.Response, body []byte) {
+	if c == nil || resp.StatusCode >= http.StatusBadRequest {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		etag := resp.Header.Get("ETag")
+		if etag == "" && resp.StatusCode == http.StatusNotModified {
+			etag = r.Header.Get("If-None-Match")
+		}
+		ttl := c.ttlOf(path)
+		if ttl == 0 || etag == "" || (resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotModified) {
+			return
+		}
+		c.backend.Set(r.URL.String(), &CacheEntry{ETag: etag, Body: body, Expires: c.now().Add(ttl)})
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		c.invalidate(r.URL)
+	}
+}
+
+// invalidate deletes the entries of the resource, its ancestors and descendants.
+func (c *responseCache) invalidate(u *url.URL) {
+	resource := strings.TrimSuffix(u.Path, "/")
+	c.backend.DeleteFunc(func(key string) bool {
+		cached, err := url.Parse(key)
+		if err != nil {
+			return true
+		}
+		if cached.Scheme != u.Scheme || cached.Host != u.Host {
+			return false
+		}
+		path := strings.TrimSuffix(cached.Path, "/")
+		return path == resource || strings.HasPrefix(resource, path+"/") || strings.HasPrefix(path, resource+"/")
+	})
+}
+
+// ttlOf returns the lifetime of the cached responses of the API path.
+func (c *responseCache) ttlOf(path string) time.Duration {
+	for _, e := range c.endpoints {
+		if e.match(path) {
+			return e.ttl
+		}
+	}
+	return c.ttl
+}
+
+// MemoryCache is an in-memory cache which evicts the least recently used
+// entries when it is full.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List
+	items      map[string]*list.Element
+}
+
+// memoryItem is an element of the LRU list.
+type memoryItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+var _ Cache = (*MemoryCache)(nil)
+
+// NewMemoryCache returns an in-memory LRU cache of at most `maxEntries` entries.
+// If maxEntries is zero or negative, the number of entries is not limited.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+// Get returns the entry of the key and marks it as recently used.
+func (m *MemoryCache) Get(key string) (*CacheEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.items[key]
+	if !ok {
+		return nil, false
+	}
+	m.ll.MoveToFront(e)
+	return itemOf(e).entry, true
+}
+
+// Set stores the entry of the key and evicts the least recently used
+// entry if the cache is full.
+func (m *MemoryCache) Set(key string, entry *CacheEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if e, ok := m.items[key]; ok {
+		itemOf(e).entry = entry
+		m.ll.MoveToFront(e)
+		return
+	}
+
+	m.items[key] = m.ll.PushFront(&memoryItem{key: key, entry: entry})
+	if m.maxEntries > 0 && m.ll.Len() > m.maxEntries {
+		m.remove(m.ll.Back())
+	}
+}
+
+// Delete deletes the entry of the key.
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if e, ok := m.items[key]; ok {
+		m.remove(e)
+	}
+}
+
+// DeleteFunc deletes the entries whose keys match.
+func (m *MemoryCache) DeleteFunc(match func(key string) bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key, e := range m.items {
+		if match(key) {
+			m.remove(e)
+		}
+	}
+}
+
+// Len returns the number of entries in the cache.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.ll.Len()
+}
+
+// itemOf returns the item of the list element.
+func itemOf(e *list.Element) *memoryItem {
+	item, _ := e.Value.(*memoryItem)
+	return item
+}
+
+func (m *MemoryCache) remove(e *list.Element) {
+	m.ll.Remove(e)
+	delete(m.items, itemOf(e).key)
+}
+
+// DiskCache is a cache which stores the entries as files in a directory,
+// so they are kept between the runs of the program. Errors of the file
+// system are ignored, i.e. the entries which cannot be read or written
+// are cache misses.
+type DiskCache struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// diskEntry is the file content of a cache entry.
+type diskEntry struct {
+	Key string `json:"key"`
+	*CacheEntry
+}
+
+var _ Cache = (*DiskCache)(nil)
+
+// NewDiskCache returns a cache which stores the entries in the directory.
+// The directory is created if it does not exist.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if dir == "" {
+		return nil, errors.New("cache: directory cannot be empty")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("cache: %w", err)
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+// Get returns the entry of the key.
+func (d *DiskCache) Get(key string) (*CacheEntry, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	e, err := d.read(d.path(key))
+	if err != nil || e.Key != key {
+		return nil, false
+	}
+	return e.CacheEntry, true
+}
+
+// Set stores the entry of the key.
+func (d *DiskCache) Set(key string, entry *CacheEntry) {
+	data, err := json.Marshal(diskEntry{Key: key, CacheEntry: entry})
+	if err != nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// Write to a temporary file first, so the entry is never read partially written.
+	tmp, err := os.CreateTemp(d.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(data)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), d.path(key))
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
+
+// Delete deletes the entry of the key.
+func (d *DiskCache) Delete(key string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	_ = os.Remove(d.path(key))
+}
+
+// DeleteFunc deletes the entries whose keys match.
+func (d *DiskCache) DeleteFunc(match func(key string) bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	files, err := filepath.Glob(filepath.Join(d.dir, "*.json"))
+	if err != nil {
+		return
+	}
+	for _, file := range files {
+		if e, err := d.read(file); err == nil && match(e.Key) {
+			_ = os.Remove(file)
+		}
+	}
+}
+
+// path returns the file path of the key.
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (d *DiskCache) read(path string) (*diskEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	e := &diskEntry{CacheEntry: new(CacheEntry)}
+	if err := json.Unmarshal(data, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
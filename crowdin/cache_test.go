@@ -0,0 +1,236 @@
+package crowdin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// etagHandler serves the project with its version as the ETag
+// and counts the responses with a body.
+func etagHandler(t *testing.T, version *int, full *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		etag := fmt.Sprintf(`"v%d"`, *version)
+		w.Header().Set("ETag", etag)
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		*full++
+		fmt.Fprintf(w, `{"data": {"id": 1, "name": "App v%d"}}`, *version)
+	}
+}
+
+func TestWithCache(t *testing.T) {
+	cache := NewMemoryCache(10)
+	client, mux, teardown := setupClient(WithCache(cache))
+	defer teardown()
+
+	version, full := 1, 0
+	mux.HandleFunc("/api/v2/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			version++
+			fmt.Fprint(w, `{"data": {"id": 1}}`)
+			return
+		}
+		etagHandler(t, &version, &full)(w, r)
+	})
+
+	project, resp, err := client.Projects.Get(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.False(t, resp.FromCache)
+	assert.Equal(t, "App v1", project.Name)
+	assert.Equal(t, 1, cache.Len())
+
+	project, resp, err = client.Projects.Get(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.True(t, resp.FromCache)
+	assert.Equal(t, "App v1", project.Name)
+	assert.Equal(t, 1, full)
+
+	// The mutation invalidates the cached project.
+	_, _, err = client.Projects.Edit(context.Background(), 1, []*model.UpdateRequest{{Op: "replace", Path: "/name", Value: "App"}})
+	require.NoError(t, err)
+	assert.Equal(t, 0, cache.Len())
+
+	project, resp, err = client.Projects.Get(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "App v2", project.Name)
+	assert.Equal(t, 2, full)
+}
+
+func TestWithCache_TTL(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewMemoryCache(0)
+	client, mux, teardown := setupClient(WithCache(cache,
+		WithCacheTTL(time.Hour),
+		WithEndpointTTL("/api/v2/projects/{projectId}", time.Minute),
+		WithEndpointTTL("/api/v2/storages/{storageId}", 0),
+	))
+	defer teardown()
+	client.cache.now = func() time.Time { return now }
+
+	version, full := 1, 0
+	mux.HandleFunc("/api/v2/projects/1", etagHandler(t, &version, &full))
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `"storage"`)
+		fmt.Fprint(w, `{"data": {"id": 1}}`)
+	})
+
+	for range 2 {
+		_, _, err := client.Storages.Get(context.Background(), 1)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 0, cache.Len(), "the endpoint is not cached")
+
+	_, _, err := client.Projects.Get(context.Background(), 1)
+	require.NoError(t, err)
+
+	now = now.Add(59 * time.Second)
+	_, resp, err := client.Projects.Get(context.Background(), 1)
+	require.NoError(t, err)
+	assert.True(t, resp.FromCache)
+
+	// The revalidated entry lives for another minute.
+	now = now.Add(59 * time.Second)
+	_, resp, err = client.Projects.Get(context.Background(), 1)
+	require.NoError(t, err)
+	assert.True(t, resp.FromCache)
+
+	now = now.Add(time.Minute)
+	_, resp, err = client.Projects.Get(context.Background(), 1)
+	require.NoError(t, err)
+	assert.False(t, resp.FromCache)
+	assert.Equal(t, 2, full)
+}
+
+func TestWithCache_Options(t *testing.T) {
+	tests := []struct {
+		opts        []CacheOption
+		expectedErr string
+	}{
+		{opts: []CacheOption{WithCacheTTL(0)}, expectedErr: "cache: TTL must be positive"},
+		{opts: []CacheOption{WithEndpointTTL("api/v2/projects", time.Minute)}, expectedErr: `cache: invalid pattern "api/v2/projects": must start with a slash`},
+		{opts: []CacheOption{WithEndpointTTL("/api/v2/projects", -time.Minute)}, expectedErr: "cache: TTL cannot be negative"},
+	}
+	for _, tt := range tests {
+		_, err := NewClient("token", WithCache(NewMemoryCache(1), tt.opts...))
+		assert.EqualError(t, err, tt.expectedErr)
+	}
+
+	_, err := NewClient("token", WithCache(nil))
+	assert.EqualError(t, err, "cache cannot be nil")
+}
+
+func TestResponseCache_Invalidate(t *testing.T) {
+	cache := NewMemoryCache(0)
+	keys := []string{
+		"https://api.crowdin.com/api/v2/projects?limit=25",
+		"https://api.crowdin.com/api/v2/projects/1",
+		"https://api.crowdin.com/api/v2/projects/1/languages/progress",
+		"https://api.crowdin.com/api/v2/projects/1/files",
+		"https://api.crowdin.com/api/v2/projects/12",
+		"https://acme.api.crowdin.com/api/v2/projects/1",
+	}
+	for _, key := range keys {
+		cache.Set(key, &CacheEntry{ETag: `"1"`})
+	}
+
+	u, err := url.Parse("https://api.crowdin.com/api/v2/projects/1/languages")
+	require.NoError(t, err)
+	c := &responseCache{backend: cache}
+	c.invalidate(u)
+
+	var left []string
+	for _, key := range keys {
+		if _, ok := cache.Get(key); ok {
+			left = append(left, key)
+		}
+	}
+	expected := []string{
+		"https://api.crowdin.com/api/v2/projects/1/files",
+		"https://api.crowdin.com/api/v2/projects/12",
+		"https://acme.api.crowdin.com/api/v2/projects/1",
+	}
+	assert.Equal(t, expected, left)
+}
+
+func TestMemoryCache_LRU(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", &CacheEntry{ETag: "a"})
+	cache.Set("b", &CacheEntry{ETag: "b"})
+	_, ok := cache.Get("a")
+	require.True(t, ok)
+
+	cache.Set("c", &CacheEntry{ETag: "c"})
+	assert.Equal(t, 2, cache.Len())
+
+	_, ok = cache.Get("b")
+	assert.False(t, ok, "the least recently used entry is evicted")
+	_, ok = cache.Get("a")
+	assert.True(t, ok)
+
+	cache.Delete("a")
+	_, ok = cache.Get("a")
+	assert.False(t, ok)
+}
+
+func TestDiskCache(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := NewDiskCache(dir)
+	require.NoError(t, err)
+
+	expires := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache.Set("https://api.crowdin.com/api/v2/projects/1", &CacheEntry{ETag: `"v1"`, Body: []byte(`{"data":{}}`), Expires: expires})
+	cache.Set("https://api.crowdin.com/api/v2/projects/2", &CacheEntry{ETag: `"v2"`})
+
+	// The entries are kept by a new cache of the directory.
+	cache, err = NewDiskCache(dir)
+	require.NoError(t, err)
+
+	entry, ok := cache.Get("https://api.crowdin.com/api/v2/projects/1")
+	require.True(t, ok)
+	assert.Equal(t, &CacheEntry{ETag: `"v1"`, Body: []byte(`{"data":{}}`), Expires: expires}, entry)
+
+	cache.DeleteFunc(func(key string) bool { return key == "https://api.crowdin.com/api/v2/projects/1" })
+	_, ok = cache.Get("https://api.crowdin.com/api/v2/projects/1")
+	assert.False(t, ok)
+
+	cache.Delete("https://api.crowdin.com/api/v2/projects/2")
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+
+	_, err = NewDiskCache("")
+	require.EqualError(t, err, "cache: directory cannot be empty")
+}
+
+func TestDiskCache_Client(t *testing.T) {
+	cache, err := NewDiskCache(t.TempDir())
+	require.NoError(t, err)
+	client, mux, teardown := setupClient(WithCache(cache))
+	defer teardown()
+
+	version, full := 1, 0
+	mux.HandleFunc("/api/v2/projects/1", etagHandler(t, &version, &full))
+
+	for range 3 {
+		project, _, err := client.Projects.Get(context.Background(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, "App v1", project.Name)
+	}
+	assert.Equal(t, 1, full)
+}
@@ -0,0 +1,120 @@
+// Package cassette provides an http.RoundTripper which records HTTP
+// interactions to a file (a cassette) and replays them later, so tests
+// of code using crowdin.Client can run deterministically with no network.
+//
+// Record the interactions once against the real API:
+//
+//	rec, err := cassette.New("testdata/sync.json", cassette.WithMode(cassette.ModeRecord))
+//	...
+//	defer rec.Stop() // saves the cassette
+//
+//	client, err := crowdin.NewClient(token, crowdin.WithHTTPClient(&http.Client{Transport: rec}))
+//
+// and replay them in CI with the default ModeReplay. The Authorization header,
+// the access token and token-like JSON fields are scrubbed from the cassette.
+// Requests are matched on the method, path, query and the normalized JSON
+// body; a request with no matching interaction fails with ErrNoInteraction.
+package cassette
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+)
+
+// Cassette is a list of recorded HTTP interactions.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded HTTP request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded HTTP request.
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   Body        `json:"body,omitempty"`
+}
+
+// Response is a recorded HTTP response.
+type Response struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       Body        `json:"body,omitempty"`
+}
+
+// Body is a recorded request or response body. It is stored as a string
+// if it is valid UTF-8 and as a base64-encoded string otherwise.
+type Body []byte
+
+// MarshalJSON implements the json.Marshaler interface.
+func (b Body) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(string(b))
+	}
+	return json.Marshal(map[string]string{"base64": base64.StdEncoding.EncodeToString(b)})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (b *Body) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*b = Body(s)
+		return nil
+	}
+
+	var encoded struct {
+		Base64 string `json:"base64"`
+	}
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded.Base64)
+	if err != nil {
+		return err
+	}
+	*b = decoded
+
+	return nil
+}
+
+// Load reads the cassette from the file.
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cassette: %w", err)
+	}
+
+	c := new(Cassette)
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("cassette: error decoding %s: %w", path, err)
+	}
+
+	return c, nil
+}
+
+// Save writes the cassette to the file, creating the parent directories.
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+
+	return nil
+}
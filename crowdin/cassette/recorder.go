@@ -0,0 +1,419 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin"
+)
+
+// ErrNoInteraction is returned by the recorder in replay mode when no
+// recorded interaction matches the request, or all matching interactions
+// have already been replayed.
+var ErrNoInteraction = errors.New("cassette: no matching interaction")
+
+// Mode defines whether the recorder records or replays the interactions.
+type Mode int
+
+const (
+	// ModeReplay replays the interactions from the cassette
+	// and never sends requests. It is the default mode.
+	ModeReplay Mode = iota
+	// ModeRecord sends the requests and records the interactions.
+	// The cassette is overwritten when the recorder is stopped.
+	ModeRecord
+	// ModeReplayOrRecord replays the interactions if the cassette
+	// exists and records them otherwise.
+	ModeReplayOrRecord
+)
+
+// Option configures a Recorder.
+type Option func(*Recorder)
+
+// WithMode sets the recorder mode. Default: ModeReplay.
+func WithMode(mode Mode) Option {
+	return func(r *Recorder) {
+		r.mode = mode
+	}
+}
+
+// WithTransport sets the transport used to send the requests in the
+// record mode. Default: http.DefaultTransport.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = rt
+	}
+}
+
+// WithHostRewrite replaces the host in the recorded URLs, headers and bodies,
+// e.g. to store the interactions with an organization under a neutral name:
+//
+//	cassette.WithHostRewrite("acme.api.crowdin.com", "org.api.crowdin.com")
+//
+// Request hosts are not matched on replay, so the cassette can be replayed
+// by a client configured with any organization.
+// Request bodies are rewritten before they are matched, so pass the same
+// option when replaying a cassette whose requests contain the host.
+func WithHostRewrite(from, to string) Option {
+	return func(r *Recorder) {
+		r.hosts = append(r.hosts, from, to)
+	}
+}
+
+// WithScrubFields adds the names of JSON fields and query parameters whose
+// values are scrubbed from the cassette. Names are case-insensitive.
+// The secret fields (see crowdin.SecretFields), e.g. "accessToken", "password"
+// or "credentials", are always scrubbed.
+func WithScrubFields(names ...string) Option {
+	return func(r *Recorder) {
+		for _, name := range names {
+			r.fields = append(r.fields, strings.ToLower(name))
+		}
+	}
+}
+
+// Recorder is an http.RoundTripper which records or replays HTTP interactions.
+// It is safe for concurrent use.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	hosts     []string
+	fields    []string
+
+	mu       sync.Mutex
+	cassette *Cassette
+	replayed []bool
+	secrets  []string
+}
+
+// New returns a recorder of the cassette file. In the replay mode
+// the cassette is loaded and must exist.
+func New(path string, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		transport: http.DefaultTransport,
+		fields:    crowdin.SecretFields(),
+		cassette:  new(Cassette),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.mode == ModeReplayOrRecord {
+		r.mode = ModeRecord
+		if _, err := os.Stat(path); err == nil {
+			r.mode = ModeReplay
+		}
+	}
+
+	if r.mode == ModeReplay {
+		c, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = c
+		r.replayed = make([]bool, len(c.Interactions))
+	}
+
+	return r, nil
+}
+
+// Mode returns the mode of the recorder. ModeReplayOrRecord is resolved
+// to ModeReplay or ModeRecord when the recorder is created.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Stop saves the recorded interactions to the cassette in the record mode.
+// In the replay mode it does nothing.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, i := range r.cassette.Interactions {
+		r.scrubSecrets(i)
+	}
+	return r.cassette.Save(r.path)
+}
+
+// Unreplayed returns the recorded interactions which have not been replayed,
+// e.g. to check that a test made all expected requests.
+func (r *Recorder) Unreplayed() []*Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var list []*Interaction
+	for i, replayed := range r.replayed {
+		if !replayed {
+			list = append(list, r.cassette.Interactions[i])
+		}
+	}
+	return list
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, fmt.Errorf("cassette: error reading request body: %w", err)
+	}
+
+	if r.mode == ModeRecord {
+		return r.record(req, body)
+	}
+	return r.replay(req, body)
+}
+
+// record sends the request and stores the interaction.
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	out := req.Clone(req.Context())
+	if body != nil {
+		out.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := r.transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("cassette: error reading response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := &Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    r.scrubURL(req.URL),
+			Header: r.scrubHeader(req.Header, "Authorization", "Cookie"),
+			Body:   r.scrubBody(body),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     r.scrubHeader(resp.Header, "Set-Cookie"),
+			Body:       r.scrubBody(respBody),
+		},
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer "); ok && token != "" &&
+		!slices.Contains(r.secrets, token) {
+		r.secrets = append(r.secrets, token)
+	}
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+
+	return resp, nil
+}
+
+// replay returns the response of the first matching interaction
+// which has not been replayed yet.
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	query := r.scrubQuery(req.URL.Query())
+	// The recorded bodies contain the rewritten hosts.
+	normalized := r.normalize([]byte(r.rewrite(string(body))))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || !r.matches(interaction, req, query, normalized) {
+			continue
+		}
+		r.replayed[i] = true
+
+		resp := interaction.Response
+		header := resp.Header.Clone()
+		if header.Get("Content-Length") != "" {
+			// The body length changes if any value was scrubbed.
+			header.Set("Content-Length", strconv.Itoa(len(resp.Body)))
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode)),
+			StatusCode:    resp.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(resp.Body)),
+			ContentLength: int64(len(resp.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, req.Method, req.URL.RequestURI())
+}
+
+// matches reports whether the interaction was recorded for the request
+// with the scrubbed query and the normalized body.
+func (r *Recorder) matches(i *Interaction, req *http.Request, query url.Values, body []byte) bool {
+	if i.Request.Method != req.Method {
+		return false
+	}
+
+	u, err := url.Parse(i.Request.URL)
+	if err != nil || u.Path != req.URL.Path {
+		return false
+	}
+	if !reflect.DeepEqual(r.scrubQuery(u.Query()), query) {
+		return false
+	}
+
+	return bytes.Equal(r.normalize(i.Request.Body), body)
+}
+
+// normalize returns the JSON body with sorted keys and scrubbed fields,
+// or the body unchanged if it is not JSON.
+func (r *Recorder) normalize(body []byte) []byte {
+	var v any
+	if len(body) == 0 || json.Unmarshal(body, &v) != nil {
+		return body
+	}
+	r.redact(v)
+	data, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return data
+}
+
+// scrubURL returns the URL with the rewritten host and scrubbed query parameters.
+func (r *Recorder) scrubURL(u *url.URL) string {
+	scrubbed := *u
+	scrubbed.Host = r.rewrite(u.Host)
+	if u.RawQuery != "" {
+		scrubbed.RawQuery = r.scrubQuery(u.Query()).Encode()
+	}
+	return scrubbed.String()
+}
+
+// scrubQuery returns the query with the values of the scrubbed parameters redacted.
+func (r *Recorder) scrubQuery(query url.Values) url.Values {
+	for key, values := range query {
+		if slices.Contains(r.fields, strings.ToLower(key)) {
+			for i := range values {
+				values[i] = crowdin.RedactedValue
+			}
+		}
+	}
+	return query
+}
+
+// scrubHeader returns a copy of the header without the given keys
+// and with the hosts rewritten.
+func (r *Recorder) scrubHeader(h http.Header, keys ...string) http.Header {
+	scrubbed := h.Clone()
+	for _, key := range keys {
+		scrubbed.Del(key)
+	}
+	for key, values := range scrubbed {
+		for i, v := range values {
+			values[i] = r.rewrite(v)
+		}
+		scrubbed[key] = values
+	}
+	if len(scrubbed) == 0 {
+		return nil
+	}
+	return scrubbed
+}
+
+// scrubBody returns the body with the hosts rewritten and, if it is JSON,
+// the values of the scrubbed fields redacted. The formatting of the JSON
+// body is kept if there is nothing to redact.
+func (r *Recorder) scrubBody(body []byte) Body {
+	body = []byte(r.rewrite(string(body)))
+
+	var v any
+	if len(body) == 0 || json.Unmarshal(body, &v) != nil || !r.redact(v) {
+		return body
+	}
+	scrubbed, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return scrubbed
+}
+
+// redact replaces the values of the scrubbed fields in the decoded JSON value.
+// It reports whether any value was replaced.
+func (r *Recorder) redact(v any) bool {
+	redacted := false
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if slices.Contains(r.fields, strings.ToLower(key)) {
+				v[key] = crowdin.RedactedValue
+				redacted = true
+			} else if r.redact(value) {
+				redacted = true
+			}
+		}
+	case []any:
+		for _, value := range v {
+			if r.redact(value) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
+
+// scrubSecrets replaces the access tokens seen in the requests
+// everywhere in the interaction.
+func (r *Recorder) scrubSecrets(i *Interaction) {
+	replace := func(s string) string {
+		for _, secret := range r.secrets {
+			s = strings.ReplaceAll(s, secret, crowdin.RedactedValue)
+		}
+		return s
+	}
+
+	i.Request.URL = replace(i.Request.URL)
+	i.Request.Body = Body(replace(string(i.Request.Body)))
+	i.Response.Body = Body(replace(string(i.Response.Body)))
+	for _, h := range []http.Header{i.Request.Header, i.Response.Header} {
+		for _, values := range h {
+			for j, v := range values {
+				values[j] = replace(v)
+			}
+		}
+	}
+}
+
+// rewrite replaces the hosts set with WithHostRewrite.
+func (r *Recorder) rewrite(s string) string {
+	for i := 0; i < len(r.hosts); i += 2 {
+		s = strings.ReplaceAll(s, r.hosts[i], r.hosts[i+1])
+	}
+	return s
+}
+
+// readBody reads and closes the request body.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	defer req.Body.Close()
+
+	return io.ReadAll(req.Body)
+}
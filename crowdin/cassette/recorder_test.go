@@ -0,0 +1,292 @@
+package cassette
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin"
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newClient returns a Crowdin client sending requests through the recorder.
+func newClient(t *testing.T, rec *Recorder, token, baseURL string) *crowdin.Client {
+	t.Helper()
+
+	client, err := crowdin.NewClient(token,
+		crowdin.WithHTTPClient(&http.Client{Transport: rec}),
+		crowdin.WithBaseURL(baseURL),
+	)
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestRecorder_RecordReplay(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/projects/1", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"data": {"id": 1, "name": "App"}}`)
+	})
+	mux.HandleFunc("POST /api/v2/projects", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name": "Web", "sourceLanguageId": "en"}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"data": {"id": 2, "name": "Web"}}`)
+	})
+	server := httptest.NewServer(mux)
+	path := filepath.Join(t.TempDir(), "cassettes", "projects.json")
+
+	// Record.
+	rec, err := New(path, WithMode(ModeRecord))
+	require.NoError(t, err)
+	client := newClient(t, rec, "secret-token", server.URL)
+
+	project, _, err := client.Projects.Get(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "App", project.Name)
+
+	_, _, err = client.Projects.Add(context.Background(), &model.ProjectsAddRequest{Name: "Web", SourceLanguageID: "en"})
+	require.NoError(t, err)
+	require.NoError(t, rec.Stop())
+	server.Close()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secret-token")
+	assert.NotContains(t, string(data), "Authorization")
+
+	// Replay with the server closed.
+	rec, err = New(path)
+	require.NoError(t, err)
+	assert.Equal(t, ModeReplay, rec.Mode())
+	client = newClient(t, rec, "another-token", server.URL)
+
+	project, _, err = client.Projects.Add(context.Background(), &model.ProjectsAddRequest{Name: "Web", SourceLanguageID: "en"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, project.ID)
+
+	project, _, err = client.Projects.Get(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "App", project.Name)
+	assert.Empty(t, rec.Unreplayed())
+
+	// Each interaction is replayed once.
+	_, _, err = client.Projects.Get(context.Background(), 1)
+	require.ErrorIs(t, err, ErrNoInteraction)
+	assert.Contains(t, err.Error(), "GET /api/v2/projects/1")
+
+	_, _, err = client.Projects.Add(context.Background(), &model.ProjectsAddRequest{Name: "Other", SourceLanguageID: "en"})
+	require.ErrorIs(t, err, ErrNoInteraction)
+}
+
+func TestRecorder_Match(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	c := &Cassette{Interactions: []*Interaction{
+		{
+			Request: Request{
+				Method: http.MethodPost,
+				URL:    "https://api.crowdin.com/api/v2/projects/1/strings?limit=10&offset=0",
+				Body:   Body(`{"text": "Hello", "fileId": 1, "labelIds": [1, 2]}`),
+			},
+			Response: Response{StatusCode: http.StatusCreated, Body: Body(`{"data": {"id": 1}}`)},
+		},
+	}}
+	require.NoError(t, c.Save(path))
+
+	tests := []struct {
+		name    string
+		method  string
+		url     string
+		body    string
+		matches bool
+	}{
+		{
+			name:    "normalized JSON body and query",
+			method:  http.MethodPost,
+			url:     "https://acme.api.crowdin.com/api/v2/projects/1/strings?offset=0&limit=10",
+			body:    `{"labelIds":[1,2],"fileId":1,"text":"Hello"}`,
+			matches: true,
+		},
+		{
+			name:   "different method",
+			method: http.MethodPut,
+			url:    "https://api.crowdin.com/api/v2/projects/1/strings?limit=10&offset=0",
+			body:   `{"text": "Hello", "fileId": 1, "labelIds": [1, 2]}`,
+		},
+		{
+			name:   "different path",
+			method: http.MethodPost,
+			url:    "https://api.crowdin.com/api/v2/projects/2/strings?limit=10&offset=0",
+			body:   `{"text": "Hello", "fileId": 1, "labelIds": [1, 2]}`,
+		},
+		{
+			name:   "different query",
+			method: http.MethodPost,
+			url:    "https://api.crowdin.com/api/v2/projects/1/strings?limit=25&offset=0",
+			body:   `{"text": "Hello", "fileId": 1, "labelIds": [1, 2]}`,
+		},
+		{
+			name:   "different body",
+			method: http.MethodPost,
+			url:    "https://api.crowdin.com/api/v2/projects/1/strings?limit=10&offset=0",
+			body:   `{"text": "Hello", "fileId": 1, "labelIds": [2, 1]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, err := New(path)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(tt.method, tt.url, strings.NewReader(tt.body))
+			require.NoError(t, err)
+
+			resp, err := rec.RoundTrip(req)
+			if !tt.matches {
+				require.ErrorIs(t, err, ErrNoInteraction)
+				return
+			}
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusCreated, resp.StatusCode)
+			assert.Equal(t, "201 Created", resp.Status)
+			assert.JSONEq(t, `{"data": {"id": 1}}`, string(body))
+		})
+	}
+}
+
+func TestRecorder_Scrub(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Set-Cookie", "session=abc")
+		w.Header().Set("Link", "<https://"+r.Host+"/api/v2/projects?offset=25>; rel=next")
+		fmt.Fprintf(w, `{"data": {"webUrl": "https://acme.crowdin.com/project/app", "echo": %q,
+			"auth": {"accessToken": "at", "refresh_token": "rt", "sessionId": "sid"}, "credentials": {"apiKey": "key"}}}`, r.Header.Get("Authorization"))
+	}))
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	rec, err := New(path,
+		WithMode(ModeReplayOrRecord),
+		WithHostRewrite("acme.crowdin.com", "org.crowdin.com"),
+		WithHostRewrite(host, "api.example.com"),
+		WithScrubFields("sessionId"),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, ModeRecord, rec.Mode())
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/api/v2/projects?access_token=query-token", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer header-token")
+
+	resp, err := (&http.Client{Transport: rec}).Do(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Contains(t, string(body), `"accessToken": "at"`, "the response must not be scrubbed")
+
+	require.NoError(t, rec.Stop())
+
+	c, err := Load(path)
+	require.NoError(t, err)
+	require.Len(t, c.Interactions, 1)
+
+	i := c.Interactions[0]
+	assert.Equal(t, "http://api.example.com/api/v2/projects?access_token=%5BREDACTED%5D", i.Request.URL)
+	assert.Empty(t, i.Request.Header.Get("Authorization"))
+	assert.Empty(t, i.Response.Header.Get("Set-Cookie"))
+	assert.Equal(t, "<https://api.example.com/api/v2/projects?offset=25>; rel=next", i.Response.Header.Get("Link"))
+	assert.JSONEq(t, `{"data": {"webUrl": "https://org.crowdin.com/project/app", "echo": "Bearer [REDACTED]",
+		"auth": {"accessToken": "[REDACTED]", "refresh_token": "[REDACTED]", "sessionId": "[REDACTED]"},
+		"credentials": "[REDACTED]"}}`,
+		string(i.Response.Body))
+
+	// The cassette exists now, so it is replayed.
+	rec, err = New(path, WithMode(ModeReplayOrRecord))
+	require.NoError(t, err)
+	assert.Equal(t, ModeReplay, rec.Mode())
+	assert.Len(t, rec.Unreplayed(), 1)
+}
+
+func TestRecorder_ReplayHostRewrite(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"data": {"id": 1}}`)
+	}))
+	host := strings.TrimPrefix(server.URL, "http://")
+	body := `{"name": "Hook", "url": "` + server.URL + `/hooks"}`
+
+	send := func(rec *Recorder) (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/api/v2/projects/1/webhooks", strings.NewReader(body))
+		require.NoError(t, err)
+		return (&http.Client{Transport: rec}).Do(req)
+	}
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	rec, err := New(path, WithMode(ModeRecord), WithHostRewrite(host, "api.example.com"))
+	require.NoError(t, err)
+	resp, err := send(rec)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.NoError(t, rec.Stop())
+	server.Close()
+
+	c, err := Load(path)
+	require.NoError(t, err)
+	require.Len(t, c.Interactions, 1)
+	assert.JSONEq(t, `{"name": "Hook", "url": "http://api.example.com/hooks"}`, string(c.Interactions[0].Request.Body))
+
+	rec, err = New(path, WithHostRewrite(host, "api.example.com"))
+	require.NoError(t, err)
+	resp, err = send(rec)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Empty(t, rec.Unreplayed())
+}
+
+func TestRecorder_BinaryBody(t *testing.T) {
+	content := []byte{0x50, 0x4b, 0x03, 0x04, 0xff, 0xfe}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	rec, err := New(path, WithMode(ModeRecord))
+	require.NoError(t, err)
+
+	resp, err := (&http.Client{Transport: rec}).Get(server.URL + "/build.zip")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.NoError(t, rec.Stop())
+
+	rec, err = New(path)
+	require.NoError(t, err)
+
+	resp, err = (&http.Client{Transport: rec}).Get(server.URL + "/build.zip")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, content, body)
+}
+
+func TestNew_MissingCassette(t *testing.T) {
+	_, err := New(filepath.Join(t.TempDir(), "missing.json"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
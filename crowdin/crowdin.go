@@ -9,12 +9,17 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
 
 const (
 	baseURL = "https://api.crowdin.com/"
+	// apiDomain is the domain of the Crowdin API hosts. Organization
+	// hosts of Crowdin Enterprise are its subdomains.
+	apiDomain = "crowdin.com"
 
 	userAgent = "crowdin-api-client-go/0.3.0"
 )
@@ -23,9 +28,15 @@ const (
 type Client struct {
 	baseURL      *url.URL
 	token        string
+	tokenSource  *cachingTokenSource
 	organization string
 	userAgent    string
 	httpClient   *http.Client
+	retryPolicy  *RetryPolicy
+	rateLimiter  *rateLimiter
+	cache        *responseCache
+	middleware   []Middleware
+	doer         Doer
 
 	Storages                  *StorageService
 	Languages                 *LanguagesService
@@ -48,10 +59,15 @@ type Client struct {
 	Tasks                     *TasksService
 	Reports                   *ReportsService
 	Dictionaries              *DictionariesService
+	Webhooks                  *WebhooksService
+	OrganizationWebhooks      *OrganizationWebhooksService
+	Workflows                 *WorkflowsService
+	Distributions             *DistributionsService
 }
 
 // NewClient creates a new Crowdin API client with provided options (ex. WithHTTPClient).
-// `token` is a personal access token. To create a client, use the following code:
+// `token` is a personal access token. It can be empty if the WithTokenSource
+// option is used. To create a client, use the following code:
 //
 //	client, err := crowdin.NewClient("token")
 //
@@ -59,9 +75,6 @@ type Client struct {
 //
 //	client, err := crowdin.NewClient("token", crowdin.WithOrganization("organization"))
 func NewClient(token string, opts ...ClientOption) (*Client, error) {
-	if token == "" {
-		return nil, errors.New("token cannot be empty")
-	}
 	u, _ := url.Parse(baseURL)
 	c := &Client{
 		token:     token,
@@ -75,12 +88,17 @@ func NewClient(token string, opts ...ClientOption) (*Client, error) {
 		}
 	}
 
+	if c.token == "" && c.tokenSource == nil {
+		return nil, errors.New("token cannot be empty")
+	}
 	if c.httpClient == nil {
 		c.httpClient = http.DefaultClient
 	}
-	if c.organization != "" {
+	if c.organization != "" && isOrganizationHost(c.baseURL.Hostname()) &&
+		!strings.HasPrefix(c.baseURL.Host, c.organization+".") {
 		c.baseURL.Host = fmt.Sprintf("%s.%s", c.organization, c.baseURL.Host)
 	}
+	c.doer = chain(DoerFunc(c.execute), c.middleware)
 
 	// Initialize services.
 	c.Storages = &StorageService{client: c}
@@ -104,6 +122,10 @@ func NewClient(token string, opts ...ClientOption) (*Client, error) {
 	c.Tasks = &TasksService{client: c}
 	c.Reports = &ReportsService{client: c}
 	c.Dictionaries = &DictionariesService{client: c}
+	c.Webhooks = &WebhooksService{client: c}
+	c.OrganizationWebhooks = &OrganizationWebhooksService{client: c}
+	c.Workflows = &WorkflowsService{client: c}
+	c.Distributions = &DistributionsService{client: c}
 
 	return c, nil
 }
@@ -119,6 +141,45 @@ func WithOrganization(organization string) ClientOption {
 	}
 }
 
+// isEnterprise reports whether the client was created
+// with the WithOrganization option.
+func (c *Client) isEnterprise() bool {
+	return c.organization != ""
+}
+
+// WithBaseURL sets the base URL of the API, e.g. a regional endpoint, a proxy
+// or a local server for tests. If not set, https://api.crowdin.com/ is used.
+//
+// The URL can have a path prefix (e.g. "https://proxy.example.com/crowdin/"),
+// which is prepended to the paths of all API requests.
+//
+// The organization set with WithOrganization is prepended to the host only if
+// the host is a Crowdin domain that does not already start with the organization
+// name, so both "https://api.crowdin.com" and "https://my-org.api.crowdin.com"
+// result in "https://my-org.api.crowdin.com". Other hosts are used as is.
+func WithBaseURL(rawURL string) ClientOption {
+	return func(c *Client) error {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return fmt.Errorf("invalid base URL: %w", err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid base URL %q: must be an absolute HTTP(S) URL", rawURL)
+		}
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+		}
+		c.baseURL = u
+		return nil
+	}
+}
+
+// isOrganizationHost reports whether the organization name should be
+// prepended to the host.
+func isOrganizationHost(host string) bool {
+	return host == apiDomain || strings.HasSuffix(host, "."+apiDomain)
+}
+
 // WithHTTPClient sets the custom HTTP client. If not set http.DefaultClient will be used.
 func WithHTTPClient(hc *http.Client) ClientOption {
 	return func(c *Client) error {
@@ -140,30 +201,71 @@ func Header(key, value string) RequestOption {
 	}
 }
 
+// ContentLength sets the length of the request body as an option for the request.
+// A negative value means that the length is unknown. If the length of the body
+// is already known (e.g. the body is an io.ReadSeeker), the values must match.
+func ContentLength(n int64) RequestOption {
+	return func(r *http.Request) error {
+		if n < 0 {
+			return nil
+		}
+
+		known := r.Body == nil || r.Body == http.NoBody || r.ContentLength > 0
+		if known && n != r.ContentLength {
+			return fmt.Errorf("content length %d does not match the body length %d", n, r.ContentLength)
+		}
+		r.ContentLength = n
+		return nil
+	}
+}
+
 // newRequest creates a new HTTP request with the provided method, path and body (if any).
+// If the body is an io.Reader, it is sent as is. Otherwise, it is encoded as JSON.
 func (c *Client) newRequest(ctx context.Context, method, path string, body any, opts ...RequestOption) (*http.Request, error) {
 	rel, err := url.Parse(path)
 	if err != nil {
 		return nil, err
 	}
+	if prefix := strings.TrimSuffix(c.baseURL.Path, "/"); prefix != "" && rel.Host == "" && strings.HasPrefix(rel.Path, "/") {
+		// Keep the path prefix of the base URL.
+		rel.Path = prefix + rel.Path
+		if rel.RawPath != "" {
+			rel.RawPath = strings.TrimSuffix(c.baseURL.EscapedPath(), "/") + rel.RawPath
+		}
+	}
 	u := c.baseURL.ResolveReference(rel)
 
-	var buf io.ReadWriter
-	if body != nil && body != "" {
-		buf = new(bytes.Buffer)
-		err := json.NewEncoder(buf).Encode(body)
-		if err != nil {
-			return nil, err
+	var (
+		buf    io.Reader
+		isJSON bool
+	)
+	switch b := body.(type) {
+	case io.Reader:
+		buf = b
+	default:
+		if body != nil && body != "" {
+			jsonBuf := new(bytes.Buffer)
+			if err := json.NewEncoder(jsonBuf).Encode(body); err != nil {
+				return nil, err
+			}
+			buf, isJSON = jsonBuf, true
 		}
 	}
+
 	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
+	if rs, ok := buf.(io.ReadSeeker); ok && req.GetBody == nil {
+		// Allow the body to be replayed on retries.
+		setSeekableBody(req, rs)
+	}
 
 	req.Header.Set("User-Agent", c.userAgent)
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
-	if body != nil && body != "" {
+	if err := c.authorize(req); err != nil {
+		return nil, err
+	}
+	if isJSON {
 		req.Header.Set("Content-Type", "application/json")
 	}
 
@@ -176,8 +278,58 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body any,
 	return req, nil
 }
 
-// do sends an API request and returns the API response.
+// do sends an API request through the middleware chain and returns the API response.
 func (c *Client) do(r *http.Request, v any) (*Response, error) {
+	return c.doer.Do(r, v)
+}
+
+// execute sends an API request and returns the API response.
+// If a rate limit is set, the request waits for the limiter before every attempt.
+// If a retry policy is set, the request is retried on failures.
+// If a token source is set, the request is retried once with a new token
+// when the token is rejected.
+func (c *Client) execute(r *http.Request, v any) (*Response, error) {
+	reauthorized := false
+	for attempt := 1; ; attempt++ {
+		if err := c.rateLimiter.wait(r.Context()); err != nil {
+			return nil, err
+		}
+
+		resp, err := c.send(r, v)
+		c.rateLimiter.update(resp)
+
+		if resp != nil && resp.StatusCode == http.StatusUnauthorized && !reauthorized {
+			reauthorized = true
+			if next, ok := c.reauthorize(r); ok {
+				r = next
+				continue
+			}
+		}
+
+		delay, ok := c.retryPolicy.retryDelay(r, resp, err, attempt)
+		if !ok {
+			return resp, err
+		}
+		if deadline, has := r.Context().Deadline(); has && time.Until(deadline) < delay {
+			return resp, err
+		}
+		if serr := sleep(r.Context(), delay); serr != nil {
+			return resp, err
+		}
+
+		next, rerr := rewindRequest(r)
+		if rerr != nil {
+			return resp, err
+		}
+		r = next
+	}
+}
+
+// send sends a single API request and decodes the API response.
+func (c *Client) send(r *http.Request, v any) (*Response, error) {
+	path := strings.TrimPrefix(r.URL.Path, strings.TrimSuffix(c.baseURL.Path, "/"))
+	cached := c.cache.lookup(r, path)
+
 	resp, err := c.httpClient.Do(r)
 	if err != nil {
 		return nil, err
@@ -194,6 +346,20 @@ func (c *Client) do(r *http.Request, v any) (*Response, error) {
 	if err != nil {
 		return response, fmt.Errorf("client: error reading response body: %w", err)
 	}
+	// The length is unknown for chunked or compressed responses until the body is read.
+	resp.ContentLength = int64(len(body))
+
+	revalidated := resp.StatusCode == http.StatusNotModified && cached != nil
+	if revalidated {
+		body = cached.Body
+	}
+	c.cache.update(r, path, resp, body)
+	if revalidated {
+		// The cached response is returned as the response of the server.
+		resp.StatusCode, resp.Status = http.StatusOK, "200 OK"
+		resp.ContentLength = int64(len(body))
+		response.FromCache = true
+	}
 
 	if resp.StatusCode == http.StatusNoContent {
 		return response, nil
@@ -217,6 +383,50 @@ func (c *Client) do(r *http.Request, v any) (*Response, error) {
 	return response, err
 }
 
+// rewindRequest returns a copy of the request with a fresh body,
+// so it can be sent again.
+func rewindRequest(r *http.Request) (*http.Request, error) {
+	req := r.Clone(r.Context())
+	if r.GetBody != nil {
+		body, err := r.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = body
+	}
+	return req, nil
+}
+
+// setSeekableBody sets the request body to the provided io.ReadSeeker with
+// a known content length. The body is not closed by the transport and can be
+// replayed by seeking back to its current position.
+// Bodies that cannot be seeked (e.g. pipes) are sent as a stream only once.
+func setSeekableBody(r *http.Request, rs io.ReadSeeker) {
+	offset, err := rs.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return
+	}
+	end, err := rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return
+	}
+	if _, err := rs.Seek(offset, io.SeekStart); err != nil {
+		return
+	}
+
+	r.ContentLength = end - offset
+	r.Body = io.NopCloser(rs)
+	if r.ContentLength == 0 {
+		r.Body = http.NoBody
+	}
+	r.GetBody = func() (io.ReadCloser, error) {
+		if _, err := rs.Seek(offset, io.SeekStart); err != nil {
+			return nil, err
+		}
+		return io.NopCloser(rs), nil
+	}
+}
+
 // RequestValidator is an interface for validating requests.
 type RequestValidator interface {
 	Validate() error
@@ -334,7 +544,8 @@ func handleErrorResponse(r *http.Response, body []byte) error {
 	}
 
 	if err := json.Unmarshal(body, errorResponse); err != nil {
-		return fmt.Errorf("client: server returned %d status code", r.StatusCode)
+		// The body is not an API error (e.g. an error page of a proxy).
+		return model.NewStatusErrorResponse(r)
 	}
 	return errorResponse
 }
@@ -344,6 +555,10 @@ type Response struct {
 	*http.Response
 
 	Pagination model.Pagination
+	// FromCache reports whether the response body was read from the cache
+	// (see WithCache) after the server responded with 304 Not Modified.
+	// The status code of such responses is 200 OK.
+	FromCache bool
 }
 
 // populatePagination reads the pagination information from the response
@@ -7,18 +7,19 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
-	"net/url"
 	"reflect"
 	"testing"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-func setupClient() (client *Client, mux *http.ServeMux, teardown func()) {
+func setupClient(opts ...ClientOption) (client *Client, mux *http.ServeMux, teardown func()) {
 	mux = http.NewServeMux()
 	server := httptest.NewServer(mux)
 
-	client, _ = NewClient("access_token", WithOrganization("demo"))
-	url, _ := url.Parse(server.URL)
-	client.baseURL = url
+	client, _ = NewClient("access_token", append([]ClientOption{WithOrganization("demo"), WithBaseURL(server.URL)}, opts...)...)
 
 	return client, mux, server.Close
 }
@@ -37,6 +38,15 @@ func testURL(t *testing.T, r *http.Request, want string) {
 	}
 }
 
+// testTime returns the API timestamp as model.Time.
+func testTime(s string) model.Time {
+	t, err := model.ParseTime(s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
 func testBody(t *testing.T, r *http.Request, want string) {
 	t.Helper()
 	buf := new(bytes.Buffer)
@@ -148,6 +158,81 @@ func TestNewEnterpriseClient(t *testing.T) {
 	testClientServices(t, c)
 }
 
+func TestWithBaseURL(t *testing.T) {
+	tests := []struct {
+		name         string
+		baseURL      string
+		organization string
+		want         string
+	}{
+		{
+			name:    "custom host",
+			baseURL: "http://localhost:8080",
+			want:    "http://localhost:8080/",
+		},
+		{
+			name:         "proxy with organization",
+			baseURL:      "https://proxy.example.com/crowdin/",
+			organization: "demo",
+			want:         "https://proxy.example.com/crowdin/",
+		},
+		{
+			name:         "crowdin host with organization",
+			baseURL:      "https://api.crowdin.com",
+			organization: "demo",
+			want:         "https://demo.api.crowdin.com/",
+		},
+		{
+			name:         "organization host",
+			baseURL:      "https://demo.api.crowdin.com/",
+			organization: "demo",
+			want:         "https://demo.api.crowdin.com/",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The order of the options should not matter.
+			for _, opts := range [][]ClientOption{
+				{WithBaseURL(tt.baseURL), WithOrganization(tt.organization)},
+				{WithOrganization(tt.organization), WithBaseURL(tt.baseURL)},
+			} {
+				c, err := NewClient("token", opts...)
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, c.baseURL.String())
+			}
+		})
+	}
+}
+
+func TestWithBaseURL_PathPrefix(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/crowdin/api/v2/storages/1", func(w http.ResponseWriter, r *http.Request) {
+		testURL(t, r, "/crowdin/api/v2/storages/1")
+		fmt.Fprint(w, `{"data": {"id": 1}}`)
+	})
+
+	client, err := NewClient("token", WithBaseURL(server.URL+"/crowdin"))
+	require.NoError(t, err)
+
+	storage, _, err := client.Storages.Get(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, storage.ID)
+}
+
+func TestWithBaseURL_Invalid(t *testing.T) {
+	for _, rawURL := range []string{"", "api.crowdin.com", "ftp://api.crowdin.com", "http://"} {
+		_, err := NewClient("token", WithBaseURL(rawURL))
+		assert.EqualError(t, err, fmt.Sprintf("invalid base URL %q: must be an absolute HTTP(S) URL", rawURL))
+	}
+
+	_, err := NewClient("token", WithBaseURL("http://[::1"))
+	assert.ErrorContains(t, err, "invalid base URL: ")
+}
+
 func TestWithCustomHTTPClient(t *testing.T) {
 	c, err := NewClient("token", WithHTTPClient(http.DefaultClient))
 	if err != nil {
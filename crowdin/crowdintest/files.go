@@ -0,0 +1,723 @@
+package crowdintest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+// fileType returns the file type detected by the file extension.
+func fileType(name string) string {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".json":
+		return "json"
+	case ".yml", ".yaml":
+		return "yaml"
+	case ".xml":
+		return "xml"
+	case ".properties":
+		return "properties"
+	case ".po", ".pot":
+		return "gettext"
+	case ".csv":
+		return "csv"
+	case ".md":
+		return "md"
+	case ".txt":
+		return "txt"
+	}
+	return "auto"
+}
+
+// file is a source file with the content of all its revisions.
+type file struct {
+	model.File
+	revisions [][]byte
+}
+
+// sourceString is a string parsed from the file content.
+type sourceString struct {
+	identifier string
+	text       string
+}
+
+// parseStrings returns the strings of the file content. Only the JSON files
+// are parsed: the nested keys are joined with dots, e.g. "menu.file.open".
+func parseStrings(fileType string, content []byte) ([]sourceString, error) {
+	if fileType != "json" {
+		return nil, nil
+	}
+
+	var v any
+	dec := json.NewDecoder(bytes.NewReader(content))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	var strs []sourceString
+	var walk func(prefix string, v any)
+	walk = func(prefix string, v any) {
+		join := func(key string) string {
+			if prefix == "" {
+				return key
+			}
+			return prefix + "." + key
+		}
+
+		switch v := v.(type) {
+		case map[string]any:
+			for _, key := range slices.Sorted(maps.Keys(v)) {
+				walk(join(key), v[key])
+			}
+		case []any:
+			for i, item := range v {
+				walk(join(strconv.Itoa(i)), item)
+			}
+		case string:
+			if v != "" {
+				strs = append(strs, sourceString{identifier: prefix, text: v})
+			}
+		case json.Number:
+			strs = append(strs, sourceString{identifier: prefix, text: v.String()})
+		case bool:
+			strs = append(strs, sourceString{identifier: prefix, text: strconv.FormatBool(v)})
+		}
+	}
+	walk("", v)
+
+	return strs, nil
+}
+
+func (s *Server) registerSourceFiles(mux *http.ServeMux) {
+	s.handle(mux, "POST /api/v2/projects/{projectId}/directories", s.addDirectory)
+	s.handle(mux, "GET /api/v2/projects/{projectId}/directories", s.listDirectories)
+	s.handle(mux, "GET /api/v2/projects/{projectId}/directories/{directoryId}", s.getDirectory)
+	s.handle(mux, "PATCH /api/v2/projects/{projectId}/directories/{directoryId}", s.editDirectory)
+	s.handle(mux, "DELETE /api/v2/projects/{projectId}/directories/{directoryId}", s.deleteDirectory)
+
+	s.handle(mux, "POST /api/v2/projects/{projectId}/files", s.addFile)
+	s.handle(mux, "GET /api/v2/projects/{projectId}/files", s.listFiles)
+	s.handle(mux, "GET /api/v2/projects/{projectId}/files/{fileId}", s.getFile)
+	s.handle(mux, "PUT /api/v2/projects/{projectId}/files/{fileId}", s.updateFile)
+	s.handle(mux, "PATCH /api/v2/projects/{projectId}/files/{fileId}", s.editFile)
+	s.handle(mux, "DELETE /api/v2/projects/{projectId}/files/{fileId}", s.deleteFile)
+	s.handle(mux, "GET /api/v2/projects/{projectId}/files/{fileId}/download", s.downloadFile)
+
+	// The download links are not authorized, like the links of the real API.
+	mux.HandleFunc("GET /_crowdintest/files/{fileId}/revisions/{revisionId}", s.serveFile)
+}
+
+func (s *Server) addDirectory(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Name          string `json:"name"`
+		DirectoryID   int    `json:"directoryId"`
+		Title         string `json:"title"`
+		ExportPattern string `json:"exportPattern"`
+		Priority      string `json:"priority"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if err := validateName(req.Name); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	parent, err := s.parentDirectory(project.ID, req.DirectoryID)
+	if err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := s.uniqueName(project.ID, parent, req.Name, 0, 0); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	priority := req.Priority
+	if priority == "" {
+		priority = "normal"
+	}
+
+	created := now()
+	dir := s.directories.add(func(id int) *model.Directory {
+		return &model.Directory{
+			ID:            id,
+			ProjectID:     project.ID,
+			DirectoryID:   parent,
+			Name:          req.Name,
+			Title:         req.Title,
+			ExportPattern: req.ExportPattern,
+			Path:          s.directoryPath(parent) + "/" + req.Name,
+			Priority:      priority,
+			CreatedAt:     created,
+			UpdatedAt:     created,
+		}
+	})
+	writeData(w, http.StatusCreated, dir)
+}
+
+func (s *Server) listDirectories(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+
+	parent := queryInt(r, "directoryId")
+	recursive := r.URL.Query().Has("recursion")
+	filter := r.URL.Query().Get("filter")
+	writeList(w, r, s.directories.list(func(d *model.Directory) bool {
+		return d.ProjectID == project.ID &&
+			s.inDirectory(d.DirectoryID, parent, recursive) &&
+			strings.Contains(d.Name, filter)
+	}))
+}
+
+func (s *Server) getDirectory(w http.ResponseWriter, r *http.Request) {
+	dir, ok := s.findDirectory(w, r)
+	if !ok {
+		return
+	}
+	writeData(w, http.StatusOK, dir)
+}
+
+func (s *Server) editDirectory(w http.ResponseWriter, r *http.Request) {
+	dir, ok := s.findDirectory(w, r)
+	if !ok {
+		return
+	}
+
+	var ops []*model.UpdateRequest
+	if !decode(w, r, &ops) {
+		return
+	}
+
+	edited := *dir
+	if err := patch(&edited, ops, "id", "projectId", "branchId", "directoryId", "path", "createdAt", "updatedAt"); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := validateName(edited.Name); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := s.uniqueName(dir.ProjectID, dir.DirectoryID, edited.Name, dir.ID, 0); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	edited.UpdatedAt = now()
+	*dir = edited
+	s.refreshPaths(dir.ProjectID)
+
+	writeData(w, http.StatusOK, dir)
+}
+
+func (s *Server) deleteDirectory(w http.ResponseWriter, r *http.Request) {
+	dir, ok := s.findDirectory(w, r)
+	if !ok {
+		return
+	}
+
+	for _, f := range s.files.list(nil) {
+		if f.ProjectID == dir.ProjectID && s.inDirectory(f.DirectoryID, dir.ID, true) {
+			s.removeFile(f)
+		}
+	}
+	nested := s.directories.list(func(d *model.Directory) bool {
+		return d.ProjectID == dir.ProjectID && s.inDirectory(d.DirectoryID, dir.ID, true)
+	})
+	for _, d := range nested {
+		s.directories.delete(d.ID)
+	}
+	s.directories.delete(dir.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) addFile(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		StorageID      int            `json:"storageId"`
+		Name           string         `json:"name"`
+		DirectoryID    int            `json:"directoryId"`
+		Title          string         `json:"title"`
+		Context        string         `json:"context"`
+		Type           string         `json:"type"`
+		AttachLabelIDs []int          `json:"attachLabelIds"`
+		Fields         map[string]any `json:"fields"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	stor, err := s.findStorageByID(req.StorageID)
+	if err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := validateName(req.Name); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	parent, err := s.parentDirectory(project.ID, req.DirectoryID)
+	if err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := s.uniqueName(project.ID, parent, req.Name, 0, 0); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := s.validLabels(project.ID, req.AttachLabelIDs); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	typ := req.Type
+	if typ == "" || typ == "auto" {
+		typ = fileType(req.Name)
+	}
+	strs, parseErr := parseStrings(typ, stor.content)
+	if parseErr != nil {
+		writeValidationError(w, &fieldError{"storageId", "invalidFile", "Failed to parse the file: " + parseErr.Error()})
+		return
+	}
+
+	created := now()
+	f := s.files.add(func(id int) *file {
+		f := &file{
+			File: model.File{
+				ID:          id,
+				ProjectID:   project.ID,
+				DirectoryID: parent,
+				Name:        req.Name,
+				Type:        typ,
+				Path:        s.directoryPath(parent) + "/" + req.Name,
+				Status:      "active",
+				RevisionID:  1,
+				Priority:    "normal",
+				Fields:      req.Fields,
+				CreatedAt:   created,
+				UpdatedAt:   created,
+			},
+			revisions: [][]byte{stor.content},
+		}
+		if req.Title != "" {
+			f.Title = &req.Title
+		}
+		if req.Context != "" {
+			f.Context = &req.Context
+		}
+		return f
+	})
+	s.syncStrings(f, strs, fileUpdate{attachLabelIDs: req.AttachLabelIDs})
+
+	writeData(w, http.StatusCreated, f.File)
+}
+
+func (s *Server) listFiles(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+
+	parent := queryInt(r, "directoryId")
+	recursive := r.URL.Query().Has("recursion")
+	filter := r.URL.Query().Get("filter")
+	items := make([]*model.File, 0)
+	for _, f := range s.files.list(nil) {
+		if f.ProjectID == project.ID && s.inDirectory(f.DirectoryID, parent, recursive) &&
+			strings.Contains(f.Name, filter) {
+			items = append(items, &f.File)
+		}
+	}
+	writeList(w, r, items)
+}
+
+func (s *Server) getFile(w http.ResponseWriter, r *http.Request) {
+	f, ok := s.findFile(w, r)
+	if !ok {
+		return
+	}
+	writeData(w, http.StatusOK, f.File)
+}
+
+// updateFile updates the file from the storage or restores one of its revisions.
+// Each update creates a new revision and syncs the source strings.
+func (s *Server) updateFile(w http.ResponseWriter, r *http.Request) {
+	f, ok := s.findFile(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		RevisionID     int    `json:"revisionId"`
+		StorageID      int    `json:"storageId"`
+		Name           string `json:"name"`
+		UpdateOption   string `json:"updateOption"`
+		AttachLabelIDs []int  `json:"attachLabelIds"`
+		DetachLabelIDs []int  `json:"detachLabelIds"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	var content []byte
+	switch {
+	case req.StorageID != 0 && req.RevisionID != 0:
+		writeValidationError(w, &fieldError{"revisionId", "conflict", "Use only one of revisionId or storageId"})
+		return
+	case req.StorageID != 0:
+		stor, err := s.findStorageByID(req.StorageID)
+		if err != nil {
+			writeValidationError(w, err)
+			return
+		}
+		content = stor.content
+	case req.RevisionID != 0:
+		if req.RevisionID < 0 || req.RevisionID > len(f.revisions) {
+			writeValidationError(w, &fieldError{"revisionId", "revisionNotFound", "Revision Not Found"})
+			return
+		}
+		content = f.revisions[req.RevisionID-1]
+	default:
+		writeValidationError(w, required("storageId", true))
+		return
+	}
+
+	switch req.UpdateOption {
+	case "", "clear_translations_and_approvals", "keep_translations", "keep_translations_and_approvals":
+	default:
+		writeValidationError(w, &fieldError{"updateOption", "notInArray", "The input was not found in the haystack"})
+		return
+	}
+	if req.Name != "" && req.Name != f.Name {
+		if err := validateName(req.Name); err != nil {
+			writeValidationError(w, err)
+			return
+		}
+		if err := s.uniqueName(f.ProjectID, f.DirectoryID, req.Name, 0, f.ID); err != nil {
+			writeValidationError(w, err)
+			return
+		}
+	}
+	if err := s.validLabels(f.ProjectID, slices.Concat(req.AttachLabelIDs, req.DetachLabelIDs)); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	strs, err := parseStrings(f.Type, content)
+	if err != nil {
+		writeValidationError(w, &fieldError{"storageId", "invalidFile", "Failed to parse the file: " + err.Error()})
+		return
+	}
+
+	if req.Name != "" {
+		f.Name = req.Name
+		s.refreshPaths(f.ProjectID)
+	}
+	f.revisions = append(f.revisions, content)
+	f.RevisionID = len(f.revisions)
+	f.UpdatedAt = now()
+	s.syncStrings(f, strs, fileUpdate{
+		option:         req.UpdateOption,
+		attachLabelIDs: req.AttachLabelIDs,
+		detachLabelIDs: req.DetachLabelIDs,
+	})
+
+	writeData(w, http.StatusOK, f.File)
+}
+
+func (s *Server) editFile(w http.ResponseWriter, r *http.Request) {
+	f, ok := s.findFile(w, r)
+	if !ok {
+		return
+	}
+
+	var ops []*model.UpdateRequest
+	if !decode(w, r, &ops) {
+		return
+	}
+
+	edited := f.File
+	if err := patch(&edited, ops, "id", "projectId", "branchId", "directoryId", "type", "path", "status",
+		"revisionId", "createdAt", "updatedAt"); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := validateName(edited.Name); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := s.uniqueName(f.ProjectID, f.DirectoryID, edited.Name, 0, f.ID); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	edited.UpdatedAt = now()
+	f.File = edited
+	s.refreshPaths(f.ProjectID)
+
+	writeData(w, http.StatusOK, f.File)
+}
+
+func (s *Server) deleteFile(w http.ResponseWriter, r *http.Request) {
+	f, ok := s.findFile(w, r)
+	if !ok {
+		return
+	}
+	s.removeFile(f)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) downloadFile(w http.ResponseWriter, r *http.Request) {
+	f, ok := s.findFile(w, r)
+	if !ok {
+		return
+	}
+	writeData(w, http.StatusOK, &model.DownloadLink{
+		URL:      fmt.Sprintf("%s/_crowdintest/files/%d/revisions/%d", s.URL, f.ID, f.RevisionID),
+		ExpireIn: model.Time{Time: now().Add(30 * time.Minute)},
+	})
+}
+
+// serveFile serves the content of the file revision. It supports
+// range requests, so the downloads can be resumed.
+func (s *Server) serveFile(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	f := s.files.get(pathInt(r, "fileId"))
+	revision := pathInt(r, "revisionId")
+	var content []byte
+	if f != nil && revision > 0 && revision <= len(f.revisions) {
+		content = f.revisions[revision-1]
+	}
+	s.mu.Unlock()
+
+	if content == nil {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("ETag", fmt.Sprintf(`"%d-%d"`, f.ID, revision))
+	http.ServeContent(w, r, f.Name, time.Time{}, bytes.NewReader(content))
+}
+
+// removeFile deletes the file with its strings.
+func (s *Server) removeFile(f *file) {
+	for _, str := range s.strings.list(nil) {
+		if str.FileID != nil && *str.FileID == f.ID {
+			s.removeString(str)
+		}
+	}
+	s.files.delete(f.ID)
+}
+
+// fileUpdate defines how the strings are synced with the file content.
+type fileUpdate struct {
+	option         string
+	attachLabelIDs []int
+	detachLabelIDs []int
+}
+
+// syncStrings adds the new strings of the file, updates the changed ones and
+// deletes the strings which are no longer in the file. The translations and
+// approvals of the changed strings are deleted according to the update option.
+func (s *Server) syncStrings(f *file, strs []sourceString, update fileUpdate) {
+	existing := make(map[string]*model.SourceString)
+	for _, str := range s.strings.list(nil) {
+		if str.FileID != nil && *str.FileID == f.ID {
+			existing[str.Identifier] = str
+		}
+	}
+
+	revision := f.RevisionID
+	for _, parsed := range strs {
+		str, ok := existing[parsed.identifier]
+		delete(existing, parsed.identifier)
+
+		if !ok {
+			s.newString(f, parsed.identifier, parsed.text, "", update.attachLabelIDs)
+			continue
+		}
+		if str.Text == parsed.text {
+			continue
+		}
+
+		updated := now()
+		str.Text = parsed.text
+		str.UpdatedAt = &updated
+		str.Revision = &revision
+		str.LabelIDs = attachLabels(str.LabelIDs, update.attachLabelIDs, update.detachLabelIDs)
+
+		switch update.option {
+		case "keep_translations_and_approvals":
+		case "keep_translations":
+			s.removeApprovals(func(a *model.Approval) bool { return a.StringID == str.ID })
+		default:
+			s.removeTranslations(func(t *translation) bool { return t.StringID == str.ID })
+		}
+	}
+
+	for _, str := range existing {
+		s.removeString(str)
+	}
+}
+
+// findFile returns the file of the request path or writes the 404 error response.
+func (s *Server) findFile(w http.ResponseWriter, r *http.Request) (*file, bool) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return nil, false
+	}
+	id, ok := pathID(w, r, "fileId", "File")
+	if !ok {
+		return nil, false
+	}
+	f := s.files.get(id)
+	if f == nil || f.ProjectID != project.ID {
+		writeNotFound(w, "File")
+		return nil, false
+	}
+	return f, true
+}
+
+// findDirectory returns the directory of the request path or writes the 404 error response.
+func (s *Server) findDirectory(w http.ResponseWriter, r *http.Request) (*model.Directory, bool) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return nil, false
+	}
+	id, ok := pathID(w, r, "directoryId", "Directory")
+	if !ok {
+		return nil, false
+	}
+	dir := s.directories.get(id)
+	if dir == nil || dir.ProjectID != project.ID {
+		writeNotFound(w, "Directory")
+		return nil, false
+	}
+	return dir, true
+}
+
+// findStorageByID returns the storage referenced by the request body.
+func (s *Server) findStorageByID(id int) (*storage, *fieldError) {
+	if id == 0 {
+		return nil, required("storageId", true)
+	}
+	stor := s.storages.get(id)
+	if stor == nil {
+		return nil, &fieldError{"storageId", "storageNotFound", "Storage Not Found"}
+	}
+	return stor, nil
+}
+
+// parentDirectory returns the directory referenced by the request body,
+// or nil for the project root.
+func (s *Server) parentDirectory(projectID, id int) (*int, *fieldError) {
+	if id == 0 {
+		return nil, nil
+	}
+	dir := s.directories.get(id)
+	if dir == nil || dir.ProjectID != projectID {
+		return nil, &fieldError{"directoryId", "directoryNotFound", "Directory Not Found"}
+	}
+	return &dir.ID, nil
+}
+
+// uniqueName checks that no other directory or file in the parent directory
+// has the name. The directory and file being renamed are skipped.
+func (s *Server) uniqueName(projectID int, parent *int, name string, dirID, fileID int) *fieldError {
+	conflict := &fieldError{"name", "notUnique", "Name must be unique"}
+	for _, d := range s.directories.list(nil) {
+		if d.ID != dirID && d.ProjectID == projectID && equalID(d.DirectoryID, parent) && d.Name == name {
+			return conflict
+		}
+	}
+	for _, f := range s.files.list(nil) {
+		if f.ID != fileID && f.ProjectID == projectID && equalID(f.DirectoryID, parent) && f.Name == name {
+			return conflict
+		}
+	}
+	return nil
+}
+
+// inDirectory reports whether the parent directory is the given one
+// or, if recursive, one of its descendants. The root directory is 0.
+func (s *Server) inDirectory(parent *int, dirID int, recursive bool) bool {
+	if !recursive || dirID == 0 {
+		return equalID(parent, ptrOrNil(dirID))
+	}
+	for parent != nil {
+		if *parent == dirID {
+			return true
+		}
+		dir := s.directories.get(*parent)
+		if dir == nil {
+			return false
+		}
+		parent = dir.DirectoryID
+	}
+	return false
+}
+
+// directoryPath returns the path of the directory, or "" for the project root.
+func (s *Server) directoryPath(id *int) string {
+	if id == nil {
+		return ""
+	}
+	dir := s.directories.get(*id)
+	if dir == nil {
+		return ""
+	}
+	return s.directoryPath(dir.DirectoryID) + "/" + dir.Name
+}
+
+// refreshPaths updates the paths of the project directories and files after a rename.
+func (s *Server) refreshPaths(projectID int) {
+	for _, d := range s.directories.list(nil) {
+		if d.ProjectID == projectID {
+			d.Path = s.directoryPath(&d.ID)
+		}
+	}
+	for _, f := range s.files.list(nil) {
+		if f.ProjectID == projectID {
+			f.Path = s.directoryPath(f.DirectoryID) + "/" + f.Name
+		}
+	}
+}
+
+// validateName checks the name of a directory or file.
+func validateName(name string) *fieldError {
+	if name == "" {
+		return required("name", true)
+	}
+	if strings.ContainsAny(name, `\/:*?"<>|`) {
+		return &fieldError{"name", "regexNotMatch", `Name can't contain \ / : * ? " < > | symbols`}
+	}
+	return nil
+}
+
+func equalID(a, b *int) bool {
+	return a == nil && b == nil || a != nil && b != nil && *a == *b
+}
+
+func ptrOrNil(id int) *int {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,342 @@
+package crowdintest
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+const (
+	defaultLimit = 25
+	maxLimit     = 500
+)
+
+// table is a collection of resources with sequential identifiers.
+type table[T any] struct {
+	next  int
+	items map[int]*T
+}
+
+func newTable[T any]() *table[T] {
+	return &table[T]{items: make(map[int]*T)}
+}
+
+// add creates a new item with the next identifier.
+func (t *table[T]) add(create func(id int) *T) *T {
+	t.next++
+	item := create(t.next)
+	t.items[t.next] = item
+	return item
+}
+
+func (t *table[T]) get(id int) *T {
+	return t.items[id]
+}
+
+func (t *table[T]) delete(id int) {
+	delete(t.items, id)
+}
+
+// list returns the items matching the filter ordered by identifier.
+func (t *table[T]) list(filter func(*T) bool) []*T {
+	items := make([]*T, 0)
+	for _, id := range slices.Sorted(maps.Keys(t.items)) {
+		if item := t.items[id]; filter == nil || filter(item) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// now returns the current time in UTC, encoded with the "+00:00" offset like by the API.
+func now() model.Time {
+	return model.Time{Time: time.Now().In(time.FixedZone("", 0)).Truncate(time.Second)}
+}
+
+// fieldError is a validation error of a request field.
+type fieldError struct {
+	key     string
+	code    string
+	message string
+}
+
+func (e *fieldError) Error() string {
+	return fmt.Sprintf("%s: %s (%s)", e.key, e.message, e.code)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response, e.g. `{"error": {"code": 404, "message": "Project Not Found"}}`.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{
+		"error": map[string]any{"code": status, "message": message},
+	})
+}
+
+// writeNotFound writes the 404 error response for the resource.
+func writeNotFound(w http.ResponseWriter, resource string) {
+	writeError(w, http.StatusNotFound, resource+" Not Found")
+}
+
+// writeValidationError writes the 400 validation error response for the field.
+func writeValidationError(w http.ResponseWriter, err *fieldError) {
+	writeJSON(w, http.StatusBadRequest, map[string]any{
+		"errors": []any{
+			map[string]any{
+				"error": map[string]any{
+					"key": err.key,
+					"errors": []any{
+						map[string]any{"code": err.code, "message": err.message},
+					},
+				},
+			},
+		},
+	})
+}
+
+// writeData writes a single resource response: `{"data": {...}}`.
+func writeData(w http.ResponseWriter, status int, v any) {
+	writeJSON(w, status, map[string]any{"data": v})
+}
+
+// writeList writes a page of the list response with pagination:
+// `{"data": [{"data": {...}}], "pagination": {"offset": 0, "limit": 25}}`.
+func writeList[T any](w http.ResponseWriter, r *http.Request, items []*T) {
+	offset, limit, err := pagination(r)
+	if err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	page := items[min(offset, len(items)):]
+	page = page[:min(limit, len(page))]
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"data":       wrap(page),
+		"pagination": map[string]int{"offset": offset, "limit": limit},
+	})
+}
+
+// wrap wraps each item in a data object: `[{"data": {...}}]`.
+func wrap[T any](items []*T) []any {
+	data := make([]any, 0, len(items))
+	for _, item := range items {
+		data = append(data, map[string]any{"data": item})
+	}
+	return data
+}
+
+// pagination returns the offset and limit of the list request.
+func pagination(r *http.Request) (offset, limit int, err *fieldError) {
+	limit = defaultLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, convErr := strconv.Atoi(v)
+		if convErr != nil || n < 1 || n > maxLimit {
+			return 0, 0, &fieldError{"limit", "notBetween", fmt.Sprintf("The input is not between '1' and '%d', inclusively", maxLimit)}
+		}
+		limit = n
+	}
+	if v := r.URL.Query().Get("offset"); v != "" {
+		n, convErr := strconv.Atoi(v)
+		if convErr != nil || n < 0 {
+			return 0, 0, &fieldError{"offset", "notGreaterThan", "The input is not greater than or equal to '0'"}
+		}
+		offset = n
+	}
+	return offset, limit, nil
+}
+
+// decode decodes the JSON request body into v.
+// It writes a validation error response and returns false on failure.
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeValidationError(w, &fieldError{"body", "invalidJson", "Request body is not a valid JSON: " + err.Error()})
+		return false
+	}
+	return true
+}
+
+// pathID returns the integer path value. It writes the 404 error
+// response for the resource and returns false if the value is invalid.
+func pathID(w http.ResponseWriter, r *http.Request, name, resource string) (int, bool) {
+	id, err := strconv.Atoi(r.PathValue(name))
+	if err != nil || id <= 0 {
+		writeNotFound(w, resource)
+		return 0, false
+	}
+	return id, true
+}
+
+// pathInt returns the integer path value or 0 if it is invalid.
+func pathInt(r *http.Request, name string) int {
+	n, _ := strconv.Atoi(r.PathValue(name))
+	return n
+}
+
+// queryInt returns the integer query parameter or 0 if it is not set or invalid.
+func queryInt(r *http.Request, name string) int {
+	n, _ := strconv.Atoi(r.URL.Query().Get(name))
+	return n
+}
+
+// queryInts returns the comma-separated integers of the query parameter.
+func queryInts(r *http.Request, name string) []int {
+	var ids []int
+	for _, v := range strings.Split(r.URL.Query().Get(name), ",") {
+		if id, err := strconv.Atoi(strings.TrimSpace(v)); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// required returns a validation error if the field value is empty.
+func required(key string, empty bool) *fieldError {
+	if empty {
+		return &fieldError{key, "isEmpty", "Value is required and can't be empty"}
+	}
+	return nil
+}
+
+// patch applies the JSON Patch operations to the resource. The operations
+// cannot change the read-only fields. The resource is changed only if
+// all operations succeed.
+func patch[T any](item *T, ops []*model.UpdateRequest, readOnly ...string) *fieldError {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return &fieldError{"body", "invalid", err.Error()}
+	}
+	var doc any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return &fieldError{"body", "invalid", err.Error()}
+	}
+
+	for i, op := range ops {
+		key := fmt.Sprintf("%d", i)
+		if op == nil {
+			return &fieldError{key, "isEmpty", "Operation is required"}
+		}
+		tokens, ferr := pointerTokens(key, op.Path)
+		if ferr != nil {
+			return ferr
+		}
+		if slices.Contains(readOnly, tokens[0]) {
+			return &fieldError{key, "readOnly", fmt.Sprintf("Field '%s' cannot be changed", tokens[0])}
+		}
+
+		// Normalize the value to the JSON representation.
+		var value any
+		if op.Value != nil {
+			raw, err := json.Marshal(op.Value)
+			if err != nil {
+				return &fieldError{key, "invalid", err.Error()}
+			}
+			_ = json.Unmarshal(raw, &value)
+		}
+
+		if doc, ferr = applyOp(key, doc, tokens, op.Op, value); ferr != nil {
+			return ferr
+		}
+	}
+
+	data, err = json.Marshal(doc)
+	if err != nil {
+		return &fieldError{"body", "invalid", err.Error()}
+	}
+	var out T
+	if err := json.Unmarshal(data, &out); err != nil {
+		return &fieldError{"value", "invalidType", err.Error()}
+	}
+	*item = out
+
+	return nil
+}
+
+// pointerTokens splits the JSON Pointer (RFC 6901) into unescaped tokens.
+func pointerTokens(key, path string) ([]string, *fieldError) {
+	if !strings.HasPrefix(path, "/") || path == "/" {
+		return nil, &fieldError{key, "invalidPath", fmt.Sprintf("Path '%s' is not valid", path)}
+	}
+	tokens := strings.Split(path[1:], "/")
+	for i, t := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// applyOp applies a single operation to the JSON document and returns the result.
+func applyOp(key string, doc any, tokens []string, op model.PatchOp, value any) (any, *fieldError) {
+	invalidPath := &fieldError{key, "invalidPath", fmt.Sprintf("Path '/%s' does not exist", strings.Join(tokens, "/"))}
+
+	if len(tokens) == 0 {
+		switch op {
+		case model.OpAdd, model.OpReplace:
+			return value, nil
+		case model.OpTest:
+			if !reflect.DeepEqual(doc, value) {
+				return nil, &fieldError{key, "testFailed", "Test operation failed"}
+			}
+			return doc, nil
+		}
+		return nil, invalidPath
+	}
+
+	switch node := doc.(type) {
+	case map[string]any:
+		child, exists := node[tokens[0]]
+		if len(tokens) == 1 {
+			switch op {
+			case model.OpAdd:
+				node[tokens[0]] = value
+				return node, nil
+			case model.OpRemove:
+				if !exists {
+					return nil, invalidPath
+				}
+				delete(node, tokens[0])
+				return node, nil
+			}
+		}
+		if !exists && (op != model.OpReplace || len(tokens) > 1) {
+			return nil, invalidPath
+		}
+		next, err := applyOp(key, child, tokens[1:], op, value)
+		if err != nil {
+			return nil, err
+		}
+		node[tokens[0]] = next
+		return node, nil
+
+	case []any:
+		i, err := strconv.Atoi(tokens[0])
+		if len(tokens) == 1 && op == model.OpAdd && (tokens[0] == "-" || err == nil && i == len(node)) {
+			return append(node, value), nil
+		}
+		if err != nil || i < 0 || i >= len(node) {
+			return nil, invalidPath
+		}
+		if len(tokens) == 1 && op == model.OpRemove {
+			return slices.Delete(node, i, i+1), nil
+		}
+		next, ferr := applyOp(key, node[i], tokens[1:], op, value)
+		if ferr != nil {
+			return nil, ferr
+		}
+		node[i] = next
+		return node, nil
+	}
+
+	return nil, invalidPath
+}
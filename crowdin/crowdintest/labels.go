@@ -0,0 +1,202 @@
+package crowdintest
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+// label is a project label.
+type label struct {
+	model.Label
+	ProjectID int `json:"-"`
+}
+
+func (s *Server) registerLabels(mux *http.ServeMux) {
+	s.handle(mux, "POST /api/v2/projects/{projectId}/labels", s.addLabel)
+	s.handle(mux, "GET /api/v2/projects/{projectId}/labels", s.listLabels)
+	s.handle(mux, "GET /api/v2/projects/{projectId}/labels/{labelId}", s.getLabel)
+	s.handle(mux, "PATCH /api/v2/projects/{projectId}/labels/{labelId}", s.editLabel)
+	s.handle(mux, "DELETE /api/v2/projects/{projectId}/labels/{labelId}", s.deleteLabel)
+	s.handle(mux, "POST /api/v2/projects/{projectId}/labels/{labelId}/strings", s.assignLabel)
+	s.handle(mux, "DELETE /api/v2/projects/{projectId}/labels/{labelId}/strings", s.unassignLabel)
+}
+
+func (s *Server) addLabel(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+
+	var req model.LabelAddRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if err := required("title", req.Title == ""); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := s.uniqueTitle(project.ID, req.Title, 0); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	l := s.labels.add(func(id int) *label {
+		return &label{Label: model.Label{ID: id, Title: req.Title}, ProjectID: project.ID}
+	})
+	writeData(w, http.StatusCreated, l.Label)
+}
+
+func (s *Server) listLabels(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+
+	items := make([]*model.Label, 0)
+	for _, l := range s.labels.list(nil) {
+		if l.ProjectID == project.ID {
+			items = append(items, &l.Label)
+		}
+	}
+	writeList(w, r, items)
+}
+
+func (s *Server) getLabel(w http.ResponseWriter, r *http.Request) {
+	l, ok := s.findLabel(w, r)
+	if !ok {
+		return
+	}
+	writeData(w, http.StatusOK, l.Label)
+}
+
+func (s *Server) editLabel(w http.ResponseWriter, r *http.Request) {
+	l, ok := s.findLabel(w, r)
+	if !ok {
+		return
+	}
+
+	var ops []*model.UpdateRequest
+	if !decode(w, r, &ops) {
+		return
+	}
+
+	edited := l.Label
+	if err := patch(&edited, ops, "id"); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := required("title", edited.Title == ""); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := s.uniqueTitle(l.ProjectID, edited.Title, l.ID); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	l.Label = edited
+
+	writeData(w, http.StatusOK, l.Label)
+}
+
+func (s *Server) deleteLabel(w http.ResponseWriter, r *http.Request) {
+	l, ok := s.findLabel(w, r)
+	if !ok {
+		return
+	}
+
+	for _, str := range s.strings.list(nil) {
+		if str.ProjectID == l.ProjectID {
+			str.LabelIDs = attachLabels(str.LabelIDs, nil, []int{l.ID})
+		}
+	}
+	s.labels.delete(l.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) assignLabel(w http.ResponseWriter, r *http.Request) {
+	l, ok := s.findLabel(w, r)
+	if !ok {
+		return
+	}
+
+	var req model.AssignToStringsRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	strs, err := s.labelStrings(l, req.StringIDs)
+	if err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	for _, str := range strs {
+		str.LabelIDs = attachLabels(str.LabelIDs, []int{l.ID}, nil)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": wrap(strs)})
+}
+
+func (s *Server) unassignLabel(w http.ResponseWriter, r *http.Request) {
+	l, ok := s.findLabel(w, r)
+	if !ok {
+		return
+	}
+
+	strs, err := s.labelStrings(l, queryInts(r, "stringIds"))
+	if err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	for _, str := range strs {
+		str.LabelIDs = attachLabels(str.LabelIDs, nil, []int{l.ID})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": wrap(strs)})
+}
+
+// labelStrings returns the strings of the label project by their IDs.
+func (s *Server) labelStrings(l *label, ids []int) ([]*model.SourceString, *fieldError) {
+	if err := required("stringIds", len(ids) == 0); err != nil {
+		return nil, err
+	}
+
+	strs := make([]*model.SourceString, 0, len(ids))
+	for _, id := range slices.Compact(slices.Sorted(slices.Values(ids))) {
+		str := s.strings.get(id)
+		if str == nil || str.ProjectID != l.ProjectID {
+			return nil, &fieldError{"stringIds", "stringNotFound", "String Not Found"}
+		}
+		strs = append(strs, str)
+	}
+	return strs, nil
+}
+
+// uniqueTitle checks that no other label in the project has the title.
+func (s *Server) uniqueTitle(projectID int, title string, labelID int) *fieldError {
+	for _, l := range s.labels.list(nil) {
+		if l.ID != labelID && l.ProjectID == projectID && l.Title == title {
+			return &fieldError{"title", "notUnique", "Label title must be unique"}
+		}
+	}
+	return nil
+}
+
+// findLabel returns the label of the request path or writes the 404 error response.
+func (s *Server) findLabel(w http.ResponseWriter, r *http.Request) (*label, bool) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return nil, false
+	}
+	id, ok := pathID(w, r, "labelId", "Label")
+	if !ok {
+		return nil, false
+	}
+	l := s.labels.get(id)
+	if l == nil || l.ProjectID != project.ID {
+		writeNotFound(w, "Label")
+		return nil, false
+	}
+	return l, true
+}
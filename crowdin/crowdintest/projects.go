@@ -0,0 +1,149 @@
+package crowdintest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+func (s *Server) registerProjects(mux *http.ServeMux) {
+	s.handle(mux, "POST /api/v2/projects", s.addProject)
+	s.handle(mux, "GET /api/v2/projects", s.listProjects)
+	s.handle(mux, "GET /api/v2/projects/{projectId}", s.getProject)
+	s.handle(mux, "PATCH /api/v2/projects/{projectId}", s.editProject)
+	s.handle(mux, "DELETE /api/v2/projects/{projectId}", s.deleteProject)
+}
+
+func (s *Server) addProject(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Name              string   `json:"name"`
+		Identifier        string   `json:"identifier"`
+		SourceLanguageID  string   `json:"sourceLanguageId"`
+		TargetLanguageIDs []string `json:"targetLanguageIds"`
+		Visibility        string   `json:"visibility"`
+		Description       string   `json:"description"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if err := required("name", req.Name == ""); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := required("sourceLanguageId", req.SourceLanguageID == ""); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	identifier := req.Identifier
+	if identifier == "" {
+		identifier = strings.ToLower(strings.ReplaceAll(req.Name, " ", "-"))
+	}
+	for _, p := range s.projects.list(nil) {
+		if p.Identifier == identifier {
+			writeValidationError(w, &fieldError{"identifier", "notUnique", "Project identifier must be unique"})
+			return
+		}
+	}
+
+	visibility := req.Visibility
+	if visibility == "" {
+		visibility = "private"
+	}
+	targets := req.TargetLanguageIDs
+	if targets == nil {
+		targets = []string{}
+	}
+
+	created := now()
+	project := s.projects.add(func(id int) *model.Project {
+		return &model.Project{
+			ID:                   id,
+			UserID:               1,
+			Name:                 req.Name,
+			Identifier:           identifier,
+			Description:          req.Description,
+			SourceLanguageID:     req.SourceLanguageID,
+			TargetLanguageIDs:    targets,
+			LanguageAccessPolicy: "open",
+			Visibility:           visibility,
+			WebURL:               s.URL + "/project/" + identifier,
+			CreatedAt:            created,
+			UpdatedAt:            created,
+			LastActivity:         created,
+		}
+	})
+	writeData(w, http.StatusCreated, project)
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request) {
+	writeList(w, r, s.projects.list(nil))
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+	writeData(w, http.StatusOK, project)
+}
+
+func (s *Server) editProject(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+
+	var ops []*model.UpdateRequest
+	if !decode(w, r, &ops) {
+		return
+	}
+	if err := patch(project, ops, "id", "userId", "sourceLanguageId", "createdAt", "updatedAt", "lastActivity", "webUrl"); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	project.UpdatedAt = now()
+
+	writeData(w, http.StatusOK, project)
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+
+	for _, dir := range s.directories.list(nil) {
+		if dir.ProjectID == project.ID {
+			s.directories.delete(dir.ID)
+		}
+	}
+	for _, f := range s.files.list(nil) {
+		if f.ProjectID == project.ID {
+			s.removeFile(f)
+		}
+	}
+	for _, l := range s.labels.list(nil) {
+		if l.ProjectID == project.ID {
+			s.labels.delete(l.ID)
+		}
+	}
+	s.projects.delete(project.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// findProject returns the project of the request path or writes the 404 error response.
+func (s *Server) findProject(w http.ResponseWriter, r *http.Request) (*model.Project, bool) {
+	id, ok := pathID(w, r, "projectId", "Project")
+	if !ok {
+		return nil, false
+	}
+	project := s.projects.get(id)
+	if project == nil {
+		writeNotFound(w, "Project")
+		return nil, false
+	}
+	return project, true
+}
@@ -0,0 +1,111 @@
+// Package crowdintest provides an in-memory fake of the Crowdin API
+// for testing code that uses crowdin.Client without network access.
+//
+// The fake keeps state between requests and covers storages, projects,
+// directories, files, source strings, string translations, approvals and
+// labels. It returns list responses with pagination and error responses
+// in the same format as the real API, so the error helpers of the crowdin
+// package (e.g. crowdin.IsNotFound) work with it.
+//
+// Files uploaded in the JSON format are parsed into source strings (nested
+// keys are joined with dots), so a sync pipeline can be tested end to end:
+//
+//	srv := crowdintest.NewServer()
+//	defer srv.Close()
+//
+//	client, err := srv.NewClient()
+//	...
+//	storage, _, err := client.Storages.AddReader(ctx, "en.json", strings.NewReader(`{"hello": "Hello"}`), -1)
+//	project, _, err := client.Projects.Add(ctx, &model.ProjectsAddRequest{Name: "App", SourceLanguageID: "en"})
+//	file, _, err := client.SourceFiles.AddFile(ctx, project.ID, &model.FileAddRequest{StorageID: storage.ID, Name: "en.json"})
+//	strs, _, err := client.SourceStrings.List(ctx, project.ID, &model.SourceStringsListOptions{FileID: file.ID})
+package crowdintest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin"
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+// Server is an in-memory fake of the Crowdin API.
+// It is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the server, e.g. "http://127.0.0.1:1234".
+	URL string
+
+	srv *httptest.Server
+
+	mu           sync.Mutex
+	storages     *table[storage]
+	projects     *table[model.Project]
+	directories  *table[model.Directory]
+	files        *table[file]
+	strings      *table[model.SourceString]
+	translations *table[translation]
+	approvals    *table[model.Approval]
+	labels       *table[label]
+}
+
+// NewServer starts and returns a new fake server with no data.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		storages:     newTable[storage](),
+		projects:     newTable[model.Project](),
+		directories:  newTable[model.Directory](),
+		files:        newTable[file](),
+		strings:      newTable[model.SourceString](),
+		translations: newTable[translation](),
+		approvals:    newTable[model.Approval](),
+		labels:       newTable[label](),
+	}
+
+	mux := http.NewServeMux()
+	s.registerStorages(mux)
+	s.registerProjects(mux)
+	s.registerSourceFiles(mux)
+	s.registerSourceStrings(mux)
+	s.registerTranslations(mux)
+	s.registerLabels(mux)
+	mux.HandleFunc("/", func(w http.ResponseWriter, _ *http.Request) {
+		writeError(w, http.StatusNotFound, "Route Not Found")
+	})
+
+	s.srv = httptest.NewServer(mux)
+	s.URL = s.srv.URL
+
+	return s
+}
+
+// NewClient returns a Crowdin API client sending requests to the server.
+// The options are applied after the base URL is set.
+func (s *Server) NewClient(opts ...crowdin.ClientOption) (*crowdin.Client, error) {
+	return crowdin.NewClient("crowdintest", append([]crowdin.ClientOption{crowdin.WithBaseURL(s.URL)}, opts...)...)
+}
+
+// Close shuts down the server and blocks until all outstanding
+// requests on this server have completed.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// handle registers the handler for the API pattern. The handler is called
+// with the server state locked and only for authorized requests.
+func (s *Server) handle(mux *http.ServeMux, pattern string, handler http.HandlerFunc) {
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			writeError(w, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		handler(w, r)
+	})
+}
@@ -0,0 +1,286 @@
+package crowdintest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin"
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupServer starts a fake server and returns a client connected to it.
+func setupServer(t *testing.T) (*Server, *crowdin.Client) {
+	t.Helper()
+
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+
+	client, err := srv.NewClient()
+	require.NoError(t, err)
+
+	return srv, client
+}
+
+// addProject creates a project with the "uk" target language.
+func addProject(t *testing.T, client *crowdin.Client) *model.Project {
+	t.Helper()
+
+	project, _, err := client.Projects.Add(context.Background(), &model.ProjectsAddRequest{
+		Name:              "App",
+		SourceLanguageID:  "en",
+		TargetLanguageIDs: []string{"uk"},
+	})
+	require.NoError(t, err)
+
+	return project
+}
+
+// addFile uploads the content to the storage and adds it as a project file.
+func addFile(t *testing.T, client *crowdin.Client, projectID int, name, content string) *model.File {
+	t.Helper()
+
+	ctx := context.Background()
+	storage, _, err := client.Storages.AddReader(ctx, name, strings.NewReader(content), -1)
+	require.NoError(t, err)
+
+	file, _, err := client.SourceFiles.AddFile(ctx, projectID, &model.FileAddRequest{StorageID: storage.ID, Name: name})
+	require.NoError(t, err)
+
+	return file
+}
+
+// identifiers returns the identifiers and texts of the strings.
+func identifiers(strs []*model.SourceString) map[string]string {
+	m := make(map[string]string, len(strs))
+	for _, str := range strs {
+		m[str.Identifier] = str.Text
+	}
+	return m
+}
+
+func TestServer_SyncPipeline(t *testing.T) {
+	_, client := setupServer(t)
+	ctx := context.Background()
+
+	project := addProject(t, client)
+	file := addFile(t, client, project.ID, "en.json", `{"menu": {"open": "Open", "close": "Close"}, "title": "App"}`)
+	assert.Equal(t, "json", file.Type)
+	assert.Equal(t, "/en.json", file.Path)
+	assert.Equal(t, 1, file.RevisionID)
+
+	strs, err := client.SourceStrings.ListAll(ctx, project.ID, &model.SourceStringsListOptions{FileID: file.ID})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"menu.open": "Open", "menu.close": "Close", "title": "App"}, identifiers(strs))
+
+	// Translate and approve all strings.
+	for _, str := range strs {
+		tr, _, err := client.StringTranslations.AddTranslation(ctx, project.ID, &model.TranslationAddRequest{
+			StringID:   str.ID,
+			LanguageID: "uk",
+			Text:       str.Text + " (uk)",
+		})
+		require.NoError(t, err)
+
+		_, _, err = client.StringTranslations.AddApproval(ctx, project.ID, tr.ID)
+		require.NoError(t, err)
+	}
+
+	// Update the file: "menu.open" is changed, "menu.close" is removed and "help" is added.
+	storage, _, err := client.Storages.AddReader(ctx, "en.json",
+		strings.NewReader(`{"menu": {"open": "Open..."}, "title": "App", "help": "Help"}`), -1)
+	require.NoError(t, err)
+
+	file, _, err = client.SourceFiles.UpdateOrRestoreFile(ctx, project.ID, file.ID, &model.FileUpdateRestoreRequest{StorageID: storage.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 2, file.RevisionID)
+
+	strs, err = client.SourceStrings.ListAll(ctx, project.ID, &model.SourceStringsListOptions{FileID: file.ID})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"menu.open": "Open...", "title": "App", "help": "Help"}, identifiers(strs))
+
+	approvals, err := client.StringTranslations.ListAllApprovals(ctx, project.ID, &model.ApprovalsListOptions{LanguageID: "uk"})
+	require.NoError(t, err)
+	require.Len(t, approvals, 1, "only the approval of the unchanged string should be kept")
+
+	for _, str := range strs {
+		translations, _, err := client.StringTranslations.ListStringTranslations(ctx, project.ID,
+			&model.StringTranslationsListOptions{StringID: str.ID, LanguageID: "uk"})
+		require.NoError(t, err)
+
+		if str.Identifier == "title" {
+			require.Len(t, translations, 1)
+			assert.Equal(t, "App (uk)", translations[0].Text)
+			assert.Equal(t, str.ID, approvals[0].StringID)
+		} else {
+			assert.Empty(t, translations, str.Identifier)
+		}
+	}
+
+	// Restore the first revision.
+	file, _, err = client.SourceFiles.UpdateOrRestoreFile(ctx, project.ID, file.ID, &model.FileUpdateRestoreRequest{RevisionID: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 3, file.RevisionID)
+
+	link, _, err := client.SourceFiles.DownloadFile(ctx, project.ID, file.ID)
+	require.NoError(t, err)
+
+	buf := new(bytes.Buffer)
+	_, err = client.Fetch(ctx, link, buf)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"menu": {"open": "Open", "close": "Close"}, "title": "App"}`, buf.String())
+}
+
+func TestServer_Directories(t *testing.T) {
+	_, client := setupServer(t)
+	ctx := context.Background()
+
+	project := addProject(t, client)
+	parent, _, err := client.SourceFiles.AddDirectory(ctx, project.ID, &model.DirectoryAddRequest{Name: "app"})
+	require.NoError(t, err)
+	child, _, err := client.SourceFiles.AddDirectory(ctx, project.ID, &model.DirectoryAddRequest{Name: "web", DirectoryID: parent.ID})
+	require.NoError(t, err)
+	assert.Equal(t, "/app/web", child.Path)
+
+	storage, _, err := client.Storages.AddReader(ctx, "en.json", strings.NewReader(`{"hello": "Hello"}`), -1)
+	require.NoError(t, err)
+	file, _, err := client.SourceFiles.AddFile(ctx, project.ID, &model.FileAddRequest{
+		StorageID:   storage.ID,
+		Name:        "en.json",
+		DirectoryID: child.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "/app/web/en.json", file.Path)
+
+	_, _, err = client.SourceFiles.EditDirectory(ctx, project.ID, parent.ID, []*model.UpdateRequest{
+		{Op: model.OpReplace, Path: "/name", Value: "mobile"},
+	})
+	require.NoError(t, err)
+
+	file, _, err = client.SourceFiles.GetFile(ctx, project.ID, file.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "/mobile/web/en.json", file.Path)
+
+	files, _, err := client.SourceFiles.ListFiles(ctx, project.ID, &model.FileListOptions{DirectoryID: parent.ID})
+	require.NoError(t, err)
+	assert.Empty(t, files)
+
+	files, _, err = client.SourceFiles.ListFiles(ctx, project.ID, &model.FileListOptions{DirectoryID: parent.ID, Recursion: "1"})
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	_, err = client.SourceFiles.DeleteDirectory(ctx, project.ID, parent.ID)
+	require.NoError(t, err)
+
+	_, _, err = client.SourceFiles.GetDirectory(ctx, project.ID, child.ID)
+	assert.True(t, crowdin.IsNotFound(err))
+	_, _, err = client.SourceFiles.GetFile(ctx, project.ID, file.ID)
+	assert.True(t, crowdin.IsNotFound(err))
+
+	strs, _, err := client.SourceStrings.List(ctx, project.ID, nil)
+	require.NoError(t, err)
+	assert.Empty(t, strs)
+}
+
+func TestServer_Labels(t *testing.T) {
+	_, client := setupServer(t)
+	ctx := context.Background()
+
+	project := addProject(t, client)
+	file := addFile(t, client, project.ID, "en.json", `{"a": "A", "b": "B"}`)
+
+	label, _, err := client.Labels.Add(ctx, project.ID, &model.LabelAddRequest{Title: "release"})
+	require.NoError(t, err)
+
+	str, _, err := client.SourceStrings.Add(ctx, project.ID, &model.SourceStringsAddRequest{
+		Text:       "C",
+		FileID:     file.ID,
+		Identifier: "c",
+	})
+	require.NoError(t, err)
+
+	strs, _, err := client.Labels.AssignToStrings(ctx, project.ID, label.ID, []int{str.ID})
+	require.NoError(t, err)
+	require.Len(t, strs, 1)
+	assert.Equal(t, []int{label.ID}, strs[0].LabelIDs)
+
+	strs, _, err = client.SourceStrings.List(ctx, project.ID, &model.SourceStringsListOptions{LabelIDs: []string{"1"}})
+	require.NoError(t, err)
+	require.Len(t, strs, 1)
+	assert.Equal(t, "c", strs[0].Identifier)
+
+	_, err = client.Labels.Delete(ctx, project.ID, label.ID)
+	require.NoError(t, err)
+
+	str, _, err = client.SourceStrings.Get(ctx, project.ID, str.ID, nil)
+	require.NoError(t, err)
+	assert.Empty(t, str.LabelIDs)
+}
+
+func TestServer_Pagination(t *testing.T) {
+	_, client := setupServer(t)
+	ctx := context.Background()
+
+	project := addProject(t, client)
+	for i := range 30 {
+		_, _, err := client.Labels.Add(ctx, project.ID, &model.LabelAddRequest{Title: string(rune('A' + i))})
+		require.NoError(t, err)
+	}
+
+	labels, resp, err := client.Labels.List(ctx, project.ID, nil)
+	require.NoError(t, err)
+	assert.Len(t, labels, 25)
+	assert.Equal(t, 25, resp.Pagination.Limit)
+
+	labels, resp, err = client.Labels.List(ctx, project.ID, &model.LabelsListOptions{ListOptions: model.ListOptions{Offset: 25, Limit: 10}})
+	require.NoError(t, err)
+	assert.Len(t, labels, 5)
+	assert.Equal(t, 25, resp.Pagination.Offset)
+	assert.Equal(t, 10, resp.Pagination.Limit)
+
+	all, err := client.Labels.ListAll(ctx, project.ID, nil)
+	require.NoError(t, err)
+	assert.Len(t, all, 30)
+}
+
+func TestServer_Errors(t *testing.T) {
+	srv, client := setupServer(t)
+	ctx := context.Background()
+
+	_, _, err := client.Projects.Get(ctx, 1)
+	require.EqualError(t, err, "404 Project Not Found")
+	assert.True(t, crowdin.IsNotFound(err))
+
+	project := addProject(t, client)
+	_, _, err = client.Projects.Add(ctx, &model.ProjectsAddRequest{Name: "App", SourceLanguageID: "en"})
+	require.Error(t, err)
+	assert.True(t, crowdin.IsValidation(err))
+
+	var validationErr *model.ValidationErrorResponse
+	require.True(t, errors.As(err, &validationErr))
+	require.Contains(t, validationErr.FieldErrors(), "identifier")
+	assert.Equal(t, "notUnique", validationErr.FieldErrors()["identifier"][0].Code)
+
+	_, _, err = client.SourceFiles.AddFile(ctx, project.ID, &model.FileAddRequest{StorageID: 100, Name: "en.json"})
+	assert.True(t, crowdin.IsValidation(err))
+
+	_, _, err = client.Projects.Edit(ctx, project.ID, []*model.UpdateRequest{{Op: model.OpReplace, Path: "/id", Value: 2}})
+	assert.True(t, crowdin.IsValidation(err))
+
+	edited, _, err := client.Projects.Edit(ctx, project.ID, []*model.UpdateRequest{{Op: model.OpReplace, Path: "/name", Value: "Web"}})
+	require.NoError(t, err)
+	assert.Equal(t, "Web", edited.Name)
+
+	_, _, err = client.Storages.List(ctx, &model.ListOptions{Limit: 501})
+	assert.True(t, crowdin.IsValidation(err))
+
+	resp, err := http.Get(srv.URL + "/api/v2/projects")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
@@ -0,0 +1,80 @@
+package crowdintest
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+// storage is a file uploaded to the storage.
+type storage struct {
+	model.Storage
+	content []byte
+}
+
+func (s *Server) registerStorages(mux *http.ServeMux) {
+	s.handle(mux, "POST /api/v2/storages", s.addStorage)
+	s.handle(mux, "GET /api/v2/storages", s.listStorages)
+	s.handle(mux, "GET /api/v2/storages/{storageId}", s.getStorage)
+	s.handle(mux, "DELETE /api/v2/storages/{storageId}", s.deleteStorage)
+}
+
+func (s *Server) addStorage(w http.ResponseWriter, r *http.Request) {
+	name, err := url.QueryUnescape(r.Header.Get("Crowdin-API-FileName"))
+	if err != nil || name == "" {
+		writeValidationError(w, &fieldError{"Crowdin-API-FileName", "isEmpty", "File name is required"})
+		return
+	}
+
+	content, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Failed to read the request body")
+		return
+	}
+
+	stor := s.storages.add(func(id int) *storage {
+		return &storage{Storage: model.Storage{ID: id, FileName: name}, content: content}
+	})
+	writeData(w, http.StatusCreated, stor.Storage)
+}
+
+func (s *Server) listStorages(w http.ResponseWriter, r *http.Request) {
+	items := make([]*model.Storage, 0)
+	for _, stor := range s.storages.list(nil) {
+		items = append(items, &stor.Storage)
+	}
+	writeList(w, r, items)
+}
+
+func (s *Server) getStorage(w http.ResponseWriter, r *http.Request) {
+	stor, ok := s.findStorage(w, r)
+	if !ok {
+		return
+	}
+	writeData(w, http.StatusOK, stor.Storage)
+}
+
+func (s *Server) deleteStorage(w http.ResponseWriter, r *http.Request) {
+	stor, ok := s.findStorage(w, r)
+	if !ok {
+		return
+	}
+	s.storages.delete(stor.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// findStorage returns the storage of the request path or writes the 404 error response.
+func (s *Server) findStorage(w http.ResponseWriter, r *http.Request) (*storage, bool) {
+	id, ok := pathID(w, r, "storageId", "Storage")
+	if !ok {
+		return nil, false
+	}
+	stor := s.storages.get(id)
+	if stor == nil {
+		writeNotFound(w, "Storage")
+		return nil, false
+	}
+	return stor, true
+}
@@ -0,0 +1,241 @@
+package crowdintest
+
+import (
+	"crypto/md5" //nolint:gosec // identifiers are not security sensitive
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+func (s *Server) registerSourceStrings(mux *http.ServeMux) {
+	s.handle(mux, "POST /api/v2/projects/{projectId}/strings", s.addString)
+	s.handle(mux, "GET /api/v2/projects/{projectId}/strings", s.listStrings)
+	s.handle(mux, "GET /api/v2/projects/{projectId}/strings/{stringId}", s.getString)
+	s.handle(mux, "PATCH /api/v2/projects/{projectId}/strings/{stringId}", s.editString)
+	s.handle(mux, "DELETE /api/v2/projects/{projectId}/strings/{stringId}", s.deleteString)
+}
+
+func (s *Server) addString(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Text       any    `json:"text"`
+		FileID     int    `json:"fileId"`
+		Identifier string `json:"identifier"`
+		Context    string `json:"context"`
+		IsHidden   bool   `json:"isHidden"`
+		MaxLength  int    `json:"maxLength"`
+		LabelIDs   []int  `json:"labelIds"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	text, isString := req.Text.(string)
+	if req.Text != nil && !isString {
+		writeValidationError(w, &fieldError{"text", "invalidType", "Plural strings are not supported"})
+		return
+	}
+	if err := required("text", text == ""); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := required("fileId", req.FileID == 0); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	f := s.files.get(req.FileID)
+	if f == nil || f.ProjectID != project.ID {
+		writeValidationError(w, &fieldError{"fileId", "fileNotFound", "File Not Found"})
+		return
+	}
+	if err := s.validLabels(project.ID, req.LabelIDs); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	identifier := req.Identifier
+	if identifier == "" {
+		sum := md5.Sum([]byte(text)) //nolint:gosec // identifiers are not security sensitive
+		identifier = hex.EncodeToString(sum[:])
+	}
+	for _, str := range s.strings.list(nil) {
+		if str.FileID != nil && *str.FileID == f.ID && str.Identifier == identifier {
+			writeValidationError(w, &fieldError{"identifier", "notUnique", "Identifier must be unique within the file"})
+			return
+		}
+	}
+
+	str := s.newString(f, identifier, text, req.Context, req.LabelIDs)
+	str.IsHidden = req.IsHidden
+	str.MaxLength = req.MaxLength
+
+	writeData(w, http.StatusCreated, str)
+}
+
+func (s *Server) listStrings(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+
+	fileID := queryInt(r, "fileId")
+	directoryID := queryInt(r, "directoryId")
+	labelIDs := queryInts(r, "labelIds")
+	filter := r.URL.Query().Get("filter")
+	scopes := strings.Split(r.URL.Query().Get("scope"), ",")
+	if scopes[0] == "" {
+		scopes = []string{"identifier", "text", "context"}
+	}
+
+	writeList(w, r, s.strings.list(func(str *model.SourceString) bool {
+		switch {
+		case str.ProjectID != project.ID,
+			fileID != 0 && (str.FileID == nil || *str.FileID != fileID),
+			directoryID != 0 && (str.DirectoryID == nil || *str.DirectoryID != directoryID),
+			len(labelIDs) > 0 && !slices.ContainsFunc(labelIDs, func(id int) bool { return slices.Contains(str.LabelIDs, id) }):
+			return false
+		case filter == "":
+			return true
+		}
+
+		for _, scope := range scopes {
+			switch scope {
+			case "identifier":
+				if strings.Contains(str.Identifier, filter) {
+					return true
+				}
+			case "text":
+				if strings.Contains(str.Text, filter) {
+					return true
+				}
+			case "context":
+				if strings.Contains(str.Context, filter) {
+					return true
+				}
+			}
+		}
+		return false
+	}))
+}
+
+func (s *Server) getString(w http.ResponseWriter, r *http.Request) {
+	str, ok := s.findString(w, r)
+	if !ok {
+		return
+	}
+	writeData(w, http.StatusOK, str)
+}
+
+func (s *Server) editString(w http.ResponseWriter, r *http.Request) {
+	str, ok := s.findString(w, r)
+	if !ok {
+		return
+	}
+
+	var ops []*model.UpdateRequest
+	if !decode(w, r, &ops) {
+		return
+	}
+
+	edited := *str
+	if err := patch(&edited, ops, "id", "projectId", "branchId", "fileId", "directoryId", "type",
+		"isDuplicate", "masterStringId", "webUrl", "revision", "createdAt", "updatedAt"); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := required("text", edited.Text == ""); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := s.validLabels(str.ProjectID, edited.LabelIDs); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	updated := now()
+	edited.UpdatedAt = &updated
+	*str = edited
+
+	writeData(w, http.StatusOK, str)
+}
+
+func (s *Server) deleteString(w http.ResponseWriter, r *http.Request) {
+	str, ok := s.findString(w, r)
+	if !ok {
+		return
+	}
+	s.removeString(str)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// newString adds a string to the file.
+func (s *Server) newString(f *file, identifier, text, context string, labelIDs []int) *model.SourceString {
+	created := now()
+	revision := f.RevisionID
+	return s.strings.add(func(id int) *model.SourceString {
+		return &model.SourceString{
+			ID:          id,
+			ProjectID:   f.ProjectID,
+			FileID:      &f.ID,
+			DirectoryID: f.DirectoryID,
+			Identifier:  identifier,
+			Text:        text,
+			Type:        "text",
+			Context:     context,
+			LabelIDs:    attachLabels(nil, labelIDs, nil),
+			WebURL:      fmt.Sprintf("%s/editor/%d/%d#%d", s.URL, f.ProjectID, f.ID, id),
+			CreatedAt:   &created,
+			Revision:    &revision,
+		}
+	})
+}
+
+// removeString deletes the string with its translations.
+func (s *Server) removeString(str *model.SourceString) {
+	s.removeTranslations(func(t *translation) bool { return t.StringID == str.ID })
+	s.strings.delete(str.ID)
+}
+
+// findString returns the string of the request path or writes the 404 error response.
+func (s *Server) findString(w http.ResponseWriter, r *http.Request) (*model.SourceString, bool) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return nil, false
+	}
+	id, ok := pathID(w, r, "stringId", "String")
+	if !ok {
+		return nil, false
+	}
+	str := s.strings.get(id)
+	if str == nil || str.ProjectID != project.ID {
+		writeNotFound(w, "String")
+		return nil, false
+	}
+	return str, true
+}
+
+// validLabels checks that the labels exist in the project.
+func (s *Server) validLabels(projectID int, ids []int) *fieldError {
+	for _, id := range ids {
+		if l := s.labels.get(id); l == nil || l.ProjectID != projectID {
+			return &fieldError{"labelIds", "labelNotFound", fmt.Sprintf("Label %d Not Found", id)}
+		}
+	}
+	return nil
+}
+
+// attachLabels returns the sorted label IDs with the attached
+// and without the detached ones.
+func attachLabels(labelIDs, attach, detach []int) []int {
+	ids := slices.Concat([]int{}, labelIDs, attach)
+	ids = slices.DeleteFunc(ids, func(id int) bool { return slices.Contains(detach, id) })
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
@@ -0,0 +1,295 @@
+package crowdintest
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+// translation is a translation of a source string into a target language.
+type translation struct {
+	model.Translation
+	StringID   int    `json:"-"`
+	LanguageID string `json:"-"`
+}
+
+// user returns the author of the translations and approvals.
+func user() *model.ShortUser {
+	return &model.ShortUser{ID: 1, Username: "crowdintest", FullName: "Crowdin Test"}
+}
+
+func (s *Server) registerTranslations(mux *http.ServeMux) {
+	s.handle(mux, "POST /api/v2/projects/{projectId}/translations", s.addTranslation)
+	s.handle(mux, "GET /api/v2/projects/{projectId}/translations", s.listTranslations)
+	s.handle(mux, "DELETE /api/v2/projects/{projectId}/translations", s.deleteStringTranslations)
+	s.handle(mux, "GET /api/v2/projects/{projectId}/translations/{translationId}", s.getTranslation)
+	s.handle(mux, "DELETE /api/v2/projects/{projectId}/translations/{translationId}", s.deleteTranslation)
+
+	s.handle(mux, "POST /api/v2/projects/{projectId}/approvals", s.addApproval)
+	s.handle(mux, "GET /api/v2/projects/{projectId}/approvals", s.listApprovals)
+	s.handle(mux, "GET /api/v2/projects/{projectId}/approvals/{approvalId}", s.getApproval)
+	s.handle(mux, "DELETE /api/v2/projects/{projectId}/approvals/{approvalId}", s.deleteApproval)
+}
+
+func (s *Server) addTranslation(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+
+	var req model.TranslationAddRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if err := required("stringId", req.StringID == 0); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := required("languageId", req.LanguageID == ""); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := required("text", req.Text == ""); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if str := s.strings.get(req.StringID); str == nil || str.ProjectID != project.ID {
+		writeValidationError(w, &fieldError{"stringId", "stringNotFound", "String Not Found"})
+		return
+	}
+	if !slices.Contains(project.TargetLanguageIDs, req.LanguageID) {
+		writeValidationError(w, &fieldError{"languageId", "notInArray", "Language is not a target language of the project"})
+		return
+	}
+	for _, t := range s.translations.list(nil) {
+		if t.StringID == req.StringID && t.LanguageID == req.LanguageID && t.Text == req.Text {
+			writeValidationError(w, &fieldError{"text", "identicalTranslation", "An identical translation of this string already exists"})
+			return
+		}
+	}
+
+	t := s.translations.add(func(id int) *translation {
+		return &translation{
+			Translation: model.Translation{
+				ID:                 id,
+				Text:               req.Text,
+				PluralCategoryName: req.PluralCategoryName,
+				User:               user(),
+				CreatedAt:          now(),
+			},
+			StringID:   req.StringID,
+			LanguageID: req.LanguageID,
+		}
+	})
+	writeData(w, http.StatusCreated, t.Translation)
+}
+
+func (s *Server) listTranslations(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+
+	stringID := queryInt(r, "stringId")
+	languageID := r.URL.Query().Get("languageId")
+	if err := required("stringId", stringID == 0); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := required("languageId", languageID == ""); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if str := s.strings.get(stringID); str == nil || str.ProjectID != project.ID {
+		writeNotFound(w, "String")
+		return
+	}
+
+	items := make([]*model.Translation, 0)
+	for _, t := range s.translations.list(nil) {
+		if t.StringID == stringID && t.LanguageID == languageID {
+			items = append(items, &t.Translation)
+		}
+	}
+	writeList(w, r, items)
+}
+
+func (s *Server) getTranslation(w http.ResponseWriter, r *http.Request) {
+	t, ok := s.findTranslation(w, r)
+	if !ok {
+		return
+	}
+	writeData(w, http.StatusOK, t.Translation)
+}
+
+func (s *Server) deleteTranslation(w http.ResponseWriter, r *http.Request) {
+	t, ok := s.findTranslation(w, r)
+	if !ok {
+		return
+	}
+	s.removeTranslations(func(other *translation) bool { return other.ID == t.ID })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteStringTranslations deletes the translations of the string into the language.
+func (s *Server) deleteStringTranslations(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+
+	stringID := queryInt(r, "stringId")
+	languageID := r.URL.Query().Get("languageId")
+	if err := required("stringId", stringID == 0); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if err := required("languageId", languageID == ""); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	if str := s.strings.get(stringID); str == nil || str.ProjectID != project.ID {
+		writeNotFound(w, "String")
+		return
+	}
+
+	s.removeTranslations(func(t *translation) bool {
+		return t.StringID == stringID && t.LanguageID == languageID
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) addApproval(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		TranslationID int `json:"translationId"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if err := required("translationId", req.TranslationID == 0); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+	t := s.translations.get(req.TranslationID)
+	if t == nil || s.strings.get(t.StringID).ProjectID != project.ID {
+		writeValidationError(w, &fieldError{"translationId", "translationNotFound", "Translation Not Found"})
+		return
+	}
+	for _, a := range s.approvals.list(nil) {
+		if a.TranslationID == t.ID {
+			writeValidationError(w, &fieldError{"translationId", "alreadyApproved", "Translation is already approved"})
+			return
+		}
+	}
+
+	approval := s.approvals.add(func(id int) *model.Approval {
+		return &model.Approval{
+			ID:            id,
+			User:          user(),
+			TranslationID: t.ID,
+			StringID:      t.StringID,
+			LanguageID:    t.LanguageID,
+			CreatedAt:     now(),
+		}
+	})
+	writeData(w, http.StatusCreated, approval)
+}
+
+func (s *Server) listApprovals(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+
+	translationID := queryInt(r, "translationId")
+	stringID := queryInt(r, "stringId")
+	fileID := queryInt(r, "fileId")
+	languageID := r.URL.Query().Get("languageId")
+
+	writeList(w, r, s.approvals.list(func(a *model.Approval) bool {
+		str := s.strings.get(a.StringID)
+		if str.ProjectID != project.ID {
+			return false
+		}
+		if translationID != 0 {
+			return a.TranslationID == translationID
+		}
+		return (stringID == 0 || a.StringID == stringID) &&
+			(fileID == 0 || str.FileID != nil && *str.FileID == fileID) &&
+			(languageID == "" || a.LanguageID == languageID)
+	}))
+}
+
+func (s *Server) getApproval(w http.ResponseWriter, r *http.Request) {
+	approval, ok := s.findApproval(w, r)
+	if !ok {
+		return
+	}
+	writeData(w, http.StatusOK, approval)
+}
+
+func (s *Server) deleteApproval(w http.ResponseWriter, r *http.Request) {
+	approval, ok := s.findApproval(w, r)
+	if !ok {
+		return
+	}
+	s.approvals.delete(approval.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// removeTranslations deletes the matching translations with their approvals.
+func (s *Server) removeTranslations(match func(*translation) bool) {
+	for _, t := range s.translations.list(match) {
+		s.removeApprovals(func(a *model.Approval) bool { return a.TranslationID == t.ID })
+		s.translations.delete(t.ID)
+	}
+}
+
+// removeApprovals deletes the matching approvals.
+func (s *Server) removeApprovals(match func(*model.Approval) bool) {
+	for _, a := range s.approvals.list(match) {
+		s.approvals.delete(a.ID)
+	}
+}
+
+// findTranslation returns the translation of the request path or writes the 404 error response.
+func (s *Server) findTranslation(w http.ResponseWriter, r *http.Request) (*translation, bool) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return nil, false
+	}
+	id, ok := pathID(w, r, "translationId", "Translation")
+	if !ok {
+		return nil, false
+	}
+	t := s.translations.get(id)
+	if t == nil || s.strings.get(t.StringID).ProjectID != project.ID {
+		writeNotFound(w, "Translation")
+		return nil, false
+	}
+	return t, true
+}
+
+// findApproval returns the approval of the request path or writes the 404 error response.
+func (s *Server) findApproval(w http.ResponseWriter, r *http.Request) (*model.Approval, bool) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return nil, false
+	}
+	id, ok := pathID(w, r, "approvalId", "Approval")
+	if !ok {
+		return nil, false
+	}
+	approval := s.approvals.get(id)
+	if approval == nil || s.strings.get(approval.StringID).ProjectID != project.ID {
+		writeNotFound(w, "Approval")
+		return nil, false
+	}
+	return approval, true
+}
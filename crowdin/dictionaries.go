@@ -23,6 +23,7 @@ type DictionariesService struct {
 func (s *DictionariesService) List(ctx context.Context, projectID int, opts *model.DictionariesListOptions) (
 	[]*model.Dictionary, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Dictionaries.List")
 	res := new(model.DictionariesListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/dictionaries", projectID), opts, res)
 	if err != nil {
@@ -49,6 +50,7 @@ func (s *DictionariesService) List(ctx context.Context, projectID int, opts *mod
 func (s *DictionariesService) Edit(ctx context.Context, projectID int, languageID string, req []*model.UpdateRequest) (
 	*model.Dictionary, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Dictionaries.Edit")
 	res := new(model.DictionaryResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/dictionaries/%s", projectID, languageID), req, res)
 
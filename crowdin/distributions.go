@@ -0,0 +1,158 @@
+package crowdin
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+// DistributionsService provides access to the Distributions API.
+// A distribution is a CDN vault that mirrors the translated content
+// of the project for the over-the-air delivery to mobile apps.
+//
+// The distributions are identified by their hash. The content is
+// available to the apps only after the distribution is released.
+//
+// Crowdin API docs: https://developer.crowdin.com/api/v2/#tag/Distributions
+type DistributionsService struct {
+	client *Client
+}
+
+// List returns a list of distributions in the project.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.distributions.getMany
+func (s *DistributionsService) List(ctx context.Context, projectID int, opts *model.ListOptions) (
+	[]*model.Distribution, *Response, error,
+) {
+	ctx = WithOperationName(ctx, "Distributions.List")
+	res := new(model.DistributionsListResponse)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions", projectID), opts, res)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	list := make([]*model.Distribution, 0, len(res.Data))
+	for _, distribution := range res.Data {
+		list = append(list, distribution.Data)
+	}
+
+	return list, resp, err
+}
+
+// All returns an iterator over all distributions in the project.
+// It fetches the pages with List until a page shorter than the limit is returned.
+func (s *DistributionsService) All(
+	ctx context.Context, projectID int, opts *model.ListOptions,
+) iter.Seq2[*model.Distribution, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, *o, func(ctx context.Context, page model.ListOptions) ([]*model.Distribution, *Response, error) {
+		return s.List(ctx, projectID, &page)
+	})
+}
+
+// ListAll returns all distributions in the project by fetching every page with List.
+func (s *DistributionsService) ListAll(ctx context.Context, projectID int, opts *model.ListOptions) (
+	[]*model.Distribution, error,
+) {
+	return Collect(s.All(ctx, projectID, opts))
+}
+
+// Get returns a distribution by its hash.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.distributions.get
+func (s *DistributionsService) Get(ctx context.Context, projectID int, hash string) (
+	*model.Distribution, *Response, error,
+) {
+	ctx = WithOperationName(ctx, "Distributions.Get")
+	res := new(model.DistributionResponse)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions/%s", projectID, hash), nil, res)
+
+	return res.Data, resp, err
+}
+
+// Add creates a new distribution in the project.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.distributions.post
+func (s *DistributionsService) Add(ctx context.Context, projectID int, req *model.DistributionAddRequest) (
+	*model.Distribution, *Response, error,
+) {
+	ctx = WithOperationName(ctx, "Distributions.Add")
+	res := new(model.DistributionResponse)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions", projectID), req, res)
+
+	return res.Data, resp, err
+}
+
+// Edit updates a distribution by its hash.
+//
+// Request body:
+// - op - operation to perform with the distribution. Enum: replace, test.
+// - path (json-pointer) - path to the field to update.
+// Enum: "/exportMode", "/name", "/fileIds", "/bundleIds".
+// - value - new value for the field.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.distributions.patch
+func (s *DistributionsService) Edit(ctx context.Context, projectID int, hash string, req []*model.UpdateRequest) (
+	*model.Distribution, *Response, error,
+) {
+	ctx = WithOperationName(ctx, "Distributions.Edit")
+	res := new(model.DistributionResponse)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions/%s", projectID, hash), req, res)
+
+	return res.Data, resp, err
+}
+
+// Delete removes a distribution by its hash.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.distributions.delete
+func (s *DistributionsService) Delete(ctx context.Context, projectID int, hash string) (*Response, error) {
+	ctx = WithOperationName(ctx, "Distributions.Delete")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions/%s", projectID, hash))
+}
+
+// Release starts a release of the distribution. Use GetRelease
+// to check the release status, or ReleaseAndWait to wait for it.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.distributions.release.post
+func (s *DistributionsService) Release(ctx context.Context, projectID int, hash string) (
+	*model.DistributionRelease, *Response, error,
+) {
+	ctx = WithOperationName(ctx, "Distributions.Release")
+	res := new(model.DistributionReleaseResponse)
+	// The release request has no body.
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions/%s/release", projectID, hash), "", res)
+
+	return res.Data, resp, err
+}
+
+// GetRelease returns the status of the distribution release.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.distributions.release.get
+func (s *DistributionsService) GetRelease(ctx context.Context, projectID int, hash string) (
+	*model.DistributionRelease, *Response, error,
+) {
+	ctx = WithOperationName(ctx, "Distributions.GetRelease")
+	res := new(model.DistributionReleaseResponse)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions/%s/release", projectID, hash), nil, res)
+
+	return res.Data, resp, err
+}
+
+// ReleaseAndWait releases the distribution and waits until the release is completed.
+func (s *DistributionsService) ReleaseAndWait(ctx context.Context, projectID int, hash string, opts ...WaitOption) (
+	*model.DistributionRelease, error,
+) {
+	return startAndWait(ctx,
+		func() (*model.DistributionRelease, *Response, error) {
+			return s.Release(ctx, projectID, hash)
+		},
+		func(*model.DistributionRelease) PollFunc[*model.DistributionRelease] {
+			return func(ctx context.Context) (*model.DistributionRelease, *Response, error) {
+				return s.GetRelease(ctx, projectID, hash)
+			}
+		},
+		opts,
+	)
+}
@@ -0,0 +1,281 @@
+package crowdin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const distributionJSON = `{
+	"data": {
+		"hash": "50fb3506-4127-4ba8-8296-f97dc7e3e0c3",
+		"name": "Mobile",
+		"exportMode": "bundle",
+		"fileIds": [],
+		"bundleIds": [45, 62],
+		"createdAt": "2023-09-16T13:48:04+00:00",
+		"updatedAt": "2023-09-19T13:25:27+00:00"
+	}
+}`
+
+func expectedDistribution() *model.Distribution {
+	return &model.Distribution{
+		Hash:       "50fb3506-4127-4ba8-8296-f97dc7e3e0c3",
+		Name:       "Mobile",
+		ExportMode: model.DistributionExportModeBundle,
+		FileIDs:    []int{},
+		BundleIDs:  []int{45, 62},
+		CreatedAt:  testTime("2023-09-16T13:48:04+00:00"),
+		UpdatedAt:  testTime("2023-09-19T13:25:27+00:00"),
+	}
+}
+
+func TestDistributionsService_List(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/projects/1/distributions"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, path+"?limit=25&offset=25")
+
+		fmt.Fprintf(w, `{
+			"data": [%s],
+			"pagination": {
+				"offset": 25,
+				"limit": 25
+			}
+		}`, distributionJSON)
+	})
+
+	distributions, resp, err := client.Distributions.List(context.Background(), 1, &model.ListOptions{Offset: 25, Limit: 25})
+	require.NoError(t, err)
+
+	assert.Equal(t, []*model.Distribution{expectedDistribution()}, distributions)
+	assert.Equal(t, 25, resp.Pagination.Offset)
+}
+
+func TestDistributionsService_Get(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/projects/1/distributions/50fb3506-4127-4ba8-8296-f97dc7e3e0c3"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, path)
+
+		fmt.Fprint(w, distributionJSON)
+	})
+
+	distribution, resp, err := client.Distributions.Get(context.Background(), 1, "50fb3506-4127-4ba8-8296-f97dc7e3e0c3")
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	assert.Equal(t, expectedDistribution(), distribution)
+}
+
+func TestDistributionsService_Add(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/projects/1/distributions"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testURL(t, r, path)
+		testBody(t, r, `{"name":"Mobile","exportMode":"bundle","bundleIds":[45,62]}`+"\n")
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, distributionJSON)
+	})
+
+	req := &model.DistributionAddRequest{
+		Name:       "Mobile",
+		ExportMode: model.DistributionExportModeBundle,
+		BundleIDs:  []int{45, 62},
+	}
+	distribution, resp, err := client.Distributions.Add(context.Background(), 1, req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	assert.Equal(t, expectedDistribution(), distribution)
+}
+
+func TestDistributionsService_Add_WithValidationErrors(t *testing.T) {
+	tests := []struct {
+		req         *model.DistributionAddRequest
+		expectedErr string
+	}{
+		{
+			req:         nil,
+			expectedErr: "request cannot be nil",
+		},
+		{
+			req:         &model.DistributionAddRequest{FileIDs: []int{1}},
+			expectedErr: "name is required",
+		},
+		{
+			req:         &model.DistributionAddRequest{Name: "Mobile"},
+			expectedErr: "fileIds is required for the default export mode",
+		},
+		{
+			req:         &model.DistributionAddRequest{Name: "Mobile", FileIDs: []int{1}, BundleIDs: []int{45}},
+			expectedErr: "bundleIds can be used only with the bundle export mode",
+		},
+		{
+			req:         &model.DistributionAddRequest{Name: "Mobile", ExportMode: model.DistributionExportModeBundle},
+			expectedErr: "bundleIds is required for the bundle export mode",
+		},
+		{
+			req: &model.DistributionAddRequest{
+				Name:       "Mobile",
+				ExportMode: model.DistributionExportModeBundle,
+				FileIDs:    []int{1},
+				BundleIDs:  []int{45},
+			},
+			expectedErr: "fileIds can be used only with the default export mode",
+		},
+		{
+			req:         &model.DistributionAddRequest{Name: "Mobile", ExportMode: "files", FileIDs: []int{1}},
+			expectedErr: `exportMode must be one of "default", "bundle"`,
+		},
+	}
+
+	for _, tt := range tests {
+		assert.EqualError(t, tt.req.Validate(), tt.expectedErr)
+	}
+
+	valid := &model.DistributionAddRequest{Name: "Mobile", ExportMode: model.DistributionExportModeDefault, FileIDs: []int{1}}
+	assert.NoError(t, valid.Validate())
+}
+
+func TestDistributionsService_Edit(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/projects/1/distributions/50fb3506-4127-4ba8-8296-f97dc7e3e0c3"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testURL(t, r, path)
+		testBody(t, r, `[{"op":"replace","path":"/bundleIds","value":[45,62]}]`+"\n")
+
+		fmt.Fprint(w, distributionJSON)
+	})
+
+	req := []*model.UpdateRequest{
+		{
+			Op:    "replace",
+			Path:  "/bundleIds",
+			Value: []int{45, 62},
+		},
+	}
+	distribution, resp, err := client.Distributions.Edit(context.Background(), 1, "50fb3506-4127-4ba8-8296-f97dc7e3e0c3", req)
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	assert.Equal(t, expectedDistribution(), distribution)
+}
+
+func TestDistributionsService_Delete(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/projects/1/distributions/50fb3506-4127-4ba8-8296-f97dc7e3e0c3"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testURL(t, r, path)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.Distributions.Delete(context.Background(), 1, "50fb3506-4127-4ba8-8296-f97dc7e3e0c3")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestDistributionsService_Release(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/projects/1/distributions/50fb3506-4127-4ba8-8296-f97dc7e3e0c3/release"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testURL(t, r, path)
+
+		if r.Method == http.MethodPost {
+			testBody(t, r, "")
+			fmt.Fprint(w, `{"data": {"status": "inProgress", "progress": 0, "currentLanguageId": "", "currentFileId": 0,
+				"date": "2023-09-16T13:48:04+00:00"}}`)
+			return
+		}
+
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": {"status": "inProgress", "progress": 40, "currentLanguageId": "uk", "currentFileId": 48,
+			"date": "2023-09-16T13:48:04+00:00"}}`)
+	})
+
+	release, resp, err := client.Distributions.Release(context.Background(), 1, "50fb3506-4127-4ba8-8296-f97dc7e3e0c3")
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, model.DistributionReleaseStatusInProgress, release.Status)
+
+	release, _, err = client.Distributions.GetRelease(context.Background(), 1, "50fb3506-4127-4ba8-8296-f97dc7e3e0c3")
+	require.NoError(t, err)
+
+	assert.Equal(t, &model.DistributionRelease{
+		Status:            model.DistributionReleaseStatusInProgress,
+		Progress:          40,
+		CurrentLanguageID: "uk",
+		CurrentFileID:     48,
+		Date:              testTime("2023-09-16T13:48:04+00:00"),
+	}, release)
+}
+
+func TestDistributionsService_ReleaseAndWait(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      string
+		expectedErr error
+	}{
+		{name: "success", status: "success", expectedErr: nil},
+		{name: "failed", status: "failed", expectedErr: ErrOperationFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux, teardown := setupClient()
+			defer teardown()
+
+			checks := 0
+			mux.HandleFunc("/api/v2/projects/1/distributions/abc/release", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					fmt.Fprint(w, `{"data": {"status": "inProgress", "progress": 0}}`)
+					return
+				}
+
+				if checks++; checks < 2 {
+					fmt.Fprint(w, `{"data": {"status": "inProgress", "progress": 60}}`)
+					return
+				}
+				fmt.Fprintf(w, `{"data": {"status": %q, "progress": 100}}`, tt.status)
+			})
+
+			var progress []int
+			release, err := client.Distributions.ReleaseAndWait(context.Background(), 1, "abc",
+				PollInterval(time.Millisecond),
+				OnProgress(func(_ model.OperationStatus, p int) {
+					progress = append(progress, p)
+				}),
+			)
+			require.ErrorIs(t, err, tt.expectedErr)
+
+			assert.Equal(t, model.DistributionReleaseStatus(tt.status), release.Status)
+			assert.Equal(t, []int{0, 60, 100}, progress)
+			assert.Equal(t, 2, checks)
+		})
+	}
+}
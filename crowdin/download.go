@@ -0,0 +1,370 @@
+package crowdin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+const (
+	defaultFetchAttempts = 3
+	// linkExpirySkew is the margin before the link expiration time
+	// when the link is considered expired.
+	linkExpirySkew = 5 * time.Second
+)
+
+// ErrDownloadChanged is returned by Client.Fetch when the downloaded content
+// does not match the ETag of the download link, or has changed between
+// the attempts, so the download cannot be resumed.
+var ErrDownloadChanged = errors.New("download: content changed since the download started")
+
+// FetchOption configures a download made with Client.Fetch.
+type FetchOption func(*fetchConfig)
+
+type fetchConfig struct {
+	attempts   int
+	onProgress func(written, total int64)
+	refresh    func(ctx context.Context) (*model.DownloadLink, error)
+}
+
+// FetchAttempts sets the maximum number of attempts to download the content
+// (default 3 or the MaxAttempts of the client retry policy, if set).
+func FetchAttempts(n int) FetchOption {
+	return func(c *fetchConfig) {
+		if n > 0 {
+			c.attempts = n
+		}
+	}
+}
+
+// FetchProgress sets a callback which is called every time a chunk of
+// the content is written. `total` is -1 if the content length is unknown.
+func FetchProgress(fn func(written, total int64)) FetchOption {
+	return func(c *fetchConfig) {
+		c.onProgress = fn
+	}
+}
+
+// RefreshLink sets a function requesting a new download link.
+// It is used when the link has expired (see DownloadLink.ExpireIn) or
+// the storage rejects it.
+//
+// Example:
+//
+//	crowdin.RefreshLink(func(ctx context.Context) (*model.DownloadLink, error) {
+//		link, _, err := client.Translations.DownloadProjectTranslations(ctx, projectID, buildID)
+//		return link, err
+//	})
+func RefreshLink(fn func(ctx context.Context) (*model.DownloadLink, error)) FetchOption {
+	return func(c *fetchConfig) {
+		c.refresh = fn
+	}
+}
+
+// Fetch downloads the content of the download link (as returned by methods like
+// TranslationsService.DownloadProjectTranslations or SourceFilesService.DownloadFile)
+// and streams it to w. It returns the number of bytes written.
+//
+// If the link has an ETag, it is compared with the ETag of the content, and
+// ErrDownloadChanged is returned if they differ.
+//
+// If the download fails after a part of the content has been written, it is
+// resumed with a Range request. The ETag of the content is sent in the If-Range
+// header, so a resumed download never mixes different versions of the content
+// (ErrDownloadChanged is returned instead). The delay between attempts follows the
+// client retry policy. If the link has expired, a new one is requested with the
+// function set by the RefreshLink option.
+//
+// The Authorization header is sent only if the link points to the API host.
+// Only such requests wait for the client rate limiter and pass through the
+// client middleware, named "Fetch" (see OperationName). The links to the storage
+// are pre-signed, so their URLs are kept out of the middleware, e.g. logs.
+func (c *Client) Fetch(ctx context.Context, link *model.DownloadLink, w io.Writer, opts ...FetchOption) (int64, error) {
+	if link == nil || link.URL == "" {
+		return 0, errors.New("download: link cannot be empty")
+	}
+	if w == nil {
+		return 0, errors.New("download: writer cannot be nil")
+	}
+
+	cfg := &fetchConfig{attempts: defaultFetchAttempts}
+	if c.retryPolicy != nil && c.retryPolicy.MaxAttempts > 0 {
+		cfg.attempts = c.retryPolicy.MaxAttempts
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	policy := c.retryPolicy
+	if policy == nil {
+		policy = &RetryPolicy{MinBackoff: defaultMinBackoff, MaxBackoff: defaultMaxBackoff}
+	}
+
+	d := &download{client: c, link: link, w: w, total: -1, onProgress: cfg.onProgress, canRefresh: cfg.refresh != nil}
+	for attempt := 1; ; attempt++ {
+		if cfg.refresh != nil && (d.refresh || linkExpired(d.link)) {
+			newLink, err := cfg.refresh(ctx)
+			if err != nil {
+				return d.written, fmt.Errorf("download: error refreshing link: %w", err)
+			}
+			if newLink == nil || newLink.URL == "" {
+				return d.written, errors.New("download: refreshed link is empty")
+			}
+			if d.written > 0 && !linkETagMatches(newLink, d.etag) {
+				return d.written, ErrDownloadChanged
+			}
+			d.link, d.refresh = newLink, false
+		}
+
+		err := d.fetch(ctx)
+		if err == nil || !d.retry || attempt >= cfg.attempts {
+			return d.written, err
+		}
+
+		delay := policy.backoff(attempt)
+		if d.retryAfter > 0 {
+			if !policy.allowsDelay(d.retryAfter) {
+				return d.written, err
+			}
+			delay = d.retryAfter
+		}
+		if serr := sleep(ctx, delay); serr != nil {
+			return d.written, err
+		}
+	}
+}
+
+// download holds the state of a download between the attempts.
+type download struct {
+	client     *Client
+	link       *model.DownloadLink
+	w          io.Writer
+	onProgress func(written, total int64)
+
+	written int64
+	total   int64
+	etag    string
+
+	canRefresh bool
+
+	// Outcome of the last attempt.
+	retry      bool
+	refresh    bool
+	retryAfter time.Duration
+}
+
+// fetch makes a single attempt to download the rest of the content.
+func (d *download) fetch(ctx context.Context) error {
+	d.retry, d.refresh, d.retryAfter = false, false, 0
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.link.URL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", d.client.userAgent)
+	if d.written > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", d.written))
+		if d.etag != "" {
+			req.Header.Set("If-Range", d.etag)
+		}
+	}
+
+	// The links to the storage are pre-signed, so their URLs must not reach
+	// the middleware (e.g. logs), and the storage is not subject to the API
+	// rate limit.
+	if !d.client.isAPIURL(req.URL) {
+		_, err = d.send(req, nil)
+		return err
+	}
+
+	if err := d.client.authorize(req); err != nil {
+		return err
+	}
+	if err := d.client.rateLimiter.wait(ctx); err != nil {
+		return err
+	}
+	if OperationName(ctx) == "" {
+		req = req.WithContext(WithOperationName(ctx, "Fetch"))
+	}
+	resp, err := chain(DoerFunc(d.send), d.client.middleware).Do(req, nil)
+	d.client.rateLimiter.update(resp)
+	return err
+}
+
+// send sends the download request and streams the response body
+// to the writer. It implements the Doer signature, so the request
+// can be passed through the client middleware.
+func (d *download) send(req *http.Request, _ any) (*Response, error) {
+	resp, err := d.client.httpClient.Do(req)
+	if err != nil {
+		d.retry = isTransientError(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	response := &Response{Response: resp}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if err := d.start(resp); err != nil {
+			return response, err
+		}
+		if d.written > 0 {
+			// The server ignored the Range header, so skip the written part.
+			if _, err := io.CopyN(io.Discard, resp.Body, d.written); err != nil {
+				d.retry = isTransientError(err) || errors.Is(err, io.EOF)
+				return response, fmt.Errorf("download: %w", err)
+			}
+		}
+	case http.StatusPartialContent:
+		if start, total, ok := parseContentRange(resp.Header.Get("Content-Range")); !ok || start != d.written {
+			return response, fmt.Errorf("download: unexpected content range %q", resp.Header.Get("Content-Range"))
+		} else if total >= 0 {
+			d.total = total
+		}
+	case http.StatusRequestedRangeNotSatisfiable:
+		if d.written > 0 && d.written == d.total {
+			return response, nil
+		}
+		return response, downloadError(resp)
+	default:
+		// A rejected link is retried only if a new link can be requested.
+		d.refresh = resp.StatusCode == http.StatusForbidden && d.canRefresh
+		d.retry = isRetryableStatus(resp.StatusCode) || d.refresh
+		if d.retry {
+			d.retryAfter, _ = retryAfter(resp)
+		}
+		return response, downloadError(resp)
+	}
+
+	_, err = io.Copy(&progressWriter{d: d}, resp.Body)
+	if err != nil {
+		var werr *writeError
+		if errors.As(err, &werr) {
+			return response, werr.err
+		}
+		d.retry = !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+		return response, fmt.Errorf("download: %w", err)
+	}
+	if d.total >= 0 && d.written < d.total {
+		d.retry = true
+		return response, fmt.Errorf("download: %w", io.ErrUnexpectedEOF)
+	}
+
+	return response, nil
+}
+
+// downloadError returns an error for the unexpected status of the download response.
+// The error matches the status-based errors of the model package (e.g. model.ErrNotFound).
+func downloadError(resp *http.Response) error {
+	return fmt.Errorf("download: %w", model.NewStatusErrorResponse(resp))
+}
+
+// start validates a full (200 OK) response against the state of the download.
+func (d *download) start(resp *http.Response) error {
+	etag := resp.Header.Get("ETag")
+	if d.written > 0 && (d.etag == "" || etag != d.etag) {
+		return ErrDownloadChanged
+	}
+	if !linkETagMatches(d.link, etag) {
+		return ErrDownloadChanged
+	}
+
+	d.etag = etag
+	d.total = resp.ContentLength
+	return nil
+}
+
+// progressWriter writes the content to the download writer
+// and reports the progress.
+type progressWriter struct {
+	d *download
+}
+
+func (pw *progressWriter) Write(p []byte) (int, error) {
+	n, err := pw.d.w.Write(p)
+	pw.d.written += int64(n)
+	if n > 0 && pw.d.onProgress != nil {
+		pw.d.onProgress(pw.d.written, pw.d.total)
+	}
+	if err != nil {
+		return n, &writeError{err: err}
+	}
+	return n, nil
+}
+
+// writeError distinguishes the errors of the destination writer
+// from the errors of reading the response body.
+type writeError struct {
+	err error
+}
+
+func (e *writeError) Error() string { return e.err.Error() }
+
+func (e *writeError) Unwrap() error { return e.err }
+
+// parseContentRange parses the `Content-Range: bytes start-end/total` header.
+// The total is -1 if it is unknown.
+func parseContentRange(v string) (start, total int64, ok bool) {
+	v, found := strings.CutPrefix(v, "bytes ")
+	if !found {
+		return 0, 0, false
+	}
+	rng, size, found := strings.Cut(v, "/")
+	if !found {
+		return 0, 0, false
+	}
+	first, _, found := strings.Cut(rng, "-")
+	if !found {
+		return 0, 0, false
+	}
+
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	total = -1
+	if size != "*" {
+		if total, err = strconv.ParseInt(size, 10, 64); err != nil {
+			return 0, 0, false
+		}
+	}
+
+	return start, total, true
+}
+
+// linkETagMatches reports whether the ETag of the content matches the ETag
+// of the download link. The links without an ETag match any content.
+// The ETags are compared without the weak prefix and the quotes, since
+// the API may return them unquoted.
+func linkETagMatches(link *model.DownloadLink, etag string) bool {
+	if link.Etag == nil || *link.Etag == "" || etag == "" {
+		return true
+	}
+	return normalizeETag(*link.Etag) == normalizeETag(etag)
+}
+
+func normalizeETag(etag string) string {
+	return strings.Trim(strings.TrimPrefix(etag, "W/"), `"`)
+}
+
+// linkExpired reports whether the download link has expired.
+func linkExpired(link *model.DownloadLink) bool {
+	if link.ExpireIn.IsZero() {
+		return false
+	}
+	return time.Now().Add(linkExpirySkew).After(link.ExpireIn.Time)
+}
+
+// isAPIURL reports whether the URL points to the API host of the client.
+func (c *Client) isAPIURL(u *url.URL) bool {
+	return u.Host == c.baseURL.Host
+}
@@ -0,0 +1,273 @@
+package crowdin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const downloadContent = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// serveContent serves the download content. The first `cut` bytes are sent
+// with a full Content-Length and the connection is then closed if cut > 0.
+func serveContent(t *testing.T, w http.ResponseWriter, r *http.Request, etag string, cut int) {
+	t.Helper()
+
+	content := downloadContent
+	w.Header().Set("ETag", etag)
+	if rng := r.Header.Get("Range"); rng != "" {
+		var start int
+		_, err := fmt.Sscanf(rng, "bytes=%d-", &start)
+		require.NoError(t, err)
+
+		if r.Header.Get("If-Range") == etag {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, len(content)-1, len(content)))
+			w.Header().Set("Content-Length", strconv.Itoa(len(content)-start))
+			w.WriteHeader(http.StatusPartialContent)
+			fmt.Fprint(w, content[start:])
+			return
+		}
+	}
+
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	if cut > 0 {
+		fmt.Fprint(w, content[:cut])
+		return
+	}
+	fmt.Fprint(w, content)
+}
+
+func TestClient_Fetch(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, "Authorization", "Bearer access_token")
+		serveContent(t, w, r, `"v1"`, 0)
+	})
+
+	var progress []int64
+	buf := new(bytes.Buffer)
+	link := &model.DownloadLink{URL: client.baseURL.String() + "download"}
+	n, err := client.Fetch(context.Background(), link, buf, FetchProgress(func(written, total int64) {
+		assert.Equal(t, int64(len(downloadContent)), total)
+		progress = append(progress, written)
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(len(downloadContent)), n)
+	assert.Equal(t, downloadContent, buf.String())
+	require.NotEmpty(t, progress)
+	assert.Equal(t, n, progress[len(progress)-1])
+}
+
+func TestClient_Fetch_ExternalHost(t *testing.T) {
+	client, _, teardown := setupClient()
+	defer teardown()
+
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"), "the token must not be sent to other hosts")
+		serveContent(t, w, r, `"v1"`, 0)
+	}))
+	defer storage.Close()
+
+	buf := new(bytes.Buffer)
+	_, err := client.Fetch(context.Background(), &model.DownloadLink{URL: storage.URL + "/file.zip"}, buf)
+	require.NoError(t, err)
+	assert.Equal(t, downloadContent, buf.String())
+}
+
+func TestClient_Fetch_Resume(t *testing.T) {
+	client, mux, teardown := setupClient(WithRetryPolicy(testRetryPolicy()))
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/download", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		switch attempts {
+		case 1:
+			serveContent(t, w, r, `"v1"`, 10)
+		case 2:
+			testHeader(t, r, "Range", "bytes=10-")
+			testHeader(t, r, "If-Range", `"v1"`)
+			serveContent(t, w, r, `"v1"`, 0)
+		default:
+			t.Errorf("unexpected attempt: %d", attempts)
+		}
+	})
+
+	buf := new(bytes.Buffer)
+	n, err := client.Fetch(context.Background(), &model.DownloadLink{URL: client.baseURL.String() + "download"}, buf)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(len(downloadContent)), n)
+	assert.Equal(t, downloadContent, buf.String())
+	assert.Equal(t, 2, attempts)
+}
+
+func TestClient_Fetch_ContentChanged(t *testing.T) {
+	client, mux, teardown := setupClient(WithRetryPolicy(testRetryPolicy()))
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/download", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			serveContent(t, w, r, `"v1"`, 10)
+			return
+		}
+		serveContent(t, w, r, `"v2"`, 0)
+	})
+
+	buf := new(bytes.Buffer)
+	n, err := client.Fetch(context.Background(), &model.DownloadLink{URL: client.baseURL.String() + "download"}, buf)
+	require.ErrorIs(t, err, ErrDownloadChanged)
+	assert.Equal(t, int64(10), n)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestClient_Fetch_RefreshLink(t *testing.T) {
+	client, mux, teardown := setupClient(WithRetryPolicy(testRetryPolicy()))
+	defer teardown()
+
+	mux.HandleFunc("/expired", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	mux.HandleFunc("/fresh", func(w http.ResponseWriter, r *http.Request) {
+		serveContent(t, w, r, `"v1"`, 0)
+	})
+
+	refreshes := 0
+	refresh := RefreshLink(func(context.Context) (*model.DownloadLink, error) {
+		refreshes++
+		return &model.DownloadLink{
+			URL:      client.baseURL.String() + "fresh",
+			ExpireIn: model.Time{Time: time.Now().Add(time.Hour)},
+		}, nil
+	})
+
+	tests := []struct {
+		name string
+		link *model.DownloadLink
+	}{
+		{
+			name: "expired link",
+			link: &model.DownloadLink{
+				URL:      client.baseURL.String() + "expired",
+				ExpireIn: model.Time{Time: time.Now().Add(-time.Minute)},
+			},
+		},
+		{
+			name: "rejected link",
+			link: &model.DownloadLink{
+				URL:      client.baseURL.String() + "expired",
+				ExpireIn: model.Time{Time: time.Now().Add(time.Hour)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			refreshes = 0
+			buf := new(bytes.Buffer)
+			_, err := client.Fetch(context.Background(), tt.link, buf, refresh)
+			require.NoError(t, err)
+
+			assert.Equal(t, downloadContent, buf.String())
+			assert.Equal(t, 1, refreshes)
+		})
+	}
+}
+
+func TestClient_Fetch_Error(t *testing.T) {
+	client, mux, teardown := setupClient(WithRetryPolicy(testRetryPolicy()))
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/download", func(w http.ResponseWriter, _ *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.Fetch(context.Background(), &model.DownloadLink{URL: client.baseURL.String() + "download"}, new(bytes.Buffer))
+	require.EqualError(t, err, "download: 404 Not Found")
+	assert.True(t, IsNotFound(err))
+	assert.Equal(t, 1, attempts)
+
+	_, err = client.Fetch(context.Background(), nil, new(bytes.Buffer))
+	require.EqualError(t, err, "download: link cannot be empty")
+}
+
+func TestClient_Fetch_LinkETag(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/download", func(w http.ResponseWriter, r *http.Request) {
+		serveContent(t, w, r, `"v2"`, 0)
+	})
+
+	link := &model.DownloadLink{URL: client.baseURL.String() + "download", Etag: ToPtr("v1")}
+	buf := new(bytes.Buffer)
+	n, err := client.Fetch(context.Background(), link, buf)
+	require.ErrorIs(t, err, ErrDownloadChanged)
+	assert.Zero(t, n)
+	assert.Empty(t, buf.String())
+
+	link.Etag = ToPtr("v2")
+	_, err = client.Fetch(context.Background(), link, buf)
+	require.NoError(t, err)
+	assert.Equal(t, downloadContent, buf.String())
+}
+
+func TestClient_Fetch_Forbidden(t *testing.T) {
+	client, mux, teardown := setupClient(WithRetryPolicy(testRetryPolicy()))
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/download", func(w http.ResponseWriter, _ *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := client.Fetch(context.Background(), &model.DownloadLink{URL: client.baseURL.String() + "download"}, new(bytes.Buffer))
+	require.EqualError(t, err, "download: 403 Forbidden")
+	assert.Equal(t, 1, attempts, "a rejected link must not be retried without RefreshLink")
+}
+
+func TestClient_Fetch_Middleware(t *testing.T) {
+	var operations []string
+	mw := func(next Doer) Doer {
+		return DoerFunc(func(r *http.Request, v any) (*Response, error) {
+			resp, err := next.Do(r, v)
+			operations = append(operations, fmt.Sprintf("%s %d", OperationName(r.Context()), resp.StatusCode))
+			return resp, err
+		})
+	}
+	client, mux, teardown := setupClient(WithMiddleware(mw))
+	defer teardown()
+
+	mux.HandleFunc("/download", func(w http.ResponseWriter, r *http.Request) {
+		serveContent(t, w, r, `"v1"`, 0)
+	})
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serveContent(t, w, r, `"v1"`, 0)
+	}))
+	defer storage.Close()
+
+	_, err := client.Fetch(context.Background(), &model.DownloadLink{URL: client.baseURL.String() + "download"}, new(bytes.Buffer))
+	require.NoError(t, err)
+	_, err = client.Fetch(context.Background(), &model.DownloadLink{URL: storage.URL + "/file.zip"}, new(bytes.Buffer))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Fetch 200"}, operations)
+}
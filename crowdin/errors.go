@@ -0,0 +1,50 @@
+package crowdin
+
+import (
+	"errors"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+// IsNotFound reports whether the error is an API error
+// with the 404 Not Found status.
+func IsNotFound(err error) bool {
+	return errors.Is(err, model.ErrNotFound)
+}
+
+// IsUnauthorized reports whether the error is an API error
+// with the 401 Unauthorized status (e.g. the token is invalid or expired).
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, model.ErrUnauthorized)
+}
+
+// IsForbidden reports whether the error is an API error
+// with the 403 Forbidden status.
+func IsForbidden(err error) bool {
+	return errors.Is(err, model.ErrForbidden)
+}
+
+// IsConflict reports whether the error is an API error
+// with the 409 Conflict status.
+func IsConflict(err error) bool {
+	return errors.Is(err, model.ErrConflict)
+}
+
+// IsRateLimited reports whether the error is an API error
+// with the 429 Too Many Requests status.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, model.ErrRateLimited)
+}
+
+// IsValidation reports whether the error is a validation error of the API.
+// Use errors.As with *model.ValidationErrorResponse to get the field errors:
+//
+//	var verr *model.ValidationErrorResponse
+//	if errors.As(err, &verr) {
+//		for field, errs := range verr.FieldErrors() {
+//			...
+//		}
+//	}
+func IsValidation(err error) bool {
+	return errors.Is(err, model.ErrValidation)
+}
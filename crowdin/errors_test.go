@@ -0,0 +1,101 @@
+package crowdin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorHelpers(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, r *http.Request) {
+		status, _ := strconv.Atoi(r.URL.Query().Get("status"))
+		w.WriteHeader(status)
+		if r.URL.Query().Has("html") {
+			fmt.Fprintf(w, "<html>%s</html>", http.StatusText(status))
+			return
+		}
+		fmt.Fprintf(w, `{"error": {"code": %d, "message": "%s"}}`, status, http.StatusText(status))
+	})
+
+	tests := []struct {
+		status int
+		is     func(error) bool
+	}{
+		{status: http.StatusNotFound, is: IsNotFound},
+		{status: http.StatusUnauthorized, is: IsUnauthorized},
+		{status: http.StatusForbidden, is: IsForbidden},
+		{status: http.StatusConflict, is: IsConflict},
+		{status: http.StatusTooManyRequests, is: IsRateLimited},
+	}
+	helpers := []func(error) bool{IsNotFound, IsUnauthorized, IsForbidden, IsConflict, IsRateLimited, IsValidation}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			_, err := client.Get(context.Background(), fmt.Sprintf("/api/v2/storages/1?status=%d", tt.status), nil, nil)
+			require.Error(t, err)
+
+			matches := 0
+			for _, is := range helpers {
+				if is(err) {
+					matches++
+				}
+			}
+			assert.True(t, tt.is(err))
+			assert.Equal(t, 1, matches, "exactly one helper should match")
+		})
+	}
+
+	t.Run("not an API error body", func(t *testing.T) {
+		_, err := client.Get(context.Background(), "/api/v2/storages/1?status=502&html", nil, nil)
+		require.EqualError(t, err, "502 Bad Gateway")
+
+		var errResp *model.ErrorResponse
+		require.ErrorAs(t, err, &errResp)
+		assert.Equal(t, http.StatusBadGateway, errResp.StatusCode())
+
+		_, err = client.Get(context.Background(), "/api/v2/storages/1?status=400&html", nil, nil)
+		require.EqualError(t, err, "400 Bad Request")
+		assert.False(t, IsValidation(err), "no validation errors were returned")
+
+		_, err = client.Get(context.Background(), "/api/v2/storages/1?status=404&html", nil, nil)
+		assert.True(t, IsNotFound(err))
+	})
+
+	t.Run("other errors", func(t *testing.T) {
+		for _, is := range helpers {
+			assert.False(t, is(nil))
+			assert.False(t, is(errors.New("not found")))
+		}
+	})
+}
+
+func TestIsValidation(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/projects", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errors": [{"error": {"key": "name", "errors": [{"code": "isEmpty", "message": "Value is required"}]}}]}`)
+	})
+
+	_, _, err := client.Projects.Add(context.Background(), &model.ProjectsAddRequest{Name: "test", SourceLanguageID: "en"})
+	require.Error(t, err)
+	assert.True(t, IsValidation(err))
+	assert.False(t, IsNotFound(err))
+
+	var verr *model.ValidationErrorResponse
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, map[string][]model.Error{
+		"name": {{Code: "isEmpty", Message: "Value is required"}},
+	}, verr.FieldErrors())
+}
@@ -0,0 +1,314 @@
+package crowdin
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+// ExtractManifest describes the changes made by Client.Extract to the target directory.
+// The paths are relative to the target directory and use forward slashes.
+type ExtractManifest struct {
+	// Added contains the files which did not exist before.
+	Added []string
+	// Modified contains the existing files whose content has been replaced.
+	Modified []string
+	// Unchanged contains the existing files with the same content as in the archive.
+	Unchanged []string
+	// Stale contains the existing files selected by the language and path
+	// filters which are not in the archive, e.g. the translations of removed
+	// source files. Only the directories which contain files in the archive
+	// are scanned, not their subdirectories. Stale files are left in place.
+	Stale []string
+}
+
+// Changed reports whether any file has been added or modified.
+func (m *ExtractManifest) Changed() bool {
+	return len(m.Added) > 0 || len(m.Modified) > 0
+}
+
+// ExtractOption configures the extraction of an archive made with Client.Extract.
+type ExtractOption func(*extractConfig)
+
+type extractConfig struct {
+	languages map[string]bool
+	patterns  []string
+	fetch     []FetchOption
+}
+
+// ExtractLanguages limits the extraction to the files of the given languages.
+// A file belongs to a language if one of its parent directories is named
+// after the language identifier (e.g. "uk/main.json" or "locales/pt-BR/main.json").
+func ExtractLanguages(languageIDs ...string) ExtractOption {
+	return func(c *extractConfig) {
+		if c.languages == nil {
+			c.languages = make(map[string]bool, len(languageIDs))
+		}
+		for _, id := range languageIDs {
+			c.languages[id] = true
+		}
+	}
+}
+
+// ExtractPaths limits the extraction to the files matching any of the glob
+// patterns (see path.Match). The patterns are matched against the path of the
+// file in the archive. A pattern ending with "/**" matches all files under
+// the directory (e.g. "uk/docs/**" or "*/docs/**").
+func ExtractPaths(patterns ...string) ExtractOption {
+	return func(c *extractConfig) {
+		c.patterns = append(c.patterns, patterns...)
+	}
+}
+
+// ExtractFetchOptions sets the options used to download the archive
+// (e.g. FetchProgress or RefreshLink).
+func ExtractFetchOptions(opts ...FetchOption) ExtractOption {
+	return func(c *extractConfig) {
+		c.fetch = append(c.fetch, opts...)
+	}
+}
+
+// Extract downloads the ZIP archive of the download link (e.g. a translations build)
+// and extracts its files into dir. It returns the manifest of the changes
+// made to the directory tree.
+//
+// The archive is downloaded into a temporary file with Client.Fetch. Entries with
+// paths escaping dir (zip-slip) make Extract fail before any file is written.
+// Each file is written into a temporary file in the same directory and then
+// renamed, so existing files are replaced atomically and files with the same
+// content are not touched. Files which exist in the directories of the archive
+// but not in the archive itself are left in place and listed in the manifest
+// as stale; other files of dir (e.g. ".git" or the source files) are ignored.
+func (c *Client) Extract(ctx context.Context, link *model.DownloadLink, dir string, opts ...ExtractOption) (
+	*ExtractManifest, error,
+) {
+	if dir == "" {
+		return nil, errors.New("extract: directory cannot be empty")
+	}
+
+	cfg := &extractConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	tmp, err := os.CreateTemp("", "crowdin-*.zip")
+	if err != nil {
+		return nil, fmt.Errorf("extract: %w", err)
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+
+	size, err := c.Fetch(ctx, link, tmp, cfg.fetch...)
+	if err != nil {
+		return nil, err
+	}
+
+	zr, err := zip.NewReader(tmp, size)
+	if err != nil {
+		return nil, fmt.Errorf("extract: %w", err)
+	}
+
+	return extractArchive(zr, dir, cfg)
+}
+
+// extractArchive extracts the files of the archive selected by the filters into dir.
+func extractArchive(zr *zip.Reader, dir string, cfg *extractConfig) (*ExtractManifest, error) {
+	files := make([]*zip.File, 0, len(zr.File))
+	names := make(map[string]bool, len(zr.File))
+	dirs := make(map[string]bool)
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		if !isLocalPath(f.Name) {
+			return nil, fmt.Errorf("extract: invalid file path %q", f.Name)
+		}
+		if !f.Mode().IsRegular() {
+			return nil, fmt.Errorf("extract: %q is not a regular file", f.Name)
+		}
+		dirs[path.Dir(f.Name)] = true
+		if cfg.match(f.Name) {
+			files = append(files, f)
+			names[f.Name] = true
+		}
+	}
+
+	manifest := &ExtractManifest{}
+	for _, f := range files {
+		status, err := extractFile(f, dir)
+		if err != nil {
+			return manifest, fmt.Errorf("extract: %s: %w", f.Name, err)
+		}
+
+		switch status {
+		case fileAdded:
+			manifest.Added = append(manifest.Added, f.Name)
+		case fileModified:
+			manifest.Modified = append(manifest.Modified, f.Name)
+		default:
+			manifest.Unchanged = append(manifest.Unchanged, f.Name)
+		}
+	}
+
+	stale, err := staleFiles(dir, dirs, names, cfg)
+	if err != nil {
+		return manifest, fmt.Errorf("extract: %w", err)
+	}
+	manifest.Stale = stale
+
+	return manifest, nil
+}
+
+// staleFiles returns the regular files in the directories of the archive
+// selected by the filters which are not among the extracted files.
+func staleFiles(dir string, dirs, extracted map[string]bool, cfg *extractConfig) ([]string, error) {
+	var stale []string
+	for d := range dirs {
+		entries, err := os.ReadDir(filepath.Join(dir, filepath.FromSlash(d)))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range entries {
+			rel := path.Join(d, e.Name())
+			if e.Type().IsRegular() && !extracted[rel] && cfg.match(rel) {
+				stale = append(stale, rel)
+			}
+		}
+	}
+	slices.Sort(stale)
+	return stale, nil
+}
+
+type fileStatus int
+
+const (
+	fileUnchanged fileStatus = iota
+	fileAdded
+	fileModified
+)
+
+// extractFile atomically writes the file of the archive into dir
+// unless the existing file has the same content.
+func extractFile(f *zip.File, dir string) (fileStatus, error) {
+	target := filepath.Join(dir, filepath.FromSlash(f.Name))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return 0, err
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), "."+filepath.Base(target)+".*.tmp")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+
+	h := sha256.New()
+	_, err = io.Copy(io.MultiWriter(tmp, h), rc)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	mode := os.FileMode(0o644)
+	status := fileAdded
+	if fi, err := os.Stat(target); err == nil {
+		if !fi.Mode().IsRegular() {
+			return 0, errors.New("target is not a regular file")
+		}
+		sum, err := fileHash(target)
+		if err != nil {
+			return 0, err
+		}
+		if bytes.Equal(sum, h.Sum(nil)) {
+			return fileUnchanged, nil
+		}
+		mode, status = fi.Mode().Perm(), fileModified
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return 0, err
+	}
+
+	if err := os.Chmod(tmp.Name(), mode); err != nil {
+		return 0, err
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return 0, err
+	}
+
+	return status, nil
+}
+
+// fileHash returns the SHA-256 hash of the file content.
+func fileHash(name string) ([]byte, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+// isLocalPath reports whether the archive path stays within
+// the target directory.
+func isLocalPath(name string) bool {
+	if strings.Contains(name, `\`) || strings.HasPrefix(name, "/") {
+		return false
+	}
+	return filepath.IsLocal(filepath.FromSlash(name))
+}
+
+// match reports whether the archive path passes the language and path filters.
+func (c *extractConfig) match(name string) bool {
+	if len(c.languages) > 0 && !slices.ContainsFunc(strings.Split(path.Dir(name), "/"), func(dir string) bool {
+		return c.languages[dir]
+	}) {
+		return false
+	}
+
+	if len(c.patterns) == 0 {
+		return true
+	}
+	for _, pattern := range c.patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "/**"); ok {
+			for dir := path.Dir(name); dir != "."; dir = path.Dir(dir) {
+				if ok, _ := path.Match(prefix, dir); ok {
+					return true
+				}
+			}
+			continue
+		}
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,189 @@
+package crowdin
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// zipArchive returns a ZIP archive with the given files.
+func zipArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	return buf.Bytes()
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(target), 0o755))
+		require.NoError(t, os.WriteFile(target, []byte(content), 0o600))
+	}
+}
+
+func readFile(t *testing.T, dir, name string) string {
+	t.Helper()
+
+	content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestTranslationsService_ExtractProjectTranslations(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	archive := zipArchive(t, map[string]string{
+		"uk/main.json":      `{"hello": "Привіт"}`,
+		"uk/docs/intro.md":  "Вступ",
+		"de/main.json":      `{"hello": "Hallo"}`,
+		"fr/main.json":      `{"hello": "Bonjour"}`,
+		"uk/docs/readme.md": "Прочитай мене",
+	})
+
+	mux.HandleFunc("/api/v2/projects/1/translations/builds/2/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprintf(w, `{"data": {"url": "%sbuild.zip", "expireIn": "2099-01-01T00:00:00+00:00"}}`, client.baseURL)
+	})
+	mux.HandleFunc("/build.zip", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(archive)
+	})
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"uk/main.json":     `{"hello": "Привіт"}`,
+		"uk/docs/intro.md": "Old intro",
+		"uk/removed.md":    "Removed",
+		"uk/docs/old.md":   "Old",
+		"fr/docs/old.md":   "Vieux",
+	})
+
+	manifest, err := client.Translations.ExtractProjectTranslations(context.Background(), 1, 2, dir,
+		ExtractLanguages("uk", "de"),
+		ExtractPaths("*/main.json", "*/docs/**"),
+	)
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"de/main.json", "uk/docs/readme.md"}, manifest.Added)
+	assert.Equal(t, []string{"uk/docs/intro.md"}, manifest.Modified)
+	assert.Equal(t, []string{"uk/main.json"}, manifest.Unchanged)
+	assert.Equal(t, []string{"uk/docs/old.md"}, manifest.Stale)
+	assert.True(t, manifest.Changed())
+
+	assert.Equal(t, "Вступ", readFile(t, dir, "uk/docs/intro.md"))
+	assert.Equal(t, `{"hello": "Hallo"}`, readFile(t, dir, "de/main.json"))
+	assert.Equal(t, "Removed", readFile(t, dir, "uk/removed.md"))
+	assert.Equal(t, "Old", readFile(t, dir, "uk/docs/old.md"))
+	assert.NoFileExists(t, filepath.Join(dir, "fr", "main.json"))
+
+	fi, err := os.Stat(filepath.Join(dir, "uk", "docs", "intro.md"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), fi.Mode().Perm(), "the mode of replaced files should be preserved")
+
+	entries, err := os.ReadDir(filepath.Join(dir, "uk"))
+	require.NoError(t, err)
+	for _, e := range entries {
+		assert.NotContains(t, e.Name(), ".tmp", "temporary files should be removed")
+	}
+}
+
+func TestClient_Extract_UnrelatedFiles(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	archive := zipArchive(t, map[string]string{
+		"uk/main.json": `{"hello": "Привіт"}`,
+		"de/main.json": `{"hello": "Hallo"}`,
+	})
+	mux.HandleFunc("/build.zip", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(archive)
+	})
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		".git/config":       "[core]",
+		"README.md":         "Readme",
+		"src/main.json":     `{"hello": "Hello"}`,
+		"uk/old.json":       "{}",
+		"uk/docs/intro.md":  "Вступ",
+		"fr/main.json":      `{"hello": "Bonjour"}`,
+		"de/nested/de.json": "{}",
+	})
+
+	link := &model.DownloadLink{URL: client.baseURL.String() + "build.zip"}
+	manifest, err := client.Extract(context.Background(), link, dir)
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"uk/main.json", "de/main.json"}, manifest.Added)
+	assert.Equal(t, []string{"uk/old.json"}, manifest.Stale)
+	assert.Equal(t, "[core]", readFile(t, dir, ".git/config"))
+}
+
+func TestClient_Extract_ZipSlip(t *testing.T) {
+	tests := []string{
+		"../evil.txt",
+		"uk/../../evil.txt",
+		"/etc/evil.txt",
+		`..\evil.txt`,
+	}
+
+	for _, name := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, mux, teardown := setupClient()
+			defer teardown()
+
+			archive := zipArchive(t, map[string]string{"uk/main.json": "{}", name: "evil"})
+			mux.HandleFunc("/build.zip", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write(archive)
+			})
+
+			parent := t.TempDir()
+			dir := filepath.Join(parent, "target")
+
+			link := &model.DownloadLink{URL: client.baseURL.String() + "build.zip"}
+			manifest, err := client.Extract(context.Background(), link, dir)
+			require.EqualError(t, err, fmt.Sprintf("extract: invalid file path %q", name))
+			assert.Nil(t, manifest)
+
+			assert.NoDirExists(t, dir, "no files should be written")
+			assert.NoFileExists(t, filepath.Join(parent, "evil.txt"))
+		})
+	}
+}
+
+func TestClient_Extract_InvalidArchive(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/build.zip", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "not a zip archive")
+	})
+
+	link := &model.DownloadLink{URL: client.baseURL.String() + "build.zip"}
+	_, err := client.Extract(context.Background(), link, t.TempDir())
+	require.EqualError(t, err, "extract: zip: not a valid zip file")
+
+	_, err = client.Extract(context.Background(), link, "")
+	require.EqualError(t, err, "extract: directory cannot be empty")
+}
@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -24,6 +25,7 @@ type GlossariesService struct {
 func (s *GlossariesService) GetConcept(ctx context.Context, glossaryID, conceptID int) (
 	*model.Concept, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Glossaries.GetConcept")
 	res := new(model.ConceptResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/concepts/%d", glossaryID, conceptID), nil, res)
 
@@ -36,6 +38,7 @@ func (s *GlossariesService) GetConcept(ctx context.Context, glossaryID, conceptI
 func (s *GlossariesService) ListConcepts(ctx context.Context, glossaryID int, opts *model.ConceptsListOptions) (
 	[]*model.Concept, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Glossaries.ListConcepts")
 	res := new(model.ConceptsListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/concepts", glossaryID), opts, res)
 	if err != nil {
@@ -50,12 +53,33 @@ func (s *GlossariesService) ListConcepts(ctx context.Context, glossaryID int, op
 	return list, resp, err
 }
 
+// AllConcepts returns an iterator over all concepts from a glossary.
+// It fetches the pages with ListConcepts until a page shorter than the limit is returned.
+func (s *GlossariesService) AllConcepts(
+	ctx context.Context, glossaryID int, opts *model.ConceptsListOptions,
+) iter.Seq2[*model.Concept, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.Concept, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListConcepts(ctx, glossaryID, &pageOpts)
+	})
+}
+
+// ListAllConcepts returns all concepts from a glossary by fetching every page with ListConcepts.
+func (s *GlossariesService) ListAllConcepts(ctx context.Context, glossaryID int, opts *model.ConceptsListOptions) (
+	[]*model.Concept, error,
+) {
+	return Collect(s.AllConcepts(ctx, glossaryID, opts))
+}
+
 // UpdateConcept updates a specific concept in a glossary.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.concepts.put
 func (s *GlossariesService) UpdateConcept(ctx context.Context, glossaryID, conceptID int, req *model.ConceptUpdateRequest) (
 	*model.Concept, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Glossaries.UpdateConcept")
 	res := new(model.ConceptResponse)
 	resp, err := s.client.Put(ctx, fmt.Sprintf("/api/v2/glossaries/%d/concepts/%d", glossaryID, conceptID), req, res)
 
@@ -66,6 +90,7 @@ func (s *GlossariesService) UpdateConcept(ctx context.Context, glossaryID, conce
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.concepts.delete
 func (s *GlossariesService) DeleteConcept(ctx context.Context, glossaryID, conceptID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Glossaries.DeleteConcept")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/glossaries/%d/concepts/%d", glossaryID, conceptID))
 }
 
@@ -73,6 +98,7 @@ func (s *GlossariesService) DeleteConcept(ctx context.Context, glossaryID, conce
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.get
 func (s *GlossariesService) GetGlossary(ctx context.Context, glossaryID int) (*model.Glossary, *Response, error) {
+	ctx = WithOperationName(ctx, "Glossaries.GetGlossary")
 	res := new(model.GlossaryResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d", glossaryID), nil, res)
 
@@ -85,6 +111,7 @@ func (s *GlossariesService) GetGlossary(ctx context.Context, glossaryID int) (*m
 func (s *GlossariesService) ListGlossaries(ctx context.Context, opts *model.GlossariesListOptions) (
 	[]*model.Glossary, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Glossaries.ListGlossaries")
 	res := new(model.GlossariesListResponse)
 	resp, err := s.client.Get(ctx, "/api/v2/glossaries", opts, res)
 	if err != nil {
@@ -99,12 +126,33 @@ func (s *GlossariesService) ListGlossaries(ctx context.Context, opts *model.Glos
 	return list, resp, err
 }
 
+// AllGlossaries returns an iterator over all glossaries.
+// It fetches the pages with ListGlossaries until a page shorter than the limit is returned.
+func (s *GlossariesService) AllGlossaries(
+	ctx context.Context, opts *model.GlossariesListOptions,
+) iter.Seq2[*model.Glossary, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.Glossary, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListGlossaries(ctx, &pageOpts)
+	})
+}
+
+// ListAllGlossaries returns all glossaries by fetching every page with ListGlossaries.
+func (s *GlossariesService) ListAllGlossaries(ctx context.Context, opts *model.GlossariesListOptions) (
+	[]*model.Glossary, error,
+) {
+	return Collect(s.AllGlossaries(ctx, opts))
+}
+
 // AddGlossary creates a new glossary.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.post
 func (s *GlossariesService) AddGlossary(ctx context.Context, req *model.GlossaryAddRequest) (
 	*model.Glossary, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Glossaries.AddGlossary")
 	res := new(model.GlossaryResponse)
 	resp, err := s.client.Post(ctx, "/api/v2/glossaries", req, res)
 
@@ -117,6 +165,7 @@ func (s *GlossariesService) AddGlossary(ctx context.Context, req *model.Glossary
 func (s *GlossariesService) EditGlossary(ctx context.Context, glossaryID int, req []*model.UpdateRequest) (
 	*model.Glossary, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Glossaries.EditGlossary")
 	res := new(model.GlossaryResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/glossaries/%d", glossaryID), req, res)
 
@@ -127,6 +176,7 @@ func (s *GlossariesService) EditGlossary(ctx context.Context, glossaryID int, re
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.delete
 func (s *GlossariesService) DeleteGlossary(ctx context.Context, glossaryID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Glossaries.DeleteGlossary")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/glossaries/%d", glossaryID))
 }
 
@@ -137,6 +187,7 @@ func (s *GlossariesService) DeleteGlossary(ctx context.Context, glossaryID int)
 func (s *GlossariesService) ExportGlossary(ctx context.Context, glossaryID int, req *model.GlossaryExportRequest) (
 	*model.GlossaryExport, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Glossaries.ExportGlossary")
 	res := new(model.GlossaryExportResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/glossaries/%d/exports", glossaryID), req, res)
 
@@ -149,18 +200,41 @@ func (s *GlossariesService) ExportGlossary(ctx context.Context, glossaryID int,
 func (s *GlossariesService) CheckGlossaryExportStatus(ctx context.Context, glossaryID int, exportID string) (
 	*model.GlossaryExport, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Glossaries.CheckGlossaryExportStatus")
 	res := new(model.GlossaryExportResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/exports/%s", glossaryID, exportID), nil, res)
 
 	return res.Data, resp, err
 }
 
+// ExportGlossaryAndWait exports a glossary and waits until the export is completed.
+// Use DownloadGlossary with the returned identifier to get the download link.
+func (s *GlossariesService) ExportGlossaryAndWait(
+	ctx context.Context,
+	glossaryID int,
+	req *model.GlossaryExportRequest,
+	opts ...WaitOption,
+) (*model.GlossaryExport, error) {
+	return startAndWait(ctx,
+		func() (*model.GlossaryExport, *Response, error) {
+			return s.ExportGlossary(ctx, glossaryID, req)
+		},
+		func(e *model.GlossaryExport) PollFunc[*model.GlossaryExport] {
+			return func(ctx context.Context) (*model.GlossaryExport, *Response, error) {
+				return s.CheckGlossaryExportStatus(ctx, glossaryID, e.Identifier)
+			}
+		},
+		opts,
+	)
+}
+
 // DownloadGlossary returns a download link for a glossary export.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.exports.download.download
 func (s *GlossariesService) DownloadGlossary(ctx context.Context, glossaryID int, exportID string) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Glossaries.DownloadGlossary")
 	res := new(model.DownloadLinkResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/exports/%s/download", glossaryID, exportID), nil, res)
 
@@ -174,6 +248,7 @@ func (s *GlossariesService) DownloadGlossary(ctx context.Context, glossaryID int
 func (s *GlossariesService) ImportGlossary(ctx context.Context, glossaryID int, req *model.GlossaryImportRequest) (
 	*model.GlossaryImport, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Glossaries.ImportGlossary")
 	res := new(model.GlossaryImportResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/glossaries/%d/imports", glossaryID), req, res)
 
@@ -186,6 +261,7 @@ func (s *GlossariesService) ImportGlossary(ctx context.Context, glossaryID int,
 func (s *GlossariesService) CheckGlossaryImportStatus(ctx context.Context, glossaryID, importID int) (
 	*model.GlossaryImport, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Glossaries.CheckGlossaryImportStatus")
 	res := new(model.GlossaryImportResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/imports/%d", glossaryID, importID), nil, res)
 
@@ -198,6 +274,7 @@ func (s *GlossariesService) CheckGlossaryImportStatus(ctx context.Context, gloss
 func (s *GlossariesService) ConcordanceSearch(ctx context.Context, projectID int, req *model.GlossaryConcordanceSearchRequest) (
 	[]*model.ConcordanceSearch, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Glossaries.ConcordanceSearch")
 	res := new(model.GlossaryConcordanceSearchResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/glossaries/concordance", projectID), req, res)
 	if err != nil {
@@ -218,6 +295,7 @@ func (s *GlossariesService) ConcordanceSearch(ctx context.Context, projectID int
 func (s *GlossariesService) GetTerm(ctx context.Context, glossaryID, termID int) (
 	*model.Term, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Glossaries.GetTerm")
 	res := new(model.TermResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/terms/%d", glossaryID, termID), nil, res)
 
@@ -230,6 +308,7 @@ func (s *GlossariesService) GetTerm(ctx context.Context, glossaryID, termID int)
 func (s *GlossariesService) ListTerms(ctx context.Context, glossaryID int, opts *model.TermsListOptions) (
 	[]*model.Term, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Glossaries.ListTerms")
 	res := new(model.TermsListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/terms", glossaryID), opts, res)
 	if err != nil {
@@ -244,12 +323,33 @@ func (s *GlossariesService) ListTerms(ctx context.Context, glossaryID int, opts
 	return list, resp, err
 }
 
+// AllTerms returns an iterator over all terms from a glossary.
+// It fetches the pages with ListTerms until a page shorter than the limit is returned.
+func (s *GlossariesService) AllTerms(
+	ctx context.Context, glossaryID int, opts *model.TermsListOptions,
+) iter.Seq2[*model.Term, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.Term, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListTerms(ctx, glossaryID, &pageOpts)
+	})
+}
+
+// ListAllTerms returns all terms from a glossary by fetching every page with ListTerms.
+func (s *GlossariesService) ListAllTerms(ctx context.Context, glossaryID int, opts *model.TermsListOptions) (
+	[]*model.Term, error,
+) {
+	return Collect(s.AllTerms(ctx, glossaryID, opts))
+}
+
 // AddTerm adds a new term to a glossary.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.terms.post
 func (s *GlossariesService) AddTerm(ctx context.Context, glossaryID int, req *model.TermAddRequest) (
 	*model.Term, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Glossaries.AddTerm")
 	res := new(model.TermResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/glossaries/%d/terms", glossaryID), req, res)
 
@@ -262,6 +362,7 @@ func (s *GlossariesService) AddTerm(ctx context.Context, glossaryID int, req *mo
 func (s *GlossariesService) EditTerm(ctx context.Context, glossaryID, termID int, req []*model.UpdateRequest) (
 	*model.Term, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Glossaries.EditTerm")
 	res := new(model.TermResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/glossaries/%d/terms/%d", glossaryID, termID), req, res)
 
@@ -274,6 +375,7 @@ func (s *GlossariesService) EditTerm(ctx context.Context, glossaryID, termID int
 func (s *GlossariesService) ClearGlossary(ctx context.Context, glossaryID int, opts *model.ClearGlossaryOptions) (
 	*Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Glossaries.ClearGlossary")
 	path := fmt.Sprintf("/api/v2/glossaries/%d/terms", glossaryID)
 	if v, ok := opts.Values(); ok {
 		path += "?" + v.Encode()
@@ -286,5 +388,6 @@ func (s *GlossariesService) ClearGlossary(ctx context.Context, glossaryID int, o
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.terms.delete
 func (s *GlossariesService) DeleteTerm(ctx context.Context, glossaryID, termID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Glossaries.DeleteTerm")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/glossaries/%d/terms/%d", glossaryID, termID))
 }
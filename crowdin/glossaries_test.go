@@ -73,12 +73,12 @@ func TestGlossariesService_GetConcept(t *testing.T) {
 				UserID:     12,
 				Definition: "Some definition",
 				Note:       "Some note",
-				CreatedAt:  "2023-09-19T14:14:00+00:00",
-				UpdatedAt:  "2023-09-19T14:14:00+00:00",
+				CreatedAt:  testTime("2023-09-19T14:14:00+00:00"),
+				UpdatedAt:  testTime("2023-09-19T14:14:00+00:00"),
 			},
 		},
-		CreatedAt: "2023-09-23T07:19:47+00:00",
-		UpdatedAt: "2023-09-23T07:19:47+00:00",
+		CreatedAt: testTime("2023-09-23T07:19:47+00:00"),
+		UpdatedAt: testTime("2023-09-23T07:19:47+00:00"),
 	}
 	assert.Equal(t, expected, concept)
 }
@@ -244,12 +244,12 @@ func TestGlossariesService_UpdateConcept(t *testing.T) {
 				UserID:     12,
 				Definition: "Some definition",
 				Note:       "Some note",
-				CreatedAt:  "2023-09-19T14:14:00+00:00",
-				UpdatedAt:  "2023-09-19T14:14:00+00:00",
+				CreatedAt:  testTime("2023-09-19T14:14:00+00:00"),
+				UpdatedAt:  testTime("2023-09-19T14:14:00+00:00"),
 			},
 		},
-		CreatedAt: "2023-09-23T07:19:47+00:00",
-		UpdatedAt: "2023-09-23T07:19:47+00:00",
+		CreatedAt: testTime("2023-09-23T07:19:47+00:00"),
+		UpdatedAt: testTime("2023-09-23T07:19:47+00:00"),
 	}
 	assert.Equal(t, expected, concept)
 }
@@ -320,7 +320,7 @@ func TestGlossariesService_GetGlossary(t *testing.T) {
 		DefaultProjectIDs: []int{2},
 		ProjectIDs:        []int{6},
 		WebURL:            "https://example.crowdin.com/u/glossaries/1",
-		CreatedAt:         "2023-09-16T13:42:04+00:00",
+		CreatedAt:         testTime("2023-09-16T13:42:04+00:00"),
 	}
 	assert.Equal(t, expected, glossary)
 }
@@ -470,7 +470,7 @@ func TestGlossariesService_AddGlossary(t *testing.T) {
 		DefaultProjectIDs: []int{2},
 		ProjectIDs:        []int{6},
 		WebURL:            "https://example.crowdin.com/u/glossaries/1",
-		CreatedAt:         "2023-09-16T13:42:04+00:00",
+		CreatedAt:         testTime("2023-09-16T13:42:04+00:00"),
 	}
 	assert.Equal(t, expected, glossary)
 }
@@ -548,11 +548,67 @@ func TestGlossariesService_EditGlossary(t *testing.T) {
 		DefaultProjectIDs: []int{2},
 		ProjectIDs:        []int{6},
 		WebURL:            "https://example.crowdin.com/u/glossaries/1",
-		CreatedAt:         "2023-09-16T13:42:04+00:00",
+		CreatedAt:         testTime("2023-09-16T13:42:04+00:00"),
 	}
 	assert.Equal(t, expected, glossary)
 }
 
+func TestGlossariesService_EditGlossary_Diff(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	path := "/api/v2/glossaries/2"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		testURL(t, r, path)
+		testBody(t, r, `[{"op":"replace","path":"/name","value":"Website Glossary"},`+
+			`{"op":"replace","path":"/languageId","value":"de"}]`+"\n")
+
+		fmt.Fprint(w, `{
+			"data": {
+				"id": 2,
+				"name": "Website Glossary",
+				"groupId": 2,
+				"userId": 2,
+				"terms": 25,
+				"languageId": "de",
+				"languageIds": ["ro"],
+				"defaultProjectIds": [2],
+				"projectIds": [6],
+				"webUrl": "https://example.crowdin.com/u/glossaries/2",
+				"createdAt": "2023-09-16T13:42:04+00:00"
+			}
+		}`)
+	})
+
+	glossary := &model.Glossary{
+		ID:                2,
+		Name:              "Glossary",
+		GroupID:           2,
+		UserID:            2,
+		Terms:             25,
+		LanguageID:        "fr",
+		LanguageIDs:       []string{"ro"},
+		DefaultProjectIDs: []int{2},
+		ProjectIDs:        []int{6},
+		WebURL:            "https://example.crowdin.com/u/glossaries/2",
+		CreatedAt:         testTime("2023-09-16T13:42:04+00:00"),
+	}
+	edited := *glossary
+	edited.Name = "Website Glossary"
+	edited.LanguageID = "de"
+	edited.Terms = 30
+
+	req, err := model.Diff(glossary, &edited)
+	require.NoError(t, err)
+
+	updated, _, err := client.Glossaries.EditGlossary(context.Background(), glossary.ID, req)
+	require.NoError(t, err)
+
+	edited.Terms = glossary.Terms
+	assert.Equal(t, &edited, updated)
+}
+
 func TestGlossariesService_ExportGlossary(t *testing.T) {
 	client, mux, teardown := setupClient()
 	defer teardown()
@@ -603,10 +659,10 @@ func TestGlossariesService_ExportGlossary(t *testing.T) {
 			Format:       "csv",
 			ExportFields: []string{"term", "description", "partOfSpeech"},
 		},
-		CreatedAt:  "2023-09-23T07:06:43+00:00",
-		UpdatedAt:  "2023-09-23T07:06:43+00:00",
-		StartedAt:  "2023-08-24T14:15:22Z",
-		FinishedAt: "2023-08-24T14:15:22Z",
+		CreatedAt:  testTime("2023-09-23T07:06:43+00:00"),
+		UpdatedAt:  testTime("2023-09-23T07:06:43+00:00"),
+		StartedAt:  testTime("2023-08-24T14:15:22Z"),
+		FinishedAt: testTime("2023-08-24T14:15:22Z"),
 	}
 	assert.Equal(t, expected, exportData)
 }
@@ -662,10 +718,10 @@ func TestGlossariesService_CheckGlossaryExportStatus(t *testing.T) {
 			Format:       "csv",
 			ExportFields: []string{"term", "description", "partOfSpeech"},
 		},
-		CreatedAt:  "2023-09-23T07:06:43+00:00",
-		UpdatedAt:  "2023-09-23T07:06:43+00:00",
-		StartedAt:  "2023-08-24T14:15:22Z",
-		FinishedAt: "2023-08-24T14:15:22Z",
+		CreatedAt:  testTime("2023-09-23T07:06:43+00:00"),
+		UpdatedAt:  testTime("2023-09-23T07:06:43+00:00"),
+		StartedAt:  testTime("2023-08-24T14:15:22Z"),
+		FinishedAt: testTime("2023-08-24T14:15:22Z"),
 	}
 	assert.Equal(t, expected, export)
 }
@@ -699,7 +755,7 @@ func TestGlossariesService_DownloadGlossary(t *testing.T) {
 
 	expected := &model.DownloadLink{
 		URL:      "https://production-enterprise-importer.downloads.crowdin.com/992000002/2/14.xliff",
-		ExpireIn: "2023-09-20T10:31:21+00:00",
+		ExpireIn: testTime("2023-09-20T10:31:21+00:00"),
 	}
 	assert.Equal(t, expected, downloadLink)
 }
@@ -763,10 +819,10 @@ func TestGlossariesService_ImportGlossary(t *testing.T) {
 			},
 			FirstLineContainsHeader: false,
 		},
-		CreatedAt:  "2023-09-23T12:17:54+00:00",
-		UpdatedAt:  "2023-09-23T12:17:54+00:00",
-		StartedAt:  "2023-08-24T14:15:22Z",
-		FinishedAt: "2023-08-24T14:15:22Z",
+		CreatedAt:  testTime("2023-09-23T12:17:54+00:00"),
+		UpdatedAt:  testTime("2023-09-23T12:17:54+00:00"),
+		StartedAt:  testTime("2023-08-24T14:15:22Z"),
+		FinishedAt: testTime("2023-08-24T14:15:22Z"),
 	}
 	assert.Equal(t, expected, glossaryImport)
 }
@@ -823,7 +879,7 @@ func TestGlossariesService_CheckGlossaryImportStatus(t *testing.T) {
 					},
 					"firstLineContainsHeader": true
 				},
-				"createdAt": "2023-23T12:17:54+00:00",
+				"createdAt": "2023-09-23T12:17:54+00:00",
 				"updatedAt": "2023-09-23T12:17:54+00:00",
 				"startedAt": "2023-08-24T14:15:22Z",
 				"finishedAt": "2023-08-24T14:15:22Z"
@@ -851,10 +907,10 @@ func TestGlossariesService_CheckGlossaryImportStatus(t *testing.T) {
 			},
 			FirstLineContainsHeader: true,
 		},
-		CreatedAt:  "2023-23T12:17:54+00:00",
-		UpdatedAt:  "2023-09-23T12:17:54+00:00",
-		StartedAt:  "2023-08-24T14:15:22Z",
-		FinishedAt: "2023-08-24T14:15:22Z",
+		CreatedAt:  testTime("2023-09-23T12:17:54+00:00"),
+		UpdatedAt:  testTime("2023-09-23T12:17:54+00:00"),
+		StartedAt:  testTime("2023-08-24T14:15:22Z"),
+		FinishedAt: testTime("2023-08-24T14:15:22Z"),
 	}
 	assert.Equal(t, expected, glossaryImport)
 }
@@ -980,8 +1036,8 @@ func TestGlossariesService_ConcordanceSearch(t *testing.T) {
 					URL:          "https://example.com/base-url",
 					ConceptID:    6,
 					Lemma:        "voir",
-					CreatedAt:    "2023-09-23T07:19:47+00:00",
-					UpdatedAt:    "2023-09-23T07:19:47+00:00",
+					CreatedAt:    testTime("2023-09-23T07:19:47+00:00"),
+					UpdatedAt:    testTime("2023-09-23T07:19:47+00:00"),
 				},
 			},
 			TargetTerms: []*model.Term{
@@ -1000,8 +1056,8 @@ func TestGlossariesService_ConcordanceSearch(t *testing.T) {
 					URL:          "https://example.com/base-url",
 					ConceptID:    6,
 					Lemma:        "voir",
-					CreatedAt:    "2023-09-23T07:19:47+00:00",
-					UpdatedAt:    "2023-09-23T07:19:47+00:00",
+					CreatedAt:    testTime("2023-09-23T07:19:47+00:00"),
+					UpdatedAt:    testTime("2023-09-23T07:19:47+00:00"),
 				},
 			},
 		},
@@ -1104,8 +1160,8 @@ func TestGlossariesService_GetTerm(t *testing.T) {
 		URL:          "https://example.com/base-url",
 		ConceptID:    6,
 		Lemma:        "voir",
-		CreatedAt:    "2023-09-23T07:19:47+00:00",
-		UpdatedAt:    "2023-09-23T07:19:47+00:00",
+		CreatedAt:    testTime("2023-09-23T07:19:47+00:00"),
+		UpdatedAt:    testTime("2023-09-23T07:19:47+00:00"),
 	}
 	assert.Equal(t, expected, term)
 }
@@ -1261,8 +1317,8 @@ func TestGlossariesService_AddTerm(t *testing.T) {
 		URL:          "https://example.com/base-url",
 		ConceptID:    6,
 		Lemma:        "voir",
-		CreatedAt:    "2023-09-23T07:19:47+00:00",
-		UpdatedAt:    "2023-09-23T07:19:47+00:00",
+		CreatedAt:    testTime("2023-09-23T07:19:47+00:00"),
+		UpdatedAt:    testTime("2023-09-23T07:19:47+00:00"),
 	}
 	assert.Equal(t, expected, term)
 }
@@ -1355,8 +1411,8 @@ func TestGlossariesService_EditTerm(t *testing.T) {
 		URL:          "Base URL",
 		ConceptID:    6,
 		Lemma:        "voir",
-		CreatedAt:    "2023-09-23T07:19:47+00:00",
-		UpdatedAt:    "2023-09-23T07:19:47+00:00",
+		CreatedAt:    testTime("2023-09-23T07:19:47+00:00"),
+		UpdatedAt:    testTime("2023-09-23T07:19:47+00:00"),
 	}
 	assert.Equal(t, expected, term)
 }
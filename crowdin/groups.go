@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -27,6 +28,7 @@ type GroupsService struct {
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.groups.getMany
 func (s *GroupsService) List(ctx context.Context, opts *model.GroupsListOptions) ([]*model.Group, *Response, error) {
+	ctx = WithOperationName(ctx, "Groups.List")
 	res := new(model.GroupsListResponse)
 	resp, err := s.client.Get(ctx, "/api/v2/groups", opts, res)
 	if err != nil {
@@ -41,10 +43,27 @@ func (s *GroupsService) List(ctx context.Context, opts *model.GroupsListOptions)
 	return groups, resp, nil
 }
 
+// All returns an iterator over all groups.
+// It fetches the pages with List until a page shorter than the limit is returned.
+func (s *GroupsService) All(ctx context.Context, opts *model.GroupsListOptions) iter.Seq2[*model.Group, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.Group, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.List(ctx, &pageOpts)
+	})
+}
+
+// ListAll returns all groups by fetching every page with List.
+func (s *GroupsService) ListAll(ctx context.Context, opts *model.GroupsListOptions) ([]*model.Group, error) {
+	return Collect(s.All(ctx, opts))
+}
+
 // Get returns a group by its identifier.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.groups.get
 func (s *GroupsService) Get(ctx context.Context, id int) (*model.Group, *Response, error) {
+	ctx = WithOperationName(ctx, "Groups.Get")
 	res := new(model.GroupsGetResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/groups/%d", id), nil, res)
 
@@ -55,6 +74,7 @@ func (s *GroupsService) Get(ctx context.Context, id int) (*model.Group, *Respons
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.groups.post
 func (s *GroupsService) Add(ctx context.Context, req *model.GroupsAddRequest) (*model.Group, *Response, error) {
+	ctx = WithOperationName(ctx, "Groups.Add")
 	res := new(model.GroupsGetResponse)
 	resp, err := s.client.Post(ctx, "/api/v2/groups", req, res)
 
@@ -71,6 +91,7 @@ func (s *GroupsService) Add(ctx context.Context, req *model.GroupsAddRequest) (*
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.groups.patch
 func (s *GroupsService) Edit(ctx context.Context, id int, req []*model.UpdateRequest) (*model.Group, *Response, error) {
+	ctx = WithOperationName(ctx, "Groups.Edit")
 	res := new(model.GroupsGetResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/groups/%d", id), req, res)
 
@@ -81,5 +102,6 @@ func (s *GroupsService) Edit(ctx context.Context, id int, req []*model.UpdateReq
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.groups.delete
 func (s *GroupsService) Delete(ctx context.Context, id int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Groups.Delete")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/groups/%d", id))
 }
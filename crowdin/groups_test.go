@@ -59,8 +59,8 @@ func TestGroupService_List(t *testing.T) {
 			SubgroupsCount: 0,
 			ProjectsCount:  1,
 			WebURL:         "https://example.crowdin.com/u/groups/123",
-			CreatedAt:      "2023-09-20T11:11:05+00:00",
-			UpdatedAt:      "2023-09-20T12:22:20+00:00",
+			CreatedAt:      testTime("2023-09-20T11:11:05+00:00"),
+			UpdatedAt:      testTime("2023-09-20T12:22:20+00:00"),
 		},
 	}
 	if !reflect.DeepEqual(groups, want) {
@@ -124,8 +124,8 @@ func TestGroupService_ListWithQueryParams(t *testing.T) {
 			SubgroupsCount: 0,
 			ProjectsCount:  1,
 			WebURL:         "https://example.crowdin.com/u/groups/123",
-			CreatedAt:      "2023-09-20T11:11:05+00:00",
-			UpdatedAt:      "2023-09-20T12:22:20+00:00",
+			CreatedAt:      testTime("2023-09-20T11:11:05+00:00"),
+			UpdatedAt:      testTime("2023-09-20T12:22:20+00:00"),
 		},
 	}
 	if !reflect.DeepEqual(groups, want) {
@@ -172,8 +172,8 @@ func TestGroupService_Get(t *testing.T) {
 		SubgroupsCount: 0,
 		ProjectsCount:  1,
 		WebURL:         "https://example.crowdin.com/u/groups/123",
-		CreatedAt:      "2023-09-20T11:11:05+00:00",
-		UpdatedAt:      "2023-09-20T12:22:20+00:00",
+		CreatedAt:      testTime("2023-09-20T11:11:05+00:00"),
+		UpdatedAt:      testTime("2023-09-20T12:22:20+00:00"),
 	}
 	if !reflect.DeepEqual(group, want) {
 		t.Errorf("Groups.Get returned %+v, want %+v", group, want)
@@ -251,8 +251,8 @@ func TestGroupService_Add(t *testing.T) {
 		SubgroupsCount: 0,
 		ProjectsCount:  1,
 		WebURL:         "https://example.crowdin.com/u/groups/123",
-		CreatedAt:      "2023-09-20T11:11:05+00:00",
-		UpdatedAt:      "2023-09-20T12:22:20+00:00",
+		CreatedAt:      testTime("2023-09-20T11:11:05+00:00"),
+		UpdatedAt:      testTime("2023-09-20T12:22:20+00:00"),
 	}
 	if !reflect.DeepEqual(group, want) {
 		t.Errorf("Groups.Add returned %+v, want %+v", group, want)
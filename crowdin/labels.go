@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -19,6 +20,7 @@ type LabelsService struct {
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.labels.get
 func (s *LabelsService) Get(ctx context.Context, projectID, labelID int) (*model.Label, *Response, error) {
+	ctx = WithOperationName(ctx, "Labels.Get")
 	res := new(model.LabelResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/labels/%d", projectID, labelID), nil, res)
 
@@ -31,6 +33,7 @@ func (s *LabelsService) Get(ctx context.Context, projectID, labelID int) (*model
 func (s *LabelsService) List(ctx context.Context, projectID int, opts *model.LabelsListOptions) (
 	[]*model.Label, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Labels.List")
 	res := new(model.LabelsListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/labels", projectID), opts, res)
 	if err != nil {
@@ -45,12 +48,33 @@ func (s *LabelsService) List(ctx context.Context, projectID int, opts *model.Lab
 	return list, resp, err
 }
 
+// All returns an iterator over all labels in the project.
+// It fetches the pages with List until a page shorter than the limit is returned.
+func (s *LabelsService) All(
+	ctx context.Context, projectID int, opts *model.LabelsListOptions,
+) iter.Seq2[*model.Label, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.Label, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.List(ctx, projectID, &pageOpts)
+	})
+}
+
+// ListAll returns all labels in the project by fetching every page with List.
+func (s *LabelsService) ListAll(ctx context.Context, projectID int, opts *model.LabelsListOptions) (
+	[]*model.Label, error,
+) {
+	return Collect(s.All(ctx, projectID, opts))
+}
+
 // Add creates a new label in the project.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.labels.post
 func (s *LabelsService) Add(ctx context.Context, projectID int, req *model.LabelAddRequest) (
 	*model.Label, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Labels.Add")
 	res := new(model.LabelResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/labels", projectID), req, res)
 
@@ -64,10 +88,13 @@ func (s *LabelsService) Add(ctx context.Context, projectID int, req *model.Label
 // - path (json-pointer) - path to the field to update. Enum: "/title".
 // - value (string) - new value for the field. Must be a string.
 //
+// Use model.LabelPatch to build the request with the checked paths.
+//
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.labels.patch
 func (s *LabelsService) Edit(ctx context.Context, projectID, labelID int, req []*model.UpdateRequest) (
 	*model.Label, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Labels.Edit")
 	res := new(model.LabelResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/labels/%d", projectID, labelID), req, res)
 
@@ -78,6 +105,7 @@ func (s *LabelsService) Edit(ctx context.Context, projectID, labelID int, req []
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.labels.delete
 func (s *LabelsService) Delete(ctx context.Context, projectID, labelID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Labels.Delete")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/labels/%d", projectID, labelID))
 }
 
@@ -89,6 +117,7 @@ func (s *LabelsService) Delete(ctx context.Context, projectID, labelID int) (*Re
 func (s *LabelsService) AssignToStrings(ctx context.Context, projectID, labelID int, stringIDs []int) (
 	[]*model.SourceString, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Labels.AssignToStrings")
 	var (
 		req = &model.AssignToStringsRequest{StringIDs: stringIDs}
 		res = &model.SourceStringsListResponse{}
@@ -115,6 +144,7 @@ func (s *LabelsService) AssignToStrings(ctx context.Context, projectID, labelID
 func (s *LabelsService) UnassignFromStrings(ctx context.Context, projectID, labelID int, stringIDs []int) (
 	[]*model.SourceString, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Labels.UnassignFromStrings")
 	if len(stringIDs) == 0 {
 		return nil, nil, errors.New("stringIDs cannot be empty")
 	}
@@ -142,6 +172,7 @@ func (s *LabelsService) UnassignFromStrings(ctx context.Context, projectID, labe
 func (s *LabelsService) AssignToScreenshots(ctx context.Context, projectID, labelID int, screenshotIDs []int) (
 	[]*model.Screenshot, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Labels.AssignToScreenshots")
 	var (
 		req = &model.AssignToScreenshotsRequest{ScreenshotIDs: screenshotIDs}
 		res = &model.ScreenshotListResponse{}
@@ -168,6 +199,7 @@ func (s *LabelsService) AssignToScreenshots(ctx context.Context, projectID, labe
 func (s *LabelsService) UnassignFromScreenshots(ctx context.Context, projectID, labelID int, screenshotIDs []int) (
 	[]*model.Screenshot, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Labels.UnassignFromScreenshots")
 	if len(screenshotIDs) == 0 {
 		return nil, nil, errors.New("screenshotIDs cannot be empty")
 	}
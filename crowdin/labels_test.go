@@ -281,8 +281,8 @@ func TestLabelsService_AssignToStrings(t *testing.T) {
 			MasterStringID: ToPtr(1),
 			LabelIDs:       []int{3},
 			WebURL:         "https://example.crowdin.com/editor/1/all/en-pl?filter=basic&value=0&view=comfortable#2",
-			CreatedAt:      ToPtr("2023-09-20T12:43:57+00:00"),
-			UpdatedAt:      ToPtr("2023-09-20T13:24:01+00:00"),
+			CreatedAt:      ToPtr(testTime("2023-09-20T12:43:57+00:00")),
+			UpdatedAt:      ToPtr(testTime("2023-09-20T13:24:01+00:00")),
 			Fields: map[string]any{
 				"fieldSlug": "fieldValue",
 			},
@@ -386,8 +386,8 @@ func TestLabelsService_UnassignFromStrings(t *testing.T) {
 			MasterStringID: ToPtr(1),
 			LabelIDs:       []int{3},
 			WebURL:         "https://example.crowdin.com/editor/1/all/en-pl?filter=basic&value=0&view=comfortable#2",
-			CreatedAt:      ToPtr("2023-09-20T12:43:57+00:00"),
-			UpdatedAt:      ToPtr("2023-09-20T13:24:01+00:00"),
+			CreatedAt:      ToPtr(testTime("2023-09-20T12:43:57+00:00")),
+			UpdatedAt:      ToPtr(testTime("2023-09-20T13:24:01+00:00")),
 			FileID:         ToPtr(48),
 			DirectoryID:    ToPtr(14),
 			Revision:       ToPtr(1),
@@ -502,12 +502,12 @@ func TestLabelsService_AssignToScreenshots(t *testing.T) {
 						Width:  ToPtr(490),
 						Height: ToPtr(99),
 					},
-					CreatedAt: "2023-09-23T09:35:31+00:00",
+					CreatedAt: testTime("2023-09-23T09:35:31+00:00"),
 				},
 			},
 			LabelIDs:  []int{1},
-			CreatedAt: "2023-09-23T09:29:19+00:00",
-			UpdatedAt: "2023-09-23T09:29:19+00:00",
+			CreatedAt: testTime("2023-09-23T09:29:19+00:00"),
+			UpdatedAt: testTime("2023-09-23T09:29:19+00:00"),
 		},
 	}
 	assert.Equal(t, expected, screenshots)
@@ -620,12 +620,12 @@ func TestLabelsService_UnassignFromScreenshots(t *testing.T) {
 						Width:  ToPtr(490),
 						Height: ToPtr(99),
 					},
-					CreatedAt: "2023-09-23T09:35:31+00:00",
+					CreatedAt: testTime("2023-09-23T09:35:31+00:00"),
 				},
 			},
 			LabelIDs:  []int{1},
-			CreatedAt: "2023-09-23T09:29:19+00:00",
-			UpdatedAt: "2023-09-23T09:29:19+00:00",
+			CreatedAt: testTime("2023-09-23T09:29:19+00:00"),
+			UpdatedAt: testTime("2023-09-23T09:29:19+00:00"),
 		},
 	}
 	assert.Equal(t, expected, strings)
@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -25,6 +26,7 @@ type LanguagesService struct {
 //
 // https://developer.crowdin.com/api/v2/#operation/api.languages.getMany
 func (s *LanguagesService) List(ctx context.Context, opts *model.ListOptions) ([]*model.Language, *Response, error) {
+	ctx = WithOperationName(ctx, "Languages.List")
 	res := new(model.LanguagesListResponse)
 	resp, err := s.client.Get(ctx, "/api/v2/languages", opts, res)
 	if err != nil {
@@ -39,10 +41,25 @@ func (s *LanguagesService) List(ctx context.Context, opts *model.ListOptions) ([
 	return langs, resp, nil
 }
 
+// All returns an iterator over all supported languages.
+// It fetches the pages with List until a page shorter than the limit is returned.
+func (s *LanguagesService) All(ctx context.Context, opts *model.ListOptions) iter.Seq2[*model.Language, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, *o, func(ctx context.Context, page model.ListOptions) ([]*model.Language, *Response, error) {
+		return s.List(ctx, &page)
+	})
+}
+
+// ListAll returns all supported languages by fetching every page with List.
+func (s *LanguagesService) ListAll(ctx context.Context, opts *model.ListOptions) ([]*model.Language, error) {
+	return Collect(s.All(ctx, opts))
+}
+
 // Get returns a language by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.languages.get
 func (s *LanguagesService) Get(ctx context.Context, id string) (*model.Language, *Response, error) {
+	ctx = WithOperationName(ctx, "Languages.Get")
 	res := new(model.LanguagesGetResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/languages/%s", id), nil, res)
 
@@ -53,6 +70,7 @@ func (s *LanguagesService) Get(ctx context.Context, id string) (*model.Language,
 //
 // https://developer.crowdin.com/api/v2/#operation/api.languages.post
 func (s *LanguagesService) Add(ctx context.Context, req *model.AddLanguageRequest) (*model.Language, *Response, error) {
+	ctx = WithOperationName(ctx, "Languages.Add")
 	res := new(model.LanguagesGetResponse)
 	resp, err := s.client.Post(ctx, "/api/v2/languages", req, res)
 
@@ -70,6 +88,7 @@ func (s *LanguagesService) Add(ctx context.Context, req *model.AddLanguageReques
 //
 // https://developer.crowdin.com/api/v2/#operation/api.languages.patch
 func (s *LanguagesService) Edit(ctx context.Context, id string, req []*model.UpdateRequest) (*model.Language, *Response, error) {
+	ctx = WithOperationName(ctx, "Languages.Edit")
 	res := new(model.LanguagesGetResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/languages/%s", id), req, res)
 
@@ -80,5 +99,6 @@ func (s *LanguagesService) Edit(ctx context.Context, id string, req []*model.Upd
 //
 // https://developer.crowdin.com/api/v2/#operation/api.languages.delete
 func (s *LanguagesService) Delete(ctx context.Context, id string) (*Response, error) {
+	ctx = WithOperationName(ctx, "Languages.Delete")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/languages/%s", id))
 }
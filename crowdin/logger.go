@@ -0,0 +1,219 @@
+package crowdin
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// RedactedValue replaces the values of the secret fields in the logged
+// bodies and in the recorded cassettes (see the cassette package).
+const RedactedValue = "[REDACTED]"
+
+// LogOption configures the logging of the client.
+type LogOption func(*logConfig)
+
+type logConfig struct {
+	bodies bool
+	fields []string
+}
+
+// WithLogBodies enables the logging of the request and response bodies.
+// The request body is logged as sent, the response body as decoded into
+// the response model. The secrets in the bodies are always redacted.
+func WithLogBodies() LogOption {
+	return func(c *logConfig) {
+		c.bodies = true
+	}
+}
+
+// WithLogRedactFields adds the names of JSON fields whose values are redacted
+// from the logged bodies. Names are case-insensitive. The tokens, passwords
+// and machine translation engine credentials are always redacted.
+func WithLogRedactFields(names ...string) LogOption {
+	return func(c *logConfig) {
+		for _, name := range names {
+			c.fields = append(c.fields, strings.ToLower(name))
+		}
+	}
+}
+
+// SecretFields returns the lower-case names of the JSON fields which hold
+// secrets, including the credentials of machine translation engines.
+// Their values are always redacted from the logs and the cassettes.
+func SecretFields() []string {
+	return []string{
+		"token", "accesstoken", "access_token", "refreshtoken", "refresh_token",
+		"clientsecret", "client_secret", "secret", "password",
+		"credentials", "apikey", "accesskey", "secretkey",
+	}
+}
+
+// WithLogger logs every API call with the logger at the debug level.
+// The record contains the operation name, the method, URL and status of the
+// request, its duration and the error, if any:
+//
+//	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+//	client, err := crowdin.NewClient("token", crowdin.WithLogger(logger, crowdin.WithLogBodies()))
+//
+// The access token is never logged. The content of the files uploaded
+// to the storage is never logged, even if the bodies are logged.
+//
+// The logger is added as middleware, so it logs a call once including
+// its retries. It is ordered with other middleware in the order of the options.
+func WithLogger(logger *slog.Logger, opts ...LogOption) ClientOption {
+	return func(c *Client) error {
+		if logger == nil {
+			return errors.New("logger cannot be nil")
+		}
+
+		cfg := &logConfig{fields: SecretFields()}
+		for _, opt := range opts {
+			opt(cfg)
+		}
+
+		return WithMiddleware(logging(logger, cfg))(c)
+	}
+}
+
+// logging returns the middleware which logs the API calls.
+func logging(logger *slog.Logger, cfg *logConfig) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(r *http.Request, v any) (*Response, error) {
+			ctx := r.Context()
+			if !logger.Enabled(ctx, slog.LevelDebug) {
+				return next.Do(r, v)
+			}
+
+			attrs := []slog.Attr{
+				slog.String("method", r.Method),
+				slog.String("url", r.URL.String()),
+			}
+			if name := OperationName(ctx); name != "" {
+				attrs = append(attrs, slog.String("operation", name))
+			}
+			if cfg.bodies {
+				var body string
+				body, r = cfg.requestBody(r)
+				if body != "" {
+					attrs = append(attrs, slog.String("request_body", body))
+				}
+			}
+
+			start := time.Now()
+			resp, err := next.Do(r, v)
+			attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+
+			if resp != nil && resp.Response != nil {
+				attrs = append(attrs, slog.Int("status", resp.StatusCode))
+			}
+			if err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+			} else if cfg.bodies && v != nil {
+				if body := cfg.responseBody(v); body != "" {
+					attrs = append(attrs, slog.String("response_body", body))
+				}
+			}
+
+			logger.LogAttrs(ctx, slog.LevelDebug, "crowdin: API request", attrs...)
+			return resp, err
+		})
+	}
+}
+
+// requestBody returns the redacted request body and the request
+// with a fresh body to be sent. Bodies which cannot be read again
+// are not logged.
+func (c *logConfig) requestBody(r *http.Request) (string, *http.Request) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return "", r
+	}
+	if r.Header.Get("Crowdin-API-FileName") != "" {
+		// The content of the storage file.
+		return RedactedValue, r
+	}
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" ||
+		r.GetBody == nil {
+		return "", r
+	}
+
+	body, err := r.GetBody()
+	if err != nil {
+		return "", r
+	}
+	data, err := io.ReadAll(body)
+	body.Close()
+	if err != nil {
+		return "", r
+	}
+
+	// Rewind the body, which may share the reader with the copy.
+	req := r.Clone(r.Context())
+	if req.Body, err = r.GetBody(); err != nil {
+		return "", r
+	}
+
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return "", req
+	}
+	return c.redact(v), req
+}
+
+// responseBody returns the redacted response model.
+func (c *logConfig) responseBody(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return ""
+	}
+	return c.redact(decoded)
+}
+
+// redact returns the JSON encoding of the decoded value with
+// the values of the redacted fields replaced.
+func (c *logConfig) redact(v any) string {
+	c.redactValue(v)
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func (c *logConfig) redactValue(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if c.isRedacted(key) {
+				v[key] = RedactedValue
+				continue
+			}
+			c.redactValue(value)
+		}
+		// The value of a patch operation on a redacted field, e.g. "/credentials/apiKey".
+		if path, ok := v["path"].(string); ok {
+			if _, ok := v["value"]; ok && slices.ContainsFunc(strings.Split(path, "/"), c.isRedacted) {
+				v["value"] = RedactedValue
+			}
+		}
+	case []any:
+		for _, value := range v {
+			c.redactValue(value)
+		}
+	}
+}
+
+func (c *logConfig) isRedacted(name string) bool {
+	return slices.Contains(c.fields, strings.ToLower(name))
+}
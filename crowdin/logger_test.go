@@ -0,0 +1,141 @@
+package crowdin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestLogger returns a debug JSON logger writing to the buffer.
+func newTestLogger(buf *bytes.Buffer) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+}
+
+// logRecords decodes the JSON log records.
+func logRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var record map[string]any
+		require.NoError(t, dec.Decode(&record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestWithLogger(t *testing.T) {
+	var buf bytes.Buffer
+	client, mux, teardown := setupClient(WithLogger(newTestLogger(&buf)))
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/projects/1", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"data": {"id": 1, "name": "App"}}`)
+	})
+
+	_, _, err := client.Projects.Get(context.Background(), 1)
+	require.NoError(t, err)
+	_, _, err = client.Projects.Get(context.Background(), 2)
+	require.Error(t, err)
+
+	assert.NotContains(t, buf.String(), "access_token")
+	records := logRecords(t, &buf)
+	require.Len(t, records, 2)
+
+	record := records[0]
+	assert.Equal(t, "DEBUG", record["level"])
+	assert.Equal(t, "crowdin: API request", record["msg"])
+	assert.Equal(t, "Projects.Get", record["operation"])
+	assert.Equal(t, http.MethodGet, record["method"])
+	assert.True(t, strings.HasSuffix(record["url"].(string), "/api/v2/projects/1"))
+	assert.InDelta(t, http.StatusOK, record["status"], 0)
+	assert.Contains(t, record, "duration")
+	assert.NotContains(t, record, "request_body")
+	assert.NotContains(t, record, "response_body")
+	assert.NotContains(t, record, "error")
+
+	record = records[1]
+	assert.InDelta(t, http.StatusNotFound, record["status"], 0)
+	assert.Contains(t, record["error"], "404")
+}
+
+func TestWithLogger_Bodies(t *testing.T) {
+	var buf bytes.Buffer
+	client, mux, teardown := setupClient(WithLogger(newTestLogger(&buf), WithLogBodies(), WithLogRedactFields("url")))
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/mts", func(w http.ResponseWriter, r *http.Request) {
+		testBody(t, r, `{"name":"DeepL","type":"deepl","credentials":{"apiKey":"mt-api-key"}}`+"\n")
+		fmt.Fprint(w, `{"data": {"id": 1, "name": "DeepL", "credentials": {"apiKey": "mt-api-key", "url": "https://mt.example.com"}}}`)
+	})
+	mux.HandleFunc("/api/v2/mts/1", func(w http.ResponseWriter, r *http.Request) {
+		testBody(t, r, `[{"op":"replace","path":"/credentials/apiKey","value":"new-api-key"}]`+"\n")
+		fmt.Fprint(w, `{"data": {"id": 1, "name": "DeepL"}}`)
+	})
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"secret": "file content"}`, string(body))
+		fmt.Fprint(w, `{"data": {"id": 1, "fileName": "strings.json"}}`)
+	})
+
+	_, _, err := client.MachineTranslationEngines.AddMT(context.Background(), &model.MTAddRequest{
+		Name:        "DeepL",
+		Type:        "deepl",
+		Credentials: &model.MTECredentials{APIKey: "mt-api-key"},
+	})
+	require.NoError(t, err)
+
+	_, _, err = client.MachineTranslationEngines.EditMT(context.Background(), 1, []*model.UpdateRequest{
+		{Op: "replace", Path: "/credentials/apiKey", Value: "new-api-key"},
+	})
+	require.NoError(t, err)
+
+	_, _, err = client.Storages.AddReader(context.Background(), "strings.json", strings.NewReader(`{"secret": "file content"}`), -1)
+	require.NoError(t, err)
+
+	log := buf.String()
+	for _, secret := range []string{"access_token", "mt-api-key", "new-api-key", "file content", "mt.example.com"} {
+		assert.NotContains(t, log, secret)
+	}
+
+	records := logRecords(t, &buf)
+	require.Len(t, records, 3)
+
+	assert.JSONEq(t, `{"name":"DeepL","type":"deepl","credentials":"[REDACTED]"}`, records[0]["request_body"].(string))
+	assert.Contains(t, records[0]["response_body"], `"credentials":"[REDACTED]"`)
+	assert.JSONEq(t, `[{"op":"replace","path":"/credentials/apiKey","value":"[REDACTED]"}]`, records[1]["request_body"].(string))
+	assert.Equal(t, "[REDACTED]", records[2]["request_body"])
+	assert.Contains(t, records[2]["response_body"], `"fileName":"strings.json"`)
+}
+
+func TestWithLogger_Disabled(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	client, mux, teardown := setupClient(WithLogger(logger, WithLogBodies()))
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/projects", func(w http.ResponseWriter, r *http.Request) {
+		testBody(t, r, `{"name":"App","sourceLanguageId":"en"}`+"\n")
+		fmt.Fprint(w, `{"data": {"id": 1}}`)
+	})
+
+	_, _, err := client.Projects.Add(context.Background(), &model.ProjectsAddRequest{Name: "App", SourceLanguageID: "en"})
+	require.NoError(t, err)
+	assert.Empty(t, buf.String())
+}
+
+func TestWithLogger_Nil(t *testing.T) {
+	_, err := NewClient("token", WithLogger(nil))
+	require.EqualError(t, err, "logger cannot be nil")
+}
@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -24,6 +25,7 @@ type MachineTranslationEnginesService struct {
 func (s *MachineTranslationEnginesService) GetMT(ctx context.Context, mtID int) (
 	*model.MachineTranslation, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "MachineTranslationEngines.GetMT")
 	res := new(model.MachineTranslationsResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/mts/%d", mtID), nil, res)
 
@@ -36,6 +38,7 @@ func (s *MachineTranslationEnginesService) GetMT(ctx context.Context, mtID int)
 func (s *MachineTranslationEnginesService) ListMT(ctx context.Context, opts *model.MTListOptions) (
 	[]*model.MachineTranslation, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "MachineTranslationEngines.ListMT")
 	res := new(model.MachineTranslationsListResponse)
 	resp, err := s.client.Get(ctx, "/api/v2/mts", opts, res)
 	if err != nil {
@@ -50,12 +53,33 @@ func (s *MachineTranslationEnginesService) ListMT(ctx context.Context, opts *mod
 	return list, resp, err
 }
 
+// AllMT returns an iterator over all machine translations.
+// It fetches the pages with ListMT until a page shorter than the limit is returned.
+func (s *MachineTranslationEnginesService) AllMT(
+	ctx context.Context, opts *model.MTListOptions,
+) iter.Seq2[*model.MachineTranslation, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.MachineTranslation, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListMT(ctx, &pageOpts)
+	})
+}
+
+// ListAllMT returns all machine translations by fetching every page with ListMT.
+func (s *MachineTranslationEnginesService) ListAllMT(ctx context.Context, opts *model.MTListOptions) (
+	[]*model.MachineTranslation, error,
+) {
+	return Collect(s.AllMT(ctx, opts))
+}
+
 // AddMT creates a new machine translation.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.mts.post
 func (s *MachineTranslationEnginesService) AddMT(ctx context.Context, req *model.MTAddRequest) (
 	*model.MachineTranslation, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "MachineTranslationEngines.AddMT")
 	res := new(model.MachineTranslationsResponse)
 	resp, err := s.client.Post(ctx, "/api/v2/mts", req, res)
 
@@ -74,6 +98,7 @@ func (s *MachineTranslationEnginesService) AddMT(ctx context.Context, req *model
 func (s *MachineTranslationEnginesService) EditMT(ctx context.Context, mtID int, req []*model.UpdateRequest) (
 	*model.MachineTranslation, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "MachineTranslationEngines.EditMT")
 	res := new(model.MachineTranslationsResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/mts/%d", mtID), req, res)
 
@@ -84,6 +109,7 @@ func (s *MachineTranslationEnginesService) EditMT(ctx context.Context, mtID int,
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.mts.delete
 func (s *MachineTranslationEnginesService) DeleteMT(ctx context.Context, mtID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "MachineTranslationEngines.DeleteMT")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/mts/%d", mtID))
 }
 
@@ -93,6 +119,7 @@ func (s *MachineTranslationEnginesService) DeleteMT(ctx context.Context, mtID in
 func (s *MachineTranslationEnginesService) Translate(ctx context.Context, mtID int, req *model.TranslateRequest) (
 	*model.MTTranslation, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "MachineTranslationEngines.Translate")
 	res := new(model.MTTranslationResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/mts/%d/translations", mtID), req, res)
 
@@ -0,0 +1,85 @@
+package crowdin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// Doer sends an API request and decodes the API response into v.
+//
+// The returned Response contains the decoded pagination information.
+// API errors are returned as *model.ErrorResponse or *model.ValidationErrorResponse.
+type Doer interface {
+	Do(r *http.Request, v any) (*Response, error)
+}
+
+// DoerFunc is an adapter to allow the use of ordinary functions as a Doer.
+type DoerFunc func(r *http.Request, v any) (*Response, error)
+
+// Do calls f(r, v).
+func (f DoerFunc) Do(r *http.Request, v any) (*Response, error) {
+	return f(r, v)
+}
+
+// Middleware wraps a Doer to intercept API requests and responses.
+// It can be used to add logging, tracing, metrics, request signing, etc.
+//
+// Example:
+//
+//	logger := func(next crowdin.Doer) crowdin.Doer {
+//		return crowdin.DoerFunc(func(r *http.Request, v any) (*crowdin.Response, error) {
+//			resp, err := next.Do(r, v)
+//			log.Printf("%s %s: %v", r.Method, r.URL, err)
+//			return resp, err
+//		})
+//	}
+//	client, err := crowdin.NewClient("token", crowdin.WithMiddleware(logger))
+type Middleware func(next Doer) Doer
+
+// WithMiddleware adds middleware to the client. Middleware wraps every API call
+// including its retries and rate limiting. The middleware passed first is the
+// outermost one, i.e. it sees the request first and the response last.
+func WithMiddleware(mw ...Middleware) ClientOption {
+	return func(c *Client) error {
+		for _, m := range mw {
+			if m == nil {
+				return errors.New("middleware cannot be nil")
+			}
+		}
+
+		c.middleware = append(c.middleware, mw...)
+		return nil
+	}
+}
+
+// chain wraps the Doer with the middleware in reverse order, so the first
+// middleware becomes the outermost one.
+func chain(d Doer, mw []Middleware) Doer {
+	for i := len(mw) - 1; i >= 0; i-- {
+		d = mw[i](d)
+	}
+	return d
+}
+
+// operationNameKey is the context key of the API operation name.
+type operationNameKey struct{}
+
+// WithOperationName returns a copy of ctx with the name of the API operation.
+// It can be used to name requests made with the Client.Get, Client.Post, etc.
+// methods directly. Requests made by the service methods are named after them.
+func WithOperationName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationNameKey{}, name)
+}
+
+// OperationName returns the name of the API operation of the request context,
+// e.g. "SourceFiles.UpdateOrRestoreFile". It is named after the service method
+// which made the request. Middleware can use it to name spans or metrics:
+//
+//	ctx, span := tracer.Start(r.Context(), crowdin.OperationName(r.Context()))
+//
+// It returns an empty string if the name is unknown.
+func OperationName(ctx context.Context) string {
+	name, _ := ctx.Value(operationNameKey{}).(string)
+	return name
+}
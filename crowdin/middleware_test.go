@@ -0,0 +1,184 @@
+package crowdin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithMiddleware_Order(t *testing.T) {
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(r *http.Request, v any) (*Response, error) {
+				calls = append(calls, name+" before")
+				resp, err := next.Do(r, v)
+				calls = append(calls, name+" after")
+				return resp, err
+			})
+		}
+	}
+
+	client, mux, teardown := setupClient(WithMiddleware(record("first"), record("second")), WithMiddleware(record("third")))
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, _ *http.Request) {
+		calls = append(calls, "request")
+		fmt.Fprint(w, `{"data": {"id": 1}}`)
+	})
+
+	_, _, err := client.Storages.Get(context.Background(), 1)
+	require.NoError(t, err)
+
+	expected := []string{
+		"first before", "second before", "third before",
+		"request",
+		"third after", "second after", "first after",
+	}
+	assert.Equal(t, expected, calls)
+}
+
+func TestWithMiddleware_ModifyRequest(t *testing.T) {
+	sign := func(next Doer) Doer {
+		return DoerFunc(func(r *http.Request, v any) (*Response, error) {
+			r.Header.Set("X-Signature", r.Method+" "+r.URL.Path)
+			return next.Do(r, v)
+		})
+	}
+
+	client, mux, teardown := setupClient(WithMiddleware(sign))
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "X-Signature", "DELETE /api/v2/storages/1")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Storages.Delete(context.Background(), 1)
+	require.NoError(t, err)
+}
+
+func TestWithMiddleware_InspectResponse(t *testing.T) {
+	var (
+		pagination model.Pagination
+		apiErr     *model.ErrorResponse
+		decoded    any
+	)
+	inspect := func(next Doer) Doer {
+		return DoerFunc(func(r *http.Request, v any) (*Response, error) {
+			resp, err := next.Do(r, v)
+			if resp != nil {
+				pagination = resp.Pagination
+			}
+			errors.As(err, &apiErr)
+			decoded = v
+			return resp, err
+		})
+	}
+
+	client, mux, teardown := setupClient(WithMiddleware(inspect))
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{
+			"data": [{"data": {"id": 1, "fileName": "file.xliff"}}],
+			"pagination": {"offset": 10, "limit": 25}
+		}`)
+	})
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error": {"code": 404, "message": "Storage Not Found"}}`)
+	})
+
+	_, _, err := client.Storages.List(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, model.Pagination{Offset: 10, Limit: 25}, pagination)
+	require.IsType(t, &model.StorageListResponse{}, decoded)
+	assert.Len(t, decoded.(*model.StorageListResponse).Data, 1)
+
+	_, _, err = client.Storages.Get(context.Background(), 1)
+	require.Error(t, err)
+	require.NotNil(t, apiErr)
+	assert.Equal(t, 404, apiErr.Err.Code)
+	assert.Equal(t, "Storage Not Found", apiErr.Err.Message)
+}
+
+func TestWithMiddleware_ShortCircuit(t *testing.T) {
+	cached := func(Doer) Doer {
+		return DoerFunc(func(_ *http.Request, v any) (*Response, error) {
+			res := v.(*model.StorageGetResponse)
+			res.Data = &model.Storage{ID: 1, FileName: "cached.xliff"}
+			return &Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil
+		})
+	}
+
+	client, mux, teardown := setupClient(WithMiddleware(cached))
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/storages/1", func(http.ResponseWriter, *http.Request) {
+		t.Error("request should not be sent")
+	})
+
+	storage, _, err := client.Storages.Get(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "cached.xliff", storage.FileName)
+}
+
+func TestWithMiddleware_Nil(t *testing.T) {
+	_, err := NewClient("token", WithMiddleware(nil))
+	assert.EqualError(t, err, "middleware cannot be nil")
+}
+
+func TestWithMiddleware_OperationName(t *testing.T) {
+	var names []string
+	record := func(next Doer) Doer {
+		return DoerFunc(func(r *http.Request, v any) (*Response, error) {
+			names = append(names, OperationName(r.Context()))
+			return next.Do(r, v)
+		})
+	}
+
+	client, mux, teardown := setupClient(WithMiddleware(record))
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/projects/1/files/2", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"data": {"id": 2}}`)
+	})
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			fmt.Fprint(w, `{"data": {"id": 1}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": [], "pagination": {"offset": 0, "limit": 500}}`)
+	})
+
+	file, err := os.CreateTemp(t.TempDir(), "*.json")
+	require.NoError(t, err)
+	defer file.Close()
+
+	ctx := context.Background()
+	_, _, err = client.SourceFiles.UpdateOrRestoreFile(ctx, 1, 2, &model.FileUpdateRestoreRequest{StorageID: 1})
+	require.NoError(t, err)
+	_, err = client.Storages.ListAll(ctx, nil)
+	require.NoError(t, err)
+	_, err = client.Get(ctx, "/api/v2/storages", nil, nil)
+	require.NoError(t, err)
+	_, err = client.Get(WithOperationName(ctx, "Custom.ListStorages"), "/api/v2/storages", nil, nil)
+	require.NoError(t, err)
+	_, _, err = client.Storages.Add(ctx, file)
+	require.NoError(t, err)
+	_, _, err = client.Storages.AddReader(ctx, "strings.json", strings.NewReader("{}"), -1)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"SourceFiles.UpdateOrRestoreFile", "Storages.List", "", "Custom.ListStorages", "Storages.Add", "Storages.AddReader",
+	}, names)
+}
@@ -11,8 +11,8 @@ type Branch struct {
 	ProjectID     int     `json:"projectId"`
 	Name          string  `json:"name"`
 	Title         string  `json:"title"`
-	CreatedAt     string  `json:"createdAt"`
-	UpdatedAt     string  `json:"updatedAt"`
+	CreatedAt     Time    `json:"createdAt"`
+	UpdatedAt     Time    `json:"updatedAt"`
 	ExportPattern *string `json:"exportPattern,omitempty"`
 	Priority      *string `json:"priority,omitempty"`
 }
@@ -94,10 +94,10 @@ type BranchMerge struct {
 		SourceBranchID   int  `json:"sourceBranchId"`
 		DeleteAfterMerge bool `json:"deleteAfterMerge"`
 	} `json:"attributes"`
-	CreatedAt  string `json:"createdAt"`
-	UpdatedAt  string `json:"updatedAt"`
-	StartedAt  string `json:"startedAt"`
-	FinishedAt string `json:"finishedAt"`
+	CreatedAt  Time `json:"createdAt"`
+	UpdatedAt  Time `json:"updatedAt"`
+	StartedAt  Time `json:"startedAt"`
+	FinishedAt Time `json:"finishedAt"`
 }
 
 // BranchesMergeResponse describes a response with a single branch merge status.
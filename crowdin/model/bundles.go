@@ -14,8 +14,8 @@ type Bundle struct {
 	IncludeProjectSourceLanguage bool     `json:"includeProjectSourceLanguage"`
 	LabelIDs                     []int    `json:"labelIds"`
 	ExcludeLabelIDs              []int    `json:"excludeLabelIds"`
-	CreatedAt                    string   `json:"createdAt"`
-	UpdatedAt                    string   `json:"updatedAt"`
+	CreatedAt                    Time     `json:"createdAt"`
+	UpdatedAt                    Time     `json:"updatedAt"`
 }
 
 // BundleResponse defines the structure of a response
@@ -87,10 +87,10 @@ type BundleExport struct {
 	Attributes struct {
 		BundleID int `json:"bundleId"`
 	} `json:"attributes"`
-	CreatedAt  string `json:"createdAt"`
-	UpdatedAt  string `json:"updatedAt"`
-	StartedAt  string `json:"startedAt"`
-	FinishedAt string `json:"finishedAt"`
+	CreatedAt  Time `json:"createdAt"`
+	UpdatedAt  Time `json:"updatedAt"`
+	StartedAt  Time `json:"startedAt"`
+	FinishedAt Time `json:"finishedAt"`
 }
 
 // BundleExportResponse defines the structure of a response
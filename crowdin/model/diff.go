@@ -0,0 +1,273 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// resourcePatch returns the patch of the resource which can be edited
+// with the operations generated for the type.
+func resourcePatch(t reflect.Type) (patch, bool) {
+	switch t {
+	case reflect.TypeFor[Project]():
+		return ProjectPatch().patch, true
+	case reflect.TypeFor[Branch]():
+		return BranchPatch().patch, true
+	case reflect.TypeFor[Label]():
+		return LabelPatch().patch, true
+	case reflect.TypeFor[Glossary]():
+		return GlossaryPatch().patch, true
+	case reflect.TypeFor[Task]():
+		return TaskPatch().patch, true
+	}
+	return patch{}, false
+}
+
+// Diff returns the JSON Patch operations which change the `from` model
+// into the `to` model, e.g. to edit a project by modifying its copy:
+//
+//	edited := *project
+//	edited.Name = "Website"
+//	edited.TargetLanguageIDs = append(edited.TargetLanguageIDs, "uk")
+//
+//	req, err := model.Diff(project, &edited)
+//	if err != nil {
+//		return err
+//	}
+//	project, _, err = client.Projects.Edit(ctx, project.ID, req)
+//
+// The models are projects, branches, labels, glossaries and tasks. Only
+// the fields which can be edited, as allowed by ProjectPatch, BranchPatch,
+// LabelPatch, GlossaryPatch and TaskPatch, are compared; the other fields,
+// such as identifiers and creation times, are skipped.
+//
+// The paths are the JSON names of the fields. Changed fields are replaced,
+// except for the keys of maps and nil pointers, which are added or removed,
+// and zeroed times, which are removed. The added values include only the fields which are not zero.
+// Structs and maps are compared field by field and key by key, if the nested
+// values can be edited, e.g. a changed language mapping is replaced as
+// "/languageMapping/uk/locale". Slices are replaced as a whole.
+//
+// It returns an error if an operation is not allowed for the resource,
+// e.g. a removed task deadline. It returns an empty slice if the models are equal.
+func Diff[T any](from, to T) ([]*UpdateRequest, error) {
+	a, b := reflect.ValueOf(&from).Elem(), reflect.ValueOf(&to).Elem()
+	for a.Kind() == reflect.Pointer || a.Kind() == reflect.Interface {
+		if a.IsNil() || b.IsNil() {
+			return nil, errors.New("diff: models cannot be nil")
+		}
+		a, b = a.Elem(), b.Elem()
+	}
+	if a.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("diff: %s is not a struct", a.Type())
+	}
+	if a.Type() != b.Type() {
+		return nil, fmt.Errorf("diff: different types %s and %s", a.Type(), b.Type())
+	}
+	p, ok := resourcePatch(a.Type())
+	if !ok {
+		return nil, fmt.Errorf("diff: %s cannot be edited", a.Type())
+	}
+
+	d := &differ{reqs: []*UpdateRequest{}}
+	for _, f := range structFields(a.Type()) {
+		nested, ok := p.fields[f.name]
+		if !ok {
+			continue
+		}
+
+		va, vb := a.FieldByIndex(f.index), b.FieldByIndex(f.index)
+		if k := va.Kind(); !nested && (k == reflect.Struct || k == reflect.Map) && !isLeaf(va.Type()) {
+			if !reflect.DeepEqual(va.Interface(), vb.Interface()) {
+				d.add(OpReplace, []string{f.name}, vb)
+			}
+			continue
+		}
+		d.diff([]string{f.name}, va, vb)
+	}
+
+	for _, req := range d.reqs {
+		if err := p.check(req.Op, req.Path); err != nil {
+			return nil, fmt.Errorf("diff: %s patch: %w", p.resource, err)
+		}
+	}
+	return d.reqs, nil
+}
+
+// differ collects the operations of a diff.
+type differ struct {
+	reqs []*UpdateRequest
+}
+
+func (d *differ) add(op PatchOp, tokens []string, v reflect.Value) {
+	req := &UpdateRequest{Op: op, Path: JSONPointer(tokens...)}
+	switch op {
+	case OpRemove:
+	case OpAdd:
+		req.Value = addedValue(v)
+	default:
+		req.Value = patchValue(v)
+	}
+	d.reqs = append(d.reqs, req)
+}
+
+// diff compares the values of the same type.
+func (d *differ) diff(tokens []string, a, b reflect.Value) {
+	if isLeaf(a.Type()) {
+		switch {
+		case reflect.DeepEqual(a.Interface(), b.Interface()):
+		case isZeroTime(b) && !isZeroTime(a):
+			d.add(OpRemove, tokens, b)
+		default:
+			d.add(OpReplace, tokens, b)
+		}
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		switch {
+		case a.IsNil() && b.IsNil():
+		case a.IsNil():
+			d.add(OpAdd, tokens, b.Elem())
+		case b.IsNil():
+			d.add(OpRemove, tokens, b)
+		case a.Elem().Type() != b.Elem().Type():
+			d.add(OpReplace, tokens, b.Elem())
+		default:
+			d.diff(tokens, a.Elem(), b.Elem())
+		}
+	case reflect.Struct:
+		d.diffStruct(tokens, a, b)
+	case reflect.Map:
+		d.diffMap(tokens, a, b)
+	case reflect.Slice:
+		if (a.Len() != 0 || b.Len() != 0) && !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			d.add(OpReplace, tokens, b)
+		}
+	default:
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			d.add(OpReplace, tokens, b)
+		}
+	}
+}
+
+// diffStruct compares the exported fields of the structs.
+func (d *differ) diffStruct(tokens []string, a, b reflect.Value) {
+	for _, f := range structFields(a.Type()) {
+		d.diff(append(slices.Clip(tokens), f.name), a.FieldByIndex(f.index), b.FieldByIndex(f.index))
+	}
+}
+
+// structField is an exported field of a struct with its JSON name.
+type structField struct {
+	name  string
+	index []int
+}
+
+// structFields returns the exported fields of the struct type,
+// including the promoted fields of the embedded structs.
+func structFields(t reflect.Type) []structField {
+	var fields []structField
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		switch {
+		case name == "-":
+			continue
+		case name == "" && f.Anonymous && f.Type.Kind() == reflect.Struct:
+			for _, promoted := range structFields(f.Type) {
+				promoted.index = append([]int{i}, promoted.index...)
+				fields = append(fields, promoted)
+			}
+			continue
+		case name == "":
+			name = f.Name
+		}
+		fields = append(fields, structField{name: name, index: []int{i}})
+	}
+	return fields
+}
+
+// diffMap compares the maps key by key in the order of the keys.
+func (d *differ) diffMap(tokens []string, a, b reflect.Value) {
+	keys := make(map[string]reflect.Value)
+	for _, m := range []reflect.Value{a, b} {
+		for _, k := range m.MapKeys() {
+			keys[fmt.Sprint(k.Interface())] = k
+		}
+	}
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		k := keys[name]
+		va, vb := a.MapIndex(k), b.MapIndex(k)
+		path := append(slices.Clip(tokens), name)
+		switch {
+		case !vb.IsValid():
+			d.add(OpRemove, path, vb)
+		case !va.IsValid():
+			d.add(OpAdd, path, vb)
+		default:
+			d.diff(path, va, vb)
+		}
+	}
+}
+
+// isLeaf reports whether the values of the type are compared as a whole,
+// e.g. Time.
+func isLeaf(t reflect.Type) bool {
+	marshaler := reflect.TypeFor[json.Marshaler]()
+	return t.Kind() != reflect.Interface && t.Kind() != reflect.Pointer &&
+		(t.Implements(marshaler) || reflect.PointerTo(t).Implements(marshaler))
+}
+
+// patchValue returns the value of an operation. Nil slices are replaced
+// with empty ones, so they are encoded as empty arrays.
+func patchValue(v reflect.Value) any {
+	if v.Kind() == reflect.Slice && v.IsNil() {
+		return reflect.MakeSlice(v.Type(), 0, 0).Interface()
+	}
+	return v.Interface()
+}
+
+// addedValue returns the value of an "add" operation. Only the fields
+// of structs which are not zero are included, so the values which are
+// not set are not overwritten.
+func addedValue(v reflect.Value) any {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct || isLeaf(v.Type()) {
+		return patchValue(v)
+	}
+
+	values := make(map[string]any)
+	for _, f := range structFields(v.Type()) {
+		if fv := v.FieldByIndex(f.index); !fv.IsZero() {
+			values[f.name] = addedValue(fv)
+		}
+	}
+	return values
+}
+
+// isZeroTime reports whether the value is a zero Time.
+func isZeroTime(v reflect.Value) bool {
+	t, ok := v.Interface().(Time)
+	return ok && t.IsZero()
+}
@@ -0,0 +1,146 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff_Project(t *testing.T) {
+	project := &Project{
+		ID:                1,
+		Name:              "App",
+		TargetLanguageIDs: []string{"de"},
+		HasCrowdsourcing:  true,
+		WebURL:            "https://crowdin.com/project/app",
+		CreatedAt:         Time{Time: time.Date(2023, 9, 20, 11, 34, 40, 0, time.UTC)},
+		LanguageMapping: map[string]LanguageMapping{
+			"de": {Locale: "de"},
+			"fr": {Locale: "fr"},
+		},
+		TMPreTranslate: &ProjectTMPreTranslate{AutoApproveOption: "all"},
+		Fields:         map[string]any{"team": "web", "owner/lead": "ann"},
+	}
+
+	edited := *project
+	edited.ID = 2
+	edited.WebURL = ""
+	edited.CreatedAt = Time{}
+	edited.Name = "Website"
+	edited.TargetLanguageIDs = append(edited.TargetLanguageIDs, "uk")
+	edited.HasCrowdsourcing = false
+	edited.SourceLanguageID = "fr"
+	edited.LanguageMapping = map[string]LanguageMapping{
+		"de": {Locale: "de-DE"},
+		"uk": {Locale: "ua"},
+	}
+	enabled, disabled := true, false
+	edited.TMPreTranslate = &ProjectTMPreTranslate{AutoApproveOption: "none", Enabled: &enabled}
+	edited.MTPreTranslate = &ProjectMTPreTranslate{Enabled: &disabled}
+	edited.Fields = map[string]any{"team": "web", "owner/lead": "bob"}
+
+	req, err := Diff(project, &edited)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"op": "replace", "path": "/targetLanguageIds", "value": ["de", "uk"]},
+		{"op": "replace", "path": "/name", "value": "Website"},
+		{"op": "replace", "path": "/fields/owner~1lead", "value": "bob"},
+		{"op": "replace", "path": "/languageMapping/de/locale", "value": "de-DE"},
+		{"op": "remove", "path": "/languageMapping/fr"},
+		{"op": "add", "path": "/languageMapping/uk", "value": {"locale": "ua"}},
+		{"op": "add", "path": "/tmPreTranslate/enabled", "value": true},
+		{"op": "replace", "path": "/tmPreTranslate/autoApproveOption", "value": "none"},
+		{"op": "add", "path": "/mtPreTranslate", "value": {"enabled": false}}
+	]`, string(data))
+
+	for _, r := range req {
+		require.NoError(t, r.Validate())
+	}
+}
+
+func TestDiff_Task(t *testing.T) {
+	task := Task{
+		ID:        1,
+		Title:     "Translate",
+		Deadline:  Time{Time: time.Date(2023, 9, 27, 7, 0, 14, 0, time.UTC)},
+		FileIDs:   []int{1},
+		UpdatedAt: Time{Time: time.Date(2023, 9, 20, 11, 34, 40, 0, time.UTC)},
+	}
+
+	edited := task
+	edited.Deadline = Time{Time: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)}
+	edited.FileIDs = nil
+	edited.UpdatedAt = Time{}
+	edited.Progress = TaskProgress{Total: 10}
+
+	req, err := Diff(task, edited)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"op": "replace", "path": "/deadline", "value": "2023-10-01T00:00:00Z"},
+		{"op": "replace", "path": "/fileIds", "value": []}
+	]`, string(data))
+
+	task.StartedAt, edited.StartedAt = Time{}, edited.Deadline
+	req, err = Diff(task, edited)
+	require.NoError(t, err)
+	assert.Equal(t, OpReplace, req[1].Op)
+	assert.Equal(t, "/startedAt", req[1].Path)
+
+	edited.Deadline = Time{}
+	_, err = Diff(task, edited)
+	require.EqualError(t, err, `diff: task patch: op "remove" is not allowed`)
+}
+
+func TestDiff_Equal(t *testing.T) {
+	branch := &Branch{ID: 1, Name: "main", Title: "Main"}
+	edited := *branch
+	edited.UpdatedAt = Time{Time: time.Date(2023, 9, 20, 11, 34, 40, 0, time.UTC)}
+
+	req, err := Diff(branch, &edited)
+	require.NoError(t, err)
+	assert.Empty(t, req)
+
+	edited.Title = "Release"
+	req, err = Diff(branch, &edited)
+	require.NoError(t, err)
+	assert.Equal(t, []*UpdateRequest{{Op: OpReplace, Path: "/title", Value: "Release"}}, req)
+}
+
+func TestDiff_Glossary(t *testing.T) {
+	glossary := Glossary{ID: 1, Name: "Terms", LanguageID: "en", Terms: 25, ProjectIDs: []int{2}}
+	edited := glossary
+	edited.Name = "Website"
+	edited.LanguageID = "de"
+	edited.Terms = 30
+	edited.ProjectIDs = []int{2, 3}
+
+	req, err := Diff(glossary, edited)
+	require.NoError(t, err)
+	assert.Equal(t, []*UpdateRequest{
+		{Op: OpReplace, Path: "/name", Value: "Website"},
+		{Op: OpReplace, Path: "/languageId", Value: "de"},
+	}, req)
+}
+
+func TestDiff_Errors(t *testing.T) {
+	_, err := Diff(&Project{}, nil)
+	require.EqualError(t, err, "diff: models cannot be nil")
+
+	_, err = Diff("a", "b")
+	require.EqualError(t, err, "diff: string is not a struct")
+
+	_, err = Diff[any](&Project{}, &Glossary{})
+	require.EqualError(t, err, "diff: different types model.Project and model.Glossary")
+
+	_, err = Diff(&Language{}, &Language{Name: "Ukrainian"})
+	require.EqualError(t, err, "diff: model.Language cannot be edited")
+}
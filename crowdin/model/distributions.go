@@ -0,0 +1,112 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DistributionExportMode represents the export mode of a distribution.
+type DistributionExportMode string
+
+const (
+	// DistributionExportModeDefault exports the project files
+	// in their source formats.
+	DistributionExportModeDefault DistributionExportMode = "default"
+	// DistributionExportModeBundle exports the project bundles.
+	DistributionExportModeBundle DistributionExportMode = "bundle"
+)
+
+// DistributionReleaseStatus represents the status of a distribution release.
+type DistributionReleaseStatus string
+
+const (
+	DistributionReleaseStatusInProgress DistributionReleaseStatus = "inProgress"
+	DistributionReleaseStatusSuccess    DistributionReleaseStatus = "success"
+	DistributionReleaseStatusFailed     DistributionReleaseStatus = "failed"
+)
+
+// Distribution represents an over-the-air content delivery distribution.
+// The distribution is identified by its hash.
+type Distribution struct {
+	Hash       string                 `json:"hash"`
+	Name       string                 `json:"name"`
+	ExportMode DistributionExportMode `json:"exportMode"`
+	FileIDs    []int                  `json:"fileIds"`
+	BundleIDs  []int                  `json:"bundleIds"`
+	CreatedAt  Time                   `json:"createdAt"`
+	UpdatedAt  Time                   `json:"updatedAt"`
+}
+
+// DistributionResponse defines the structure of a response when
+// getting a distribution.
+type DistributionResponse struct {
+	Data *Distribution `json:"data"`
+}
+
+// DistributionsListResponse defines the structure of a response when
+// getting a list of distributions.
+type DistributionsListResponse struct {
+	Data []*DistributionResponse `json:"data"`
+}
+
+// DistributionAddRequest defines the structure of a request to add a distribution.
+type DistributionAddRequest struct {
+	// Distribution name.
+	Name string `json:"name"`
+	// Export mode. Enum: default, bundle. Default: default.
+	ExportMode DistributionExportMode `json:"exportMode,omitempty"`
+	// File Identifiers.
+	// Note: Required for the default export mode.
+	FileIDs []int `json:"fileIds,omitempty"`
+	// Bundle Identifiers.
+	// Note: Required for the bundle export mode.
+	BundleIDs []int `json:"bundleIds,omitempty"`
+}
+
+// Validate checks if the request is valid.
+// It implements the crowdin.RequestValidator interface.
+func (r *DistributionAddRequest) Validate() error {
+	if r == nil {
+		return ErrNilRequest
+	}
+	if r.Name == "" {
+		return errors.New("name is required")
+	}
+
+	switch r.ExportMode {
+	case "", DistributionExportModeDefault:
+		if len(r.FileIDs) == 0 {
+			return errors.New("fileIds is required for the default export mode")
+		}
+		if len(r.BundleIDs) > 0 {
+			return errors.New("bundleIds can be used only with the bundle export mode")
+		}
+	case DistributionExportModeBundle:
+		if len(r.BundleIDs) == 0 {
+			return errors.New("bundleIds is required for the bundle export mode")
+		}
+		if len(r.FileIDs) > 0 {
+			return errors.New("fileIds can be used only with the default export mode")
+		}
+	default:
+		return fmt.Errorf("exportMode must be one of %q, %q",
+			DistributionExportModeDefault, DistributionExportModeBundle)
+	}
+
+	return nil
+}
+
+// DistributionRelease represents the state of a distribution release.
+type DistributionRelease struct {
+	Status            DistributionReleaseStatus `json:"status"`
+	Progress          int                       `json:"progress"`
+	CurrentLanguageID string                    `json:"currentLanguageId"`
+	CurrentFileID     int                       `json:"currentFileId"`
+	Date              Time                      `json:"date"`
+}
+
+// DistributionReleaseResponse defines the structure of a response when
+// getting a distribution release.
+type DistributionReleaseResponse struct {
+	Data *DistributionRelease `json:"data"`
+}
@@ -11,8 +11,43 @@ import (
 var (
 	// ErrNilRequest is returned when a request for a validation is nil.
 	ErrNilRequest = errors.New("request cannot be nil")
+	// ErrEnterpriseOnly is returned when a method of the Enterprise API
+	// is called by a client created without the WithOrganization option.
+	ErrEnterpriseOnly = errors.New("available only for Enterprise clients, use the WithOrganization option")
+
+	// ErrNotFound matches API errors with the 404 Not Found status (see errors.Is).
+	ErrNotFound = errors.New("not found")
+	// ErrUnauthorized matches API errors with the 401 Unauthorized status (see errors.Is).
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden matches API errors with the 403 Forbidden status (see errors.Is).
+	ErrForbidden = errors.New("forbidden")
+	// ErrConflict matches API errors with the 409 Conflict status (see errors.Is).
+	ErrConflict = errors.New("conflict")
+	// ErrRateLimited matches API errors with the 429 Too Many Requests status (see errors.Is).
+	ErrRateLimited = errors.New("rate limited")
+	// ErrValidation matches validation errors of the API (see errors.Is).
+	ErrValidation = errors.New("validation failed")
 )
 
+// statusError returns the error matching the HTTP status code.
+func statusError(code int) error {
+	switch code {
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusConflict:
+		return ErrConflict
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrValidation
+	}
+	return nil
+}
+
 // Error represents the schema for the error response.
 type Error struct {
 	Code    any    `json:"code"`
@@ -53,6 +88,21 @@ type ErrorResponse struct {
 	Response *http.Response `json:"-"`
 
 	Err Error `json:"error"`
+
+	// statusOnly is set if the response body is not an API error.
+	statusOnly bool
+}
+
+// NewStatusErrorResponse returns the error of the response whose body is
+// not an API error (e.g. an error page of a proxy). The error is described
+// by the status code. Since no validation errors were returned, it does not
+// match ErrValidation.
+func NewStatusErrorResponse(resp *http.Response) *ErrorResponse {
+	return &ErrorResponse{
+		Response:   resp,
+		Err:        Error{Code: resp.StatusCode, Message: http.StatusText(resp.StatusCode)},
+		statusOnly: true,
+	}
 }
 
 // Error implements the Error interface.
@@ -60,6 +110,28 @@ func (r *ErrorResponse) Error() string {
 	return fmt.Sprintf("%d %s", r.Err.Code, r.Err.Message)
 }
 
+// StatusCode returns the HTTP status code of the error response.
+// If the HTTP response is not set, the numeric error code is returned.
+func (r *ErrorResponse) StatusCode() int {
+	if r.Response != nil {
+		return r.Response.StatusCode
+	}
+	if code, ok := r.Err.Code.(int); ok {
+		return code
+	}
+	return 0
+}
+
+// Is reports whether the error matches the target error
+// (e.g. ErrNotFound for the 404 Not Found status).
+func (r *ErrorResponse) Is(target error) bool {
+	err := statusError(r.StatusCode())
+	if err == ErrValidation && r.statusOnly {
+		return false
+	}
+	return err != nil && err == target
+}
+
 // ValidationError represents the schema for the invalid
 // request error response.
 type ValidationError struct {
@@ -78,6 +150,21 @@ type ValidationErrorResponse struct {
 	Status int
 }
 
+// Is reports whether the target error is ErrValidation.
+func (r *ValidationErrorResponse) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// FieldErrors returns the validation errors keyed by the path of the field
+// (e.g. "name" or "fields.0.value").
+func (r *ValidationErrorResponse) FieldErrors() map[string][]Error {
+	fields := make(map[string][]Error, len(r.Errors))
+	for _, err := range r.Errors {
+		fields[err.Error.Key] = append(fields[err.Error.Key], err.Error.Errors...)
+	}
+	return fields
+}
+
 // Error implements the Error interface.
 func (r *ValidationErrorResponse) Error() string {
 	var sb strings.Builder
@@ -3,10 +3,12 @@ package model
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestErrorResponse_Error(t *testing.T) {
@@ -276,3 +278,67 @@ func handleErrorResponse(r *http.Response, body []byte) error {
 
 	return errorResponse
 }
+
+func TestErrorResponse_Is(t *testing.T) {
+	cases := []struct {
+		status   int
+		code     any
+		expected error
+	}{
+		{status: http.StatusNotFound, code: 404, expected: ErrNotFound},
+		{status: http.StatusUnauthorized, code: 401, expected: ErrUnauthorized},
+		{status: http.StatusForbidden, code: 403, expected: ErrForbidden},
+		{status: http.StatusConflict, code: "conflict", expected: ErrConflict},
+		{status: http.StatusTooManyRequests, code: 429, expected: ErrRateLimited},
+	}
+
+	for _, tt := range cases {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			err := fmt.Errorf("wrapped: %w", &ErrorResponse{
+				Response: &http.Response{StatusCode: tt.status},
+				Err:      Error{Code: tt.code, Message: http.StatusText(tt.status)},
+			})
+
+			assert.ErrorIs(t, err, tt.expected)
+			for _, other := range []error{ErrNotFound, ErrUnauthorized, ErrForbidden, ErrConflict, ErrRateLimited, ErrValidation} {
+				if other != tt.expected {
+					assert.NotErrorIs(t, err, other)
+				}
+			}
+		})
+	}
+
+	t.Run("without response", func(t *testing.T) {
+		err := &ErrorResponse{Err: Error{Code: 404, Message: "Not Found"}}
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Equal(t, http.StatusNotFound, err.StatusCode())
+
+		err = &ErrorResponse{Err: Error{Code: "unknown"}}
+		assert.Equal(t, 0, err.StatusCode())
+		assert.NotErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestValidationErrorResponse_FieldErrors(t *testing.T) {
+	var err ValidationErrorResponse
+	body := []byte(`{
+		"errors": [
+			{"error": {"key": "name", "errors": [{"code": "isEmpty", "message": "Value is required"}]}},
+			{"error": {"key": "fields.0.value", "errors": [{"code": "notString", "message": "Value must be a string"}]}},
+			{"error": {"key": "name", "errors": [{"code": 1, "message": "Name is too short"}]}}
+		]
+	}`)
+	require.NoError(t, json.Unmarshal(body, &err))
+
+	assert.ErrorIs(t, &err, ErrValidation)
+	assert.NotErrorIs(t, &err, ErrNotFound)
+	assert.Equal(t, map[string][]Error{
+		"name": {
+			{Code: "isEmpty", Message: "Value is required"},
+			{Code: 1, Message: "Name is too short"},
+		},
+		"fields.0.value": {
+			{Code: "notString", Message: "Value must be a string"},
+		},
+	}, err.FieldErrors())
+}
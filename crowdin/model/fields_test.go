@@ -0,0 +1,39 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFields_UnmarshalJSON(t *testing.T) {
+	// The custom fields are returned as an object keyed by the field slug.
+	const payload = `{"id": 1, "fields": {"fieldSlug": "fieldValue", "reviewers": ["ann", "bob"], "priority": 2}}`
+	expected := map[string]any{
+		"fieldSlug": "fieldValue",
+		"reviewers": []any{"ann", "bob"},
+		"priority":  float64(2),
+	}
+
+	var project Project
+	require.NoError(t, json.Unmarshal([]byte(payload), &project))
+	assert.Equal(t, expected, project.Fields)
+
+	var file File
+	require.NoError(t, json.Unmarshal([]byte(payload), &file))
+	assert.Equal(t, expected, file.Fields)
+
+	var str SourceString
+	require.NoError(t, json.Unmarshal([]byte(payload), &str))
+	assert.Equal(t, expected, str.Fields)
+
+	var task Task
+	require.NoError(t, json.Unmarshal([]byte(payload), &task))
+	assert.Equal(t, expected, task.Fields)
+
+	var user User
+	require.NoError(t, json.Unmarshal([]byte(payload), &user))
+	assert.Equal(t, expected, user.Fields)
+}
@@ -19,8 +19,8 @@ type (
 		URL              string                     `json:"url"`    // Base URL.
 		Figure           string                     `json:"figure"` // Figure URL.
 		LanguagesDetails []*ConceptLanguagesDetails `json:"languagesDetails"`
-		CreatedAt        string                     `json:"createdAt"`
-		UpdatedAt        string                     `json:"updatedAt"`
+		CreatedAt        Time                       `json:"createdAt"`
+		UpdatedAt        Time                       `json:"updatedAt"`
 	}
 
 	// ConceptLanguagesDetails represents the language details of a concept.
@@ -29,8 +29,8 @@ type (
 		UserID     int    `json:"userId"`
 		Definition string `json:"definition"`
 		Note       string `json:"note"`
-		CreatedAt  string `json:"createdAt"`
-		UpdatedAt  string `json:"updatedAt"`
+		CreatedAt  Time   `json:"createdAt"`
+		UpdatedAt  Time   `json:"updatedAt"`
 	}
 )
 
@@ -121,7 +121,7 @@ type Glossary struct {
 	DefaultProjectIDs []int    `json:"defaultProjectIds"`
 	ProjectIDs        []int    `json:"projectIds"`
 	WebURL            string   `json:"webUrl"`
-	CreatedAt         string   `json:"createdAt"`
+	CreatedAt         Time     `json:"createdAt"`
 }
 
 // GlossaryResponse defines the structure of a response when
@@ -213,10 +213,10 @@ type GlossaryExport struct {
 		Format       string   `json:"format"`
 		ExportFields []string `json:"exportFields"`
 	} `json:"attributes"`
-	CreatedAt  string `json:"createdAt"`
-	UpdatedAt  string `json:"updatedAt"`
-	StartedAt  string `json:"startedAt"`
-	FinishedAt string `json:"finishedAt"`
+	CreatedAt  Time `json:"createdAt"`
+	UpdatedAt  Time `json:"updatedAt"`
+	StartedAt  Time `json:"startedAt"`
+	FinishedAt Time `json:"finishedAt"`
 }
 
 // GlossaryExportResponse defines the structure of a response when
@@ -258,10 +258,10 @@ type GlossaryImport struct {
 		Scheme                  map[string]int `json:"scheme"`
 		FirstLineContainsHeader bool           `json:"firstLineContainsHeader"`
 	} `json:"attributes"`
-	CreatedAt  string `json:"createdAt"`
-	UpdatedAt  string `json:"updatedAt"`
-	StartedAt  string `json:"startedAt"`
-	FinishedAt string `json:"finishedAt"`
+	CreatedAt  Time `json:"createdAt"`
+	UpdatedAt  Time `json:"updatedAt"`
+	StartedAt  Time `json:"startedAt"`
+	FinishedAt Time `json:"finishedAt"`
 }
 
 // GlossaryImportResponse defines the structure of a response when
@@ -395,8 +395,8 @@ type Term struct {
 	URL          string `json:"url"`
 	ConceptID    int    `json:"conceptId"`
 	Lemma        string `json:"lemma"`
-	CreatedAt    string `json:"createdAt"`
-	UpdatedAt    string `json:"updatedAt"`
+	CreatedAt    Time   `json:"createdAt"`
+	UpdatedAt    Time   `json:"updatedAt"`
 }
 
 // TermResponse defines the structure of a response when
@@ -17,8 +17,8 @@ type Group struct {
 	SubgroupsCount int    `json:"subgroupsCount"`
 	ProjectsCount  int    `json:"projectsCount"`
 	WebURL         string `json:"webUrl"`
-	CreatedAt      string `json:"createdAt"`
-	UpdatedAt      string `json:"updatedAt"`
+	CreatedAt      Time   `json:"createdAt"`
+	UpdatedAt      Time   `json:"updatedAt"`
 }
 
 // GroupsListOptions specifies the optional parameters to the GroupsService.List method.
@@ -0,0 +1,245 @@
+package model
+
+// OperationStatus represents the status of an asynchronous operation
+// such as a build, a merge, an import or an export.
+type OperationStatus string
+
+// OperationStatus values.
+const (
+	OperationStatusCreated    OperationStatus = "created"
+	OperationStatusInProgress OperationStatus = "inProgress"
+	OperationStatusFinished   OperationStatus = "finished"
+	OperationStatusFailed     OperationStatus = "failed"
+	OperationStatusCanceled   OperationStatus = "canceled"
+)
+
+// IsTerminal reports whether the operation has completed,
+// either successfully or not.
+func (s OperationStatus) IsTerminal() bool {
+	switch s {
+	case OperationStatusFinished, OperationStatusFailed, OperationStatusCanceled, "cancelled":
+		return true
+	}
+	return false
+}
+
+// AsyncOperation is implemented by the models describing the state
+// of an asynchronous operation.
+type AsyncOperation interface {
+	// OperationStatus returns the current status of the operation.
+	OperationStatus() OperationStatus
+	// OperationProgress returns the progress of the operation in percent.
+	OperationProgress() int
+}
+
+// OperationStatus implements the AsyncOperation interface.
+func (b *BranchMerge) OperationStatus() OperationStatus {
+	if b == nil {
+		return ""
+	}
+	return OperationStatus(b.Status)
+}
+
+// OperationProgress implements the AsyncOperation interface.
+func (b *BranchMerge) OperationProgress() int {
+	if b == nil {
+		return 0
+	}
+	return b.Progress
+}
+
+// OperationStatus implements the AsyncOperation interface.
+func (e *BundleExport) OperationStatus() OperationStatus {
+	if e == nil {
+		return ""
+	}
+	return OperationStatus(e.Status)
+}
+
+// OperationProgress implements the AsyncOperation interface.
+func (e *BundleExport) OperationProgress() int {
+	if e == nil {
+		return 0
+	}
+	return e.Progress
+}
+
+// OperationStatus implements the AsyncOperation interface.
+func (e *GlossaryExport) OperationStatus() OperationStatus {
+	if e == nil {
+		return ""
+	}
+	return OperationStatus(e.Status)
+}
+
+// OperationProgress implements the AsyncOperation interface.
+func (e *GlossaryExport) OperationProgress() int {
+	if e == nil {
+		return 0
+	}
+	return e.Progress
+}
+
+// OperationStatus implements the AsyncOperation interface.
+func (i *GlossaryImport) OperationStatus() OperationStatus {
+	if i == nil {
+		return ""
+	}
+	return OperationStatus(i.Status)
+}
+
+// OperationProgress implements the AsyncOperation interface.
+func (i *GlossaryImport) OperationProgress() int {
+	if i == nil {
+		return 0
+	}
+	return i.Progress
+}
+
+// OperationStatus implements the AsyncOperation interface.
+func (r *ReportStatus) OperationStatus() OperationStatus {
+	if r == nil {
+		return ""
+	}
+	return OperationStatus(r.Status)
+}
+
+// OperationProgress implements the AsyncOperation interface.
+func (r *ReportStatus) OperationProgress() int {
+	if r == nil {
+		return 0
+	}
+	return r.Progress
+}
+
+// OperationStatus implements the AsyncOperation interface.
+func (b *ReviewedBuild) OperationStatus() OperationStatus {
+	if b == nil {
+		return ""
+	}
+	return OperationStatus(b.Status)
+}
+
+// OperationProgress implements the AsyncOperation interface.
+func (b *ReviewedBuild) OperationProgress() int {
+	if b == nil {
+		return 0
+	}
+	return b.Progress
+}
+
+// OperationStatus implements the AsyncOperation interface.
+func (u *SourceStringsUpload) OperationStatus() OperationStatus {
+	if u == nil {
+		return ""
+	}
+	return OperationStatus(u.Status)
+}
+
+// OperationProgress implements the AsyncOperation interface.
+func (u *SourceStringsUpload) OperationProgress() int {
+	if u == nil {
+		return 0
+	}
+	return u.Progress
+}
+
+// OperationStatus implements the AsyncOperation interface.
+func (e *TranslationMemoryExport) OperationStatus() OperationStatus {
+	if e == nil {
+		return ""
+	}
+	return OperationStatus(e.Status)
+}
+
+// OperationProgress implements the AsyncOperation interface.
+func (e *TranslationMemoryExport) OperationProgress() int {
+	if e == nil {
+		return 0
+	}
+	return e.Progress
+}
+
+// OperationStatus implements the AsyncOperation interface.
+func (i *TranslationMemoryImport) OperationStatus() OperationStatus {
+	if i == nil {
+		return ""
+	}
+	return OperationStatus(i.Status)
+}
+
+// OperationProgress implements the AsyncOperation interface.
+func (i *TranslationMemoryImport) OperationProgress() int {
+	if i == nil {
+		return 0
+	}
+	return i.Progress
+}
+
+// OperationStatus implements the AsyncOperation interface.
+func (p *PreTranslation) OperationStatus() OperationStatus {
+	if p == nil {
+		return ""
+	}
+	return OperationStatus(p.Status)
+}
+
+// OperationProgress implements the AsyncOperation interface.
+func (p *PreTranslation) OperationProgress() int {
+	if p == nil {
+		return 0
+	}
+	return p.Progress
+}
+
+// OperationStatus implements the AsyncOperation interface.
+func (b *BuildProjectDirectoryTranslation) OperationStatus() OperationStatus {
+	if b == nil {
+		return ""
+	}
+	return OperationStatus(b.Status)
+}
+
+// OperationProgress implements the AsyncOperation interface.
+func (b *BuildProjectDirectoryTranslation) OperationProgress() int {
+	if b == nil {
+		return 0
+	}
+	return b.Progress
+}
+
+// OperationStatus implements the AsyncOperation interface.
+func (b *TranslationsProjectBuild) OperationStatus() OperationStatus {
+	if b == nil {
+		return ""
+	}
+	return OperationStatus(b.Status)
+}
+
+// OperationProgress implements the AsyncOperation interface.
+func (b *TranslationsProjectBuild) OperationProgress() int {
+	if b == nil {
+		return 0
+	}
+	return b.Progress
+}
+
+// OperationStatus implements the AsyncOperation interface.
+// The "success" status of a release is reported as OperationStatusFinished.
+func (r *DistributionRelease) OperationStatus() OperationStatus {
+	if r == nil {
+		return ""
+	}
+	if r.Status == DistributionReleaseStatusSuccess {
+		return OperationStatusFinished
+	}
+	return OperationStatus(r.Status)
+}
+
+// OperationProgress implements the AsyncOperation interface.
+func (r *DistributionRelease) OperationProgress() int {
+	if r == nil {
+		return 0
+	}
+	return r.Progress
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperationStatus_IsTerminal(t *testing.T) {
+	tests := map[OperationStatus]bool{
+		OperationStatusCreated:    false,
+		OperationStatusInProgress: false,
+		OperationStatusFinished:   true,
+		OperationStatusFailed:     true,
+		OperationStatusCanceled:   true,
+		"cancelled":               true,
+		"":                        false,
+	}
+
+	for status, expected := range tests {
+		assert.Equal(t, expected, status.IsTerminal(), "status %q", status)
+	}
+}
+
+func TestAsyncOperation_Nil(t *testing.T) {
+	operations := []AsyncOperation{
+		(*BranchMerge)(nil),
+		(*BundleExport)(nil),
+		(*GlossaryExport)(nil),
+		(*GlossaryImport)(nil),
+		(*ReportStatus)(nil),
+		(*ReviewedBuild)(nil),
+		(*SourceStringsUpload)(nil),
+		(*TranslationMemoryExport)(nil),
+		(*TranslationMemoryImport)(nil),
+		(*PreTranslation)(nil),
+		(*BuildProjectDirectoryTranslation)(nil),
+		(*TranslationsProjectBuild)(nil),
+	}
+
+	for _, op := range operations {
+		assert.Equal(t, OperationStatus(""), op.OperationStatus())
+		assert.Equal(t, 0, op.OperationProgress())
+	}
+}
@@ -0,0 +1,455 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// JSONPointer returns the JSON Pointer (RFC 6901) of the reference tokens.
+// The "~" and "/" characters of the tokens are escaped as "~0" and "~1":
+//
+//	JSONPointer("languageMapping", "uk", "name") // "/languageMapping/uk/name"
+//	JSONPointer("fields", "a/b")                 // "/fields/a~1b"
+func JSONPointer(tokens ...string) string {
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteByte('/')
+		b.WriteString(strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1"))
+	}
+	return b.String()
+}
+
+// parseJSONPointer returns the unescaped reference tokens of the JSON Pointer.
+func parseJSONPointer(path string) ([]string, error) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("invalid path %q: must start with a slash", path)
+	}
+
+	tokens := strings.Split(path[1:], "/")
+	for i, token := range tokens {
+		for j := 0; j < len(token); j++ {
+			if token[j] == '~' && (j+1 == len(token) || (token[j+1] != '0' && token[j+1] != '1')) {
+				return nil, fmt.Errorf("invalid path %q: '~' must be escaped as '~0'", path)
+			}
+		}
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// patch collects the operations of a JSON Patch request
+// and checks them against the fields of the resource.
+type patch struct {
+	resource string
+	ops      []PatchOp
+	// fields maps the top-level fields which can be patched to
+	// whether their nested values can be patched too.
+	fields map[string]bool
+
+	reqs []*UpdateRequest
+	err  error
+}
+
+func newPatch(resource string, ops []PatchOp, fields map[string]bool) patch {
+	return patch{resource: resource, ops: ops, fields: fields}
+}
+
+// add appends the operation. The first invalid operation is kept
+// as the error returned by build.
+func (p *patch) add(op PatchOp, path string, value any) {
+	if p.err != nil {
+		return
+	}
+	if err := p.check(op, path); err != nil {
+		p.err = fmt.Errorf("%s patch: %w", p.resource, err)
+		return
+	}
+	p.reqs = append(p.reqs, &UpdateRequest{Op: op, Path: path, Value: value})
+}
+
+// check reports an error if the operation or the path is not allowed.
+func (p *patch) check(op PatchOp, path string) error {
+	if !slices.Contains(p.ops, op) {
+		return fmt.Errorf("op %q is not allowed", op)
+	}
+
+	tokens, err := parseJSONPointer(path)
+	if err != nil {
+		return err
+	}
+	nested, ok := p.fields[tokens[0]]
+	if !ok || (len(tokens) > 1 && !nested) {
+		return fmt.Errorf("path %q is not allowed", path)
+	}
+	if slices.Contains(tokens[1:], "") {
+		return fmt.Errorf("invalid path %q: empty reference token", path)
+	}
+	return nil
+}
+
+// build returns the operations or the first error.
+func (p *patch) build() ([]*UpdateRequest, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	if len(p.reqs) == 0 {
+		return nil, fmt.Errorf("%s patch: no operations", p.resource)
+	}
+	for _, req := range p.reqs {
+		if err := req.Validate(); err != nil {
+			return nil, fmt.Errorf("%s patch: %s: %w", p.resource, req.Path, err)
+		}
+	}
+	return slices.Clone(p.reqs), nil
+}
+
+// ProjectPatchBuilder builds the request of ProjectsService.Edit.
+type ProjectPatchBuilder struct {
+	patch
+}
+
+// ProjectPatch returns a builder of the request to edit a project:
+//
+//	req, err := model.ProjectPatch().
+//		SetName("Website").
+//		SetTargetLanguageIDs("uk", "de").
+//		SetLanguageMapping("uk", "locale", "ua").
+//		Build()
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.patch
+func ProjectPatch() *ProjectPatchBuilder {
+	return &ProjectPatchBuilder{newPatch("project", []PatchOp{OpAdd, OpReplace, OpRemove, OpTest}, map[string]bool{
+		"name": false, "cname": false, "identifier": false, "description": false, "visibility": false,
+		"languageAccessPolicy": false, "targetLanguageIds": true, "logo": false, "translateDuplicates": false,
+		"tagsDetection": false, "glossaryAccess": false, "isMtAllowed": false, "taskBasedAccessControl": false,
+		"hiddenStringsProofreadersAccess": false, "autoSubstitution": false, "exportTranslatedOnly": false,
+		"skipUntranslatedStrings": false, "skipUntranslatedFiles": false, "exportApprovedOnly": false,
+		"exportWithMinApprovalsCount": false, "exportStringsThatPassedWorkflow": false,
+		"autoTranslateDialects": false, "publicDownloads": false, "useGlobalTm": false, "tmContextType": false,
+		"showTmSuggestionsDialects": false, "isSuspended": false, "qaCheckIsActive": false,
+		"qaApprovalsCount": false, "qaCheckCategories": true, "qaChecksIgnorableCategories": true,
+		"customQaCheckIds": true, "languageMapping": true, "delayedWorkflowStart": false,
+		"notificationSettings": true, "defaultTmId": false, "defaultGlossaryId": false,
+		"assignedTms": true, "assignedGlossaries": true, "tmPenalties": true, "normalizePlaceholder": false,
+		"tmPreTranslate": true, "mtPreTranslate": true, "saveMetaInfoInSource": false, "inContext": false,
+		"inContextProcessHiddenStrings": false, "inContextPseudoLanguageId": false, "fields": true,
+	})}
+}
+
+// SetName sets the project name.
+func (b *ProjectPatchBuilder) SetName(name string) *ProjectPatchBuilder {
+	return b.Replace("/name", name)
+}
+
+// SetIdentifier sets the project identifier.
+func (b *ProjectPatchBuilder) SetIdentifier(identifier string) *ProjectPatchBuilder {
+	return b.Replace("/identifier", identifier)
+}
+
+// SetDescription sets the project description.
+func (b *ProjectPatchBuilder) SetDescription(description string) *ProjectPatchBuilder {
+	return b.Replace("/description", description)
+}
+
+// SetVisibility sets the project visibility. Enum: open, private.
+func (b *ProjectPatchBuilder) SetVisibility(visibility string) *ProjectPatchBuilder {
+	return b.Replace("/visibility", visibility)
+}
+
+// SetLanguageAccessPolicy sets the language access policy. Enum: open, moderate.
+func (b *ProjectPatchBuilder) SetLanguageAccessPolicy(policy string) *ProjectPatchBuilder {
+	return b.Replace("/languageAccessPolicy", policy)
+}
+
+// SetTargetLanguageIDs sets the target languages of the project.
+func (b *ProjectPatchBuilder) SetTargetLanguageIDs(languageIDs ...string) *ProjectPatchBuilder {
+	return b.Replace("/targetLanguageIds", languageIDs)
+}
+
+// SetLanguageMapping sets the value of the placeholder (e.g. "locale")
+// in the language mapping of the language.
+func (b *ProjectPatchBuilder) SetLanguageMapping(languageID, placeholder, value string) *ProjectPatchBuilder {
+	return b.Add(JSONPointer("languageMapping", languageID, placeholder), value)
+}
+
+// RemoveLanguageMapping removes the language mapping of the language.
+func (b *ProjectPatchBuilder) RemoveLanguageMapping(languageID string) *ProjectPatchBuilder {
+	return b.Remove(JSONPointer("languageMapping", languageID))
+}
+
+// SetQACheckCategory enables or disables the QA check category.
+func (b *ProjectPatchBuilder) SetQACheckCategory(category string, enabled bool) *ProjectPatchBuilder {
+	return b.Add(JSONPointer("qaCheckCategories", category), enabled)
+}
+
+// SetField sets the value of the custom field.
+func (b *ProjectPatchBuilder) SetField(key string, value any) *ProjectPatchBuilder {
+	return b.Add(JSONPointer("fields", key), value)
+}
+
+// Add adds the "add" operation of the path.
+func (b *ProjectPatchBuilder) Add(path string, value any) *ProjectPatchBuilder {
+	b.add(OpAdd, path, value)
+	return b
+}
+
+// Replace adds the "replace" operation of the path.
+func (b *ProjectPatchBuilder) Replace(path string, value any) *ProjectPatchBuilder {
+	b.add(OpReplace, path, value)
+	return b
+}
+
+// Remove adds the "remove" operation of the path.
+func (b *ProjectPatchBuilder) Remove(path string) *ProjectPatchBuilder {
+	b.add(OpRemove, path, nil)
+	return b
+}
+
+// Test adds the "test" operation of the path.
+func (b *ProjectPatchBuilder) Test(path string, value any) *ProjectPatchBuilder {
+	b.add(OpTest, path, value)
+	return b
+}
+
+// Build returns the request, or an error if any operation is not allowed
+// for a project or there are no operations.
+func (b *ProjectPatchBuilder) Build() ([]*UpdateRequest, error) {
+	return b.build()
+}
+
+// BranchPatchBuilder builds the request of BranchesService.Edit.
+type BranchPatchBuilder struct {
+	patch
+}
+
+// BranchPatch returns a builder of the request to edit a branch.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.branches.patch
+func BranchPatch() *BranchPatchBuilder {
+	return &BranchPatchBuilder{newPatch("branch", []PatchOp{OpReplace, OpTest}, map[string]bool{
+		"name": false, "title": false, "exportPattern": false, "priority": false,
+	})}
+}
+
+// SetName sets the branch name.
+func (b *BranchPatchBuilder) SetName(name string) *BranchPatchBuilder {
+	return b.Replace("/name", name)
+}
+
+// SetTitle sets the branch title.
+func (b *BranchPatchBuilder) SetTitle(title string) *BranchPatchBuilder {
+	return b.Replace("/title", title)
+}
+
+// SetExportPattern sets the export pattern of the branch.
+func (b *BranchPatchBuilder) SetExportPattern(pattern string) *BranchPatchBuilder {
+	return b.Replace("/exportPattern", pattern)
+}
+
+// SetPriority sets the branch priority. Enum: low, normal, high.
+func (b *BranchPatchBuilder) SetPriority(priority string) *BranchPatchBuilder {
+	return b.Replace("/priority", priority)
+}
+
+// Replace adds the "replace" operation of the path.
+func (b *BranchPatchBuilder) Replace(path string, value any) *BranchPatchBuilder {
+	b.add(OpReplace, path, value)
+	return b
+}
+
+// Test adds the "test" operation of the path.
+func (b *BranchPatchBuilder) Test(path string, value any) *BranchPatchBuilder {
+	b.add(OpTest, path, value)
+	return b
+}
+
+// Build returns the request, or an error if any operation is not allowed
+// for a branch or there are no operations.
+func (b *BranchPatchBuilder) Build() ([]*UpdateRequest, error) {
+	return b.build()
+}
+
+// LabelPatchBuilder builds the request of LabelsService.Edit.
+type LabelPatchBuilder struct {
+	patch
+}
+
+// LabelPatch returns a builder of the request to edit a label.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.labels.patch
+func LabelPatch() *LabelPatchBuilder {
+	return &LabelPatchBuilder{newPatch("label", []PatchOp{OpReplace, OpTest}, map[string]bool{
+		"title": false,
+	})}
+}
+
+// SetTitle sets the label title.
+func (b *LabelPatchBuilder) SetTitle(title string) *LabelPatchBuilder {
+	return b.Replace("/title", title)
+}
+
+// Replace adds the "replace" operation of the path.
+func (b *LabelPatchBuilder) Replace(path string, value any) *LabelPatchBuilder {
+	b.add(OpReplace, path, value)
+	return b
+}
+
+// Test adds the "test" operation of the path.
+func (b *LabelPatchBuilder) Test(path string, value any) *LabelPatchBuilder {
+	b.add(OpTest, path, value)
+	return b
+}
+
+// Build returns the request, or an error if any operation is not allowed
+// for a label or there are no operations.
+func (b *LabelPatchBuilder) Build() ([]*UpdateRequest, error) {
+	return b.build()
+}
+
+// GlossaryPatchBuilder builds the request of GlossariesService.EditGlossary.
+type GlossaryPatchBuilder struct {
+	patch
+}
+
+// GlossaryPatch returns a builder of the request to edit a glossary.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.glossaries.patch
+func GlossaryPatch() *GlossaryPatchBuilder {
+	return &GlossaryPatchBuilder{newPatch("glossary", []PatchOp{OpReplace, OpTest}, map[string]bool{
+		"name": false, "languageId": false,
+	})}
+}
+
+// SetName sets the glossary name.
+func (b *GlossaryPatchBuilder) SetName(name string) *GlossaryPatchBuilder {
+	return b.Replace("/name", name)
+}
+
+// SetLanguageID sets the language of the glossary terms.
+func (b *GlossaryPatchBuilder) SetLanguageID(languageID string) *GlossaryPatchBuilder {
+	return b.Replace("/languageId", languageID)
+}
+
+// Replace adds the "replace" operation of the path.
+func (b *GlossaryPatchBuilder) Replace(path string, value any) *GlossaryPatchBuilder {
+	b.add(OpReplace, path, value)
+	return b
+}
+
+// Test adds the "test" operation of the path.
+func (b *GlossaryPatchBuilder) Test(path string, value any) *GlossaryPatchBuilder {
+	b.add(OpTest, path, value)
+	return b
+}
+
+// Build returns the request, or an error if any operation is not allowed
+// for a glossary or there are no operations.
+func (b *GlossaryPatchBuilder) Build() ([]*UpdateRequest, error) {
+	return b.build()
+}
+
+// TaskPatchBuilder builds the request of TasksService.Edit.
+type TaskPatchBuilder struct {
+	patch
+}
+
+// TaskPatch returns a builder of the request to edit a task.
+// The fields of vendor and pending tasks are a subset of the task fields.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.tasks.patch
+func TaskPatch() *TaskPatchBuilder {
+	return &TaskPatchBuilder{newPatch("task", []PatchOp{OpReplace, OpTest}, map[string]bool{
+		"status": false, "title": false, "description": false, "deadline": false, "startedAt": false,
+		"resolvedAt": false, "splitFiles": false, "splitContent": false, "fileIds": false, "stringIds": false,
+		"assignees": false, "dateFrom": false, "dateTo": false, "labelIds": false, "excludeLabelIds": false,
+	})}
+}
+
+// SetStatus sets the task status. Enum: todo, in_progress, done, closed.
+func (b *TaskPatchBuilder) SetStatus(status string) *TaskPatchBuilder {
+	return b.Replace("/status", status)
+}
+
+// SetTitle sets the task title.
+func (b *TaskPatchBuilder) SetTitle(title string) *TaskPatchBuilder {
+	return b.Replace("/title", title)
+}
+
+// SetDescription sets the task description.
+func (b *TaskPatchBuilder) SetDescription(description string) *TaskPatchBuilder {
+	return b.Replace("/description", description)
+}
+
+// SetDeadline sets the task deadline.
+func (b *TaskPatchBuilder) SetDeadline(deadline Time) *TaskPatchBuilder {
+	return b.Replace("/deadline", deadline)
+}
+
+// SetStartedAt sets the start date of the task.
+func (b *TaskPatchBuilder) SetStartedAt(startedAt Time) *TaskPatchBuilder {
+	return b.Replace("/startedAt", startedAt)
+}
+
+// SetResolvedAt sets the resolution date of the task.
+func (b *TaskPatchBuilder) SetResolvedAt(resolvedAt Time) *TaskPatchBuilder {
+	return b.Replace("/resolvedAt", resolvedAt)
+}
+
+// SetDateRange sets the interval when the strings of the task were modified.
+func (b *TaskPatchBuilder) SetDateRange(from, to Time) *TaskPatchBuilder {
+	return b.Replace("/dateFrom", from).Replace("/dateTo", to)
+}
+
+// SetFileIDs sets the files of the task.
+func (b *TaskPatchBuilder) SetFileIDs(fileIDs ...int) *TaskPatchBuilder {
+	return b.Replace("/fileIds", fileIDs)
+}
+
+// SetStringIDs sets the strings of the task.
+func (b *TaskPatchBuilder) SetStringIDs(stringIDs ...int) *TaskPatchBuilder {
+	return b.Replace("/stringIds", stringIDs)
+}
+
+// SetLabelIDs sets the labels of the strings of the task.
+func (b *TaskPatchBuilder) SetLabelIDs(labelIDs ...int) *TaskPatchBuilder {
+	return b.Replace("/labelIds", labelIDs)
+}
+
+// SetExcludeLabelIDs sets the labels of the strings excluded from the task.
+func (b *TaskPatchBuilder) SetExcludeLabelIDs(labelIDs ...int) *TaskPatchBuilder {
+	return b.Replace("/excludeLabelIds", labelIDs)
+}
+
+// SetAssignees sets the task assignees.
+func (b *TaskPatchBuilder) SetAssignees(assignees ...CrowdinTaskAssignee) *TaskPatchBuilder {
+	return b.Replace("/assignees", assignees)
+}
+
+// SetSplitFiles sets whether the files are split between the assignees.
+func (b *TaskPatchBuilder) SetSplitFiles(split bool) *TaskPatchBuilder {
+	return b.Replace("/splitFiles", split)
+}
+
+// SetSplitContent sets whether the content is split between the assignees.
+func (b *TaskPatchBuilder) SetSplitContent(split bool) *TaskPatchBuilder {
+	return b.Replace("/splitContent", split)
+}
+
+// Replace adds the "replace" operation of the path.
+func (b *TaskPatchBuilder) Replace(path string, value any) *TaskPatchBuilder {
+	b.add(OpReplace, path, value)
+	return b
+}
+
+// Test adds the "test" operation of the path.
+func (b *TaskPatchBuilder) Test(path string, value any) *TaskPatchBuilder {
+	b.add(OpTest, path, value)
+	return b
+}
+
+// Build returns the request, or an error if any operation is not allowed
+// for a task or there are no operations.
+func (b *TaskPatchBuilder) Build() ([]*UpdateRequest, error) {
+	return b.build()
+}
@@ -0,0 +1,137 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONPointer(t *testing.T) {
+	tests := []struct {
+		tokens []string
+		path   string
+	}{
+		{tokens: []string{"name"}, path: "/name"},
+		{tokens: []string{"languageMapping", "uk", "locale"}, path: "/languageMapping/uk/locale"},
+		{tokens: []string{"fields", "a/b"}, path: "/fields/a~1b"},
+		{tokens: []string{"fields", "m~n"}, path: "/fields/m~0n"},
+		{tokens: []string{"fields", "~1"}, path: "/fields/~01"},
+		{tokens: []string{"fields", ""}, path: "/fields/"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.path, JSONPointer(tt.tokens...))
+
+		tokens, err := parseJSONPointer(tt.path)
+		require.NoError(t, err)
+		assert.Equal(t, tt.tokens, tokens)
+	}
+
+	for _, path := range []string{"name", "/fields/a~2", "/fields/a~"} {
+		_, err := parseJSONPointer(path)
+		assert.Error(t, err, path)
+	}
+}
+
+func TestProjectPatch(t *testing.T) {
+	req, err := ProjectPatch().
+		SetName("Website").
+		SetTargetLanguageIDs("uk", "de").
+		SetLanguageMapping("uk", "locale", "ua").
+		SetField("team/owner", "web").
+		RemoveLanguageMapping("de").
+		Test("/visibility", "private").
+		Build()
+	require.NoError(t, err)
+
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"op": "replace", "path": "/name", "value": "Website"},
+		{"op": "replace", "path": "/targetLanguageIds", "value": ["uk", "de"]},
+		{"op": "add", "path": "/languageMapping/uk/locale", "value": "ua"},
+		{"op": "add", "path": "/fields/team~1owner", "value": "web"},
+		{"op": "remove", "path": "/languageMapping/de"},
+		{"op": "test", "path": "/visibility", "value": "private"}
+	]`, string(data))
+}
+
+func TestTaskPatch(t *testing.T) {
+	deadline := Time{Time: time.Date(2023, 9, 27, 7, 0, 14, 0, time.UTC)}
+
+	req, err := TaskPatch().
+		SetStatus("in_progress").
+		SetDeadline(deadline).
+		SetFileIDs(1, 2).
+		SetAssignees(CrowdinTaskAssignee{ID: 1}).
+		Build()
+	require.NoError(t, err)
+
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"op": "replace", "path": "/status", "value": "in_progress"},
+		{"op": "replace", "path": "/deadline", "value": "2023-09-27T07:00:14Z"},
+		{"op": "replace", "path": "/fileIds", "value": [1, 2]},
+		{"op": "replace", "path": "/assignees", "value": [{"id": 1}]}
+	]`, string(data))
+}
+
+func TestPatch_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		build       func() ([]*UpdateRequest, error)
+		expectedErr string
+	}{
+		{
+			name:        "no operations",
+			build:       LabelPatch().Build,
+			expectedErr: "label patch: no operations",
+		},
+		{
+			name:        "unknown field",
+			build:       ProjectPatch().SetName("App").Replace("/nmae", "App").Build,
+			expectedErr: `project patch: path "/nmae" is not allowed`,
+		},
+		{
+			name:        "nested value of a scalar field",
+			build:       BranchPatch().Replace("/title/en", "Main").Build,
+			expectedErr: `branch patch: path "/title/en" is not allowed`,
+		},
+		{
+			name:        "relative path",
+			build:       LabelPatch().Replace("title", "UI").Build,
+			expectedErr: `label patch: invalid path "title": must start with a slash`,
+		},
+		{
+			name:        "empty language",
+			build:       ProjectPatch().SetLanguageMapping("", "locale", "ua").Build,
+			expectedErr: `project patch: invalid path "/languageMapping//locale": empty reference token`,
+		},
+		{
+			name:        "missing value",
+			build:       TaskPatch().SetTitle("Translate").Replace("/description", nil).Build,
+			expectedErr: "task patch: /description: value is required",
+		},
+		{
+			name:        "field of another resource",
+			build:       BranchPatch().SetName("main").Replace("/identifier", "main").Build,
+			expectedErr: `branch patch: path "/identifier" is not allowed`,
+		},
+		{
+			name:        "read-only field",
+			build:       GlossaryPatch().SetLanguageID("de").Replace("/terms", 30).Build,
+			expectedErr: `glossary patch: path "/terms" is not allowed`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.build()
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
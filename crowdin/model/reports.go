@@ -67,7 +67,7 @@ type ReportArchive struct {
 	Name      string `json:"name"`
 	WebURL    string `json:"webUrl"`
 	Scheme    any    `json:"scheme"`
-	CreatedAt string `json:"createdAt"`
+	CreatedAt Time   `json:"createdAt"`
 }
 
 // ReportArchiveResponse defines the structure of a response
@@ -122,10 +122,10 @@ type (
 		Status     string                 `json:"status"`
 		Progress   int                    `json:"progress"`
 		Attributes ReportStatusAttributes `json:"attributes"`
-		CreatedAt  string                 `json:"createdAt"`
-		UpdatedAt  string                 `json:"updatedAt"`
-		StartedAt  string                 `json:"startedAt"`
-		FinishedAt string                 `json:"finishedAt"`
+		CreatedAt  Time                   `json:"createdAt"`
+		UpdatedAt  Time                   `json:"updatedAt"`
+		StartedAt  Time                   `json:"startedAt"`
+		FinishedAt Time                   `json:"finishedAt"`
 	}
 
 	// ReportStatusAttributes represents the attributes of
@@ -249,9 +249,9 @@ type (
 		// Enum: strings_with_label, strings_without_label. Default: strings_with_label.
 		LabelIncludeType string `json:"labelIncludeType,omitempty"`
 		// Report date from in UTC, ISO 8601.
-		DateFrom string `json:"dateFrom,omitempty"`
+		DateFrom *Time `json:"dateFrom,omitempty"`
 		// Report date to in UTC, ISO 8601.
-		DateTo string `json:"dateTo,omitempty"`
+		DateTo *Time `json:"dateTo,omitempty"`
 
 		// Task Identifier.
 		// Used to generate report by task.
@@ -300,9 +300,9 @@ type (
 		// Enum: strings_with_label, strings_without_label. Default: strings_with_label.
 		LabelIncludeType string `json:"labelIncludeType,omitempty"`
 		// Report date from in UTC, ISO 8601.
-		DateFrom string `json:"dateFrom,omitempty"`
+		DateFrom *Time `json:"dateFrom,omitempty"`
 		// Report date to in UTC, ISO 8601.
-		DateTo string `json:"dateTo,omitempty"`
+		DateTo *Time `json:"dateTo,omitempty"`
 
 		// Task Identifier.
 		// Used to generate report by task.
@@ -320,9 +320,9 @@ type (
 		// Enum: xlsx, csv, json. Default: xlsx.
 		Format ReportFormat `json:"format,omitempty"`
 		// Report date from in UTC, ISO 8601.
-		DateFrom string `json:"dateFrom,omitempty"`
+		DateFrom *Time `json:"dateFrom,omitempty"`
 		// Report date to in UTC, ISO 8601.
-		DateTo string `json:"dateTo,omitempty"`
+		DateTo *Time `json:"dateTo,omitempty"`
 	}
 
 	// ContributionRawDataSchema defines the schema for the contribution
@@ -357,9 +357,9 @@ type (
 		// List of branch identifiers.
 		BranchIDs []int `json:"branchIds,omitempty"`
 		// Report date from in UTC, ISO 8601.
-		DateFrom string `json:"dateFrom,omitempty"`
+		DateFrom *Time `json:"dateFrom,omitempty"`
 		// Report date to in UTC, ISO 8601.
-		DateTo string `json:"dateTo,omitempty"`
+		DateTo *Time `json:"dateTo,omitempty"`
 	}
 )
 
@@ -459,9 +459,9 @@ type (
 		// Enum: user, language. Default: user.
 		GroupBy string `json:"groupBy,omitempty"`
 		// Report date from in UTC, ISO 8601.
-		DateFrom string `json:"dateFrom,omitempty"`
+		DateFrom *Time `json:"dateFrom,omitempty"`
 		// Report date to in UTC, ISO 8601.
-		DateTo string `json:"dateTo,omitempty"`
+		DateTo *Time `json:"dateTo,omitempty"`
 		// User Identifier for which the report should be generated.
 		UserIDs []int `json:"userIds,omitempty"`
 	}
@@ -479,9 +479,9 @@ type (
 		// Enum: xlsx, csv, json. Default: xlsx.
 		Format ReportFormat `json:"format,omitempty"`
 		// Report date from in UTC, ISO 8601.
-		DateFrom string `json:"dateFrom,omitempty"`
+		DateFrom *Time `json:"dateFrom,omitempty"`
 		// Report date to in UTC, ISO 8601.
-		DateTo string `json:"dateTo,omitempty"`
+		DateTo *Time `json:"dateTo,omitempty"`
 	}
 )
 
@@ -528,8 +528,8 @@ type ReportSettingsTemplate struct {
 	Currency  string                       `json:"currency"`
 	Unit      string                       `json:"unit"`
 	Config    ReportSettingsTemplateConfig `json:"config"`
-	CreatedAt string                       `json:"createdAt"`
-	UpdatedAt string                       `json:"updatedAt"`
+	CreatedAt Time                         `json:"createdAt"`
+	UpdatedAt Time                         `json:"updatedAt"`
 	IsPublic  bool                         `json:"isPublic"`
 	IsGlobal  *bool                        `json:"isGlobal,omitempty"`
 
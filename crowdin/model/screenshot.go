@@ -21,8 +21,8 @@ type Screenshot struct {
 	TagsCount int    `json:"tagsCount"`
 	Tags      []*Tag `json:"tags"`
 	LabelIDs  []int  `json:"labelIds"`
-	CreatedAt string `json:"createdAt"`
-	UpdatedAt string `json:"updatedAt"`
+	CreatedAt Time   `json:"createdAt"`
+	UpdatedAt Time   `json:"updatedAt"`
 }
 
 // ScreenshotResponse defines the structure of a response
@@ -43,7 +43,7 @@ type Tag struct {
 	ScreenshotID int          `json:"screenshotId"`
 	StringID     int          `json:"stringId"`
 	Position     *TagPosition `json:"position"`
-	CreatedAt    string       `json:"createdAt"`
+	CreatedAt    Time         `json:"createdAt"`
 }
 
 // TagPosition represents the position of a tag on a screenshot.
@@ -17,8 +17,8 @@ type Directory struct {
 	ExportPattern string `json:"exportPattern"`
 	Path          string `json:"path"`
 	Priority      string `json:"priority"`
-	CreatedAt     string `json:"createdAt"`
-	UpdatedAt     string `json:"updatedAt"`
+	CreatedAt     Time   `json:"createdAt"`
+	UpdatedAt     Time   `json:"updatedAt"`
 }
 
 // DirectoryGetResponse describes a response with a single directory.
@@ -126,17 +126,17 @@ func (r *DirectoryAddRequest) Validate() error {
 
 // File represents a project file.
 type File struct {
-	ID          int     `json:"id"`
-	ProjectID   int     `json:"projectId"`
-	BranchID    *int    `json:"branchId,omitempty"`
-	DirectoryID *int    `json:"directoryId,omitempty"`
-	Name        string  `json:"name"`
-	Title       *string `json:"title,omitempty"`
-	Context     *string `json:"context,omitempty"`
-	Type        string  `json:"type"`
-	Path        string  `json:"path"`
-	Status      string  `json:"status"`
-	Fields      []any   `json:"fields,omitempty"`
+	ID          int            `json:"id"`
+	ProjectID   int            `json:"projectId"`
+	BranchID    *int           `json:"branchId,omitempty"`
+	DirectoryID *int           `json:"directoryId,omitempty"`
+	Name        string         `json:"name"`
+	Title       *string        `json:"title,omitempty"`
+	Context     *string        `json:"context,omitempty"`
+	Type        string         `json:"type"`
+	Path        string         `json:"path"`
+	Status      string         `json:"status"`
+	Fields      map[string]any `json:"fields,omitempty"`
 
 	RevisionID             int            `json:"revisionId"`
 	Priority               string         `json:"priority"`
@@ -144,8 +144,8 @@ type File struct {
 	ExportOptions          map[string]any `json:"exportOptions,omitempty"`
 	ExcludeTargetLanguages []string       `json:"excludedTargetLanguages,omitempty"`
 	ParserVersion          *int           `json:"parserVersion,omitempty"`
-	CreatedAt              string         `json:"createdAt,omitempty"`
-	UpdatedAt              string         `json:"updatedAt,omitempty"`
+	CreatedAt              Time           `json:"createdAt"`
+	UpdatedAt              Time           `json:"updatedAt"`
 }
 
 // FileGetResponse describes a response with a single file.
@@ -511,7 +511,7 @@ type (
 			Deleted RevisionInfo `json:"deleted"`
 			Updated RevisionInfo `json:"updated"`
 		} `json:"info"`
-		Date string `json:"date"`
+		Date Time `json:"date"`
 	}
 
 	// RevisionInfo contains the number of strings and words
@@ -9,25 +9,25 @@ import (
 
 // SourceString represents the text units for translation.
 type SourceString struct {
-	ID             int     `json:"id"`
-	ProjectID      int     `json:"projectId"`
-	BranchID       *int    `json:"branchId,omitempty"`
-	Identifier     string  `json:"identifier"`
-	Text           string  `json:"text"`
-	Type           string  `json:"type"`
-	Context        string  `json:"context"`
-	MaxLength      int     `json:"maxLength"`
-	IsHidden       bool    `json:"isHidden"`
-	IsDuplicate    bool    `json:"isDuplicate"`
-	MasterStringID *int    `json:"masterStringId,omitempty"`
-	LabelIDs       []int   `json:"labelIds"`
-	WebURL         string  `json:"webUrl"`
-	CreatedAt      *string `json:"createdAt,omitempty"`
-	UpdatedAt      *string `json:"updatedAt,omitempty"`
-	Fields         []any   `json:"fields,omitempty"`
-	FileID         *int    `json:"fileId,omitempty"`
-	DirectoryID    *int    `json:"directoryId,omitempty"`
-	Revision       *int    `json:"revision,omitempty"`
+	ID             int            `json:"id"`
+	ProjectID      int            `json:"projectId"`
+	BranchID       *int           `json:"branchId,omitempty"`
+	Identifier     string         `json:"identifier"`
+	Text           string         `json:"text"`
+	Type           string         `json:"type"`
+	Context        string         `json:"context"`
+	MaxLength      int            `json:"maxLength"`
+	IsHidden       bool           `json:"isHidden"`
+	IsDuplicate    bool           `json:"isDuplicate"`
+	MasterStringID *int           `json:"masterStringId,omitempty"`
+	LabelIDs       []int          `json:"labelIds"`
+	WebURL         string         `json:"webUrl"`
+	CreatedAt      *Time          `json:"createdAt,omitempty"`
+	UpdatedAt      *Time          `json:"updatedAt,omitempty"`
+	Fields         map[string]any `json:"fields,omitempty"`
+	FileID         *int           `json:"fileId,omitempty"`
+	DirectoryID    *int           `json:"directoryId,omitempty"`
+	Revision       *int           `json:"revision,omitempty"`
 }
 
 // SourceStringsGetResponse describes the response when getting
@@ -206,10 +206,10 @@ type SourceStringsUpload struct {
 		UpdateStrings bool `json:"updateStrings"`
 		CleanupMode   bool `json:"cleanupMode"`
 	} `json:"attributes"`
-	CreatedAt  string `json:"createdAt"`
-	UpdatedAt  string `json:"updatedAt"`
-	StartedAt  string `json:"startedAt"`
-	FinishedAt string `json:"finishedAt"`
+	CreatedAt  Time `json:"createdAt"`
+	UpdatedAt  Time `json:"updatedAt"`
+	StartedAt  Time `json:"startedAt"`
+	FinishedAt Time `json:"finishedAt"`
 }
 
 // SourceStringsUploadResponse defines the response when
@@ -22,8 +22,8 @@ type StringComment struct {
 	IssueStatus string     `json:"issueStatus"`
 	ResolverID  int        `json:"resolverId"`
 	Resolver    *ShortUser `json:"resolver"`
-	ResolvedAt  string     `json:"resolvedAt"`
-	CreatedAt   string     `json:"createdAt"`
+	ResolvedAt  Time       `json:"resolvedAt"`
+	CreatedAt   Time       `json:"createdAt"`
 
 	IsShared             *bool         `json:"isShared,omitempty"`
 	SenderOrganization   *Organization `json:"senderOrganization,omitempty"`
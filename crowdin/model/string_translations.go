@@ -14,7 +14,7 @@ type Approval struct {
 	TranslationID int        `json:"translationId"`
 	StringID      int        `json:"stringId"`
 	LanguageID    string     `json:"languageId"`
-	CreatedAt     string     `json:"createdAt"`
+	CreatedAt     Time       `json:"createdAt"`
 }
 
 // ApprovalsGetResponse defines the structure of the response when
@@ -155,7 +155,7 @@ type LanguageTranslation struct {
 	TranslationID *int       `json:"translationId,omitempty"`
 	Text          *string    `json:"text,omitempty"`
 	User          *ShortUser `json:"user,omitempty"`
-	CreatedAt     *string    `json:"createdAt,omitempty"`
+	CreatedAt     *Time      `json:"createdAt,omitempty"`
 
 	Plurals []*LanguageTranslationPlural `json:"plurals,omitempty"`
 }
@@ -167,7 +167,7 @@ type LanguageTranslationPlural struct {
 	Text          string     `json:"text"`
 	PluralForm    string     `json:"pluralForm"`
 	User          *ShortUser `json:"user"`
-	CreatedAt     string     `json:"createdAt"`
+	CreatedAt     Time       `json:"createdAt"`
 }
 
 // LanguageTranslationsGetResponse defines the structure of the response when
@@ -258,7 +258,7 @@ type Translation struct {
 	Rating             int        `json:"rating"`
 	Provider           *string    `json:"provider,omitempty"`
 	IsPreTranslated    bool       `json:"isPreTranslated"`
-	CreatedAt          string     `json:"createdAt"`
+	CreatedAt          Time       `json:"createdAt"`
 }
 
 // TranslationGetResponse defines the structure of the response when
@@ -382,7 +382,7 @@ type Vote struct {
 	ID            int        `json:"id"`
 	User          *ShortUser `json:"user"`
 	TranslationID int        `json:"translationId"`
-	VotedAt       string     `json:"votedAt"`
+	VotedAt       Time       `json:"votedAt"`
 	Mark          string     `json:"mark"`
 }
 
@@ -35,14 +35,14 @@ type (
 		WebURL           string              `json:"webUrl"`
 		WordsCount       int                 `json:"wordsCount"`
 		CommentsCount    int                 `json:"commentsCount"`
-		Deadline         string              `json:"deadline"`
-		StartedAt        string              `json:"startedAt"`
-		ResolvedAt       string              `json:"resolvedAt"`
+		Deadline         Time                `json:"deadline"`
+		StartedAt        Time                `json:"startedAt"`
+		ResolvedAt       Time                `json:"resolvedAt"`
 		TimeRange        string              `json:"timeRange"`
 		WorkflowStepID   int                 `json:"workflowStepId"`
 		BuyURL           string              `json:"buyUrl"`
-		CreatedAt        string              `json:"createdAt"`
-		UpdatedAt        string              `json:"updatedAt"`
+		CreatedAt        Time                `json:"createdAt"`
+		UpdatedAt        Time                `json:"updatedAt"`
 		SourceLanguage   *Language           `json:"sourceLanguage"`
 		TargetLanguages  []*Language         `json:"targetLanguages"`
 		LabelIDs         []int               `json:"labelIds"`
@@ -53,7 +53,7 @@ type (
 		Vendor           string              `json:"vendor,omitempty"`
 		BranchIDs        []int               `json:"branchIds,omitempty"`
 		IsArchived       *bool               `json:"isArchived,omitempty"`
-		Fields           []any               `json:"fields,omitempty"`
+		Fields           map[string]any      `json:"fields,omitempty"`
 	}
 
 	// TaskAssignee represents an assignee of a task.
@@ -231,13 +231,13 @@ type (
 		// Task assignees.
 		Assignees []CrowdinTaskAssignee `json:"assignees,omitempty"`
 		// Task deadline date. Format: UTC, ISO 8601.
-		Deadline string `json:"deadline,omitempty"`
+		Deadline *Time `json:"deadline,omitempty"`
 		// Task started date. Format: UTC, ISO 8601.
-		StartedAt string `json:"startedAt,omitempty"`
+		StartedAt *Time `json:"startedAt,omitempty"`
 		// Start date for interval when strings were modified. Format: UTC, ISO 8601.
-		DateFrom string `json:"dateFrom,omitempty"`
+		DateFrom *Time `json:"dateFrom,omitempty"`
 		// End date for interval when strings were modified. Format: UTC, ISO 8601.
-		DateTo string `json:"dateTo,omitempty"`
+		DateTo *Time `json:"dateTo,omitempty"`
 	}
 
 	LanguageServiceTaskCreateForm struct {
@@ -271,9 +271,9 @@ type (
 		// `includeUntranslatedStringsOnly=true` in the same request.
 		IncludePreTranslatedStringsOnly *bool `json:"includePreTranslatedStringsOnly,omitempty"`
 		// Start date for interval when strings were modified. Format: UTC, ISO 8601.
-		DateFrom string `json:"dateFrom,omitempty"`
+		DateFrom *Time `json:"dateFrom,omitempty"`
 		// End date for interval when strings were modified. Format: UTC, ISO 8601.
-		DateTo string `json:"dateTo,omitempty"`
+		DateTo *Time `json:"dateTo,omitempty"`
 	}
 
 	VendorOhtTaskCreateForm struct {
@@ -316,9 +316,9 @@ type (
 		// `includeUntranslatedStringsOnly=true` in the same request.
 		IncludePreTranslatedStringsOnly *bool `json:"includePreTranslatedStringsOnly,omitempty"`
 		// Start date for interval when strings were modified. Format: UTC, ISO 8601.
-		DateFrom string `json:"dateFrom,omitempty"`
+		DateFrom *Time `json:"dateFrom,omitempty"`
 		// End date for interval when strings were modified. Format: UTC, ISO 8601.
-		DateTo string `json:"dateTo,omitempty"`
+		DateTo *Time `json:"dateTo,omitempty"`
 	}
 
 	VendorGengoTaskCreateForm struct {
@@ -364,9 +364,9 @@ type (
 		// Enables Edit stage for all jobs. Default: false.
 		EditService *bool `json:"editService,omitempty"`
 		// Start date for interval when strings were modified. Format: UTC, ISO 8601.
-		DateFrom string `json:"dateFrom,omitempty"`
+		DateFrom *Time `json:"dateFrom,omitempty"`
 		// End date for interval when strings were modified. Format: UTC, ISO 8601.
-		DateTo string `json:"dateTo,omitempty"`
+		DateTo *Time `json:"dateTo,omitempty"`
 	}
 
 	VendorManualTaskCreateForm struct {
@@ -406,13 +406,13 @@ type (
 		// Task assignees.
 		Assignees []CrowdinTaskAssignee `json:"assignees,omitempty"`
 		// Task deadline date. Format: UTC, ISO 8601.
-		Deadline string `json:"deadline,omitempty"`
+		Deadline *Time `json:"deadline,omitempty"`
 		// Task started date. Format: UTC, ISO 8601.
-		StartedAt string `json:"startedAt,omitempty"`
+		StartedAt *Time `json:"startedAt,omitempty"`
 		// Start date for interval when strings were modified. Format: UTC, ISO 8601.
-		DateFrom string `json:"dateFrom,omitempty"`
+		DateFrom *Time `json:"dateFrom,omitempty"`
 		// End date for interval when strings were modified. Format: UTC, ISO 8601.
-		DateTo string `json:"dateTo,omitempty"`
+		DateTo *Time `json:"dateTo,omitempty"`
 	}
 
 	PendingTaskCreateForm struct {
@@ -427,7 +427,7 @@ type (
 		// Task assignees.
 		Assignees []CrowdinTaskAssignee `json:"assignees,omitempty"`
 		// Task deadline date. Format: UTC, ISO 8601.
-		Deadline string `json:"deadline,omitempty"`
+		Deadline *Time `json:"deadline,omitempty"`
 	}
 
 	LanguageServicePendingTaskCreateForm struct {
@@ -442,7 +442,7 @@ type (
 		// Task description.
 		Description string `json:"description,omitempty"`
 		// Task deadline date. Format: UTC, ISO 8601.
-		Deadline string `json:"deadline,omitempty"`
+		Deadline *Time `json:"deadline,omitempty"`
 	}
 
 	VendorManualPendingTaskCreateForm struct {
@@ -461,7 +461,7 @@ type (
 		// Task assignees.
 		Assignees []CrowdinTaskAssignee `json:"assignees,omitempty"`
 		// Task deadline date. Format: UTC, ISO 8601.
-		Deadline string `json:"deadline,omitempty"`
+		Deadline *Time `json:"deadline,omitempty"`
 	}
 )
 
@@ -504,15 +504,15 @@ type (
 		// `type=0` or `type=2` in same request.
 		IncludePreTranslatedStringsOnly *bool `json:"includePreTranslatedStringsOnly,omitempty"`
 		// Task deadline date. Format: UTC, ISO 8601.
-		Deadline string `json:"deadline,omitempty"`
+		Deadline *Time `json:"deadline,omitempty"`
 		// Task started date. Format: UTC, ISO 8601.
-		StartedAt string `json:"startedAt,omitempty"`
+		StartedAt *Time `json:"startedAt,omitempty"`
 		// Start date for interval when strings were modified. Format: UTC, ISO 8601.
-		DateFrom string `json:"dateFrom,omitempty"`
+		DateFrom *Time `json:"dateFrom,omitempty"`
 		// End date for interval when strings were modified. Format: UTC, ISO 8601.
-		DateTo string `json:"dateTo,omitempty"`
+		DateTo *Time `json:"dateTo,omitempty"`
 		// Fields for task.
-		Fields []any `json:"fields,omitempty"`
+		Fields map[string]any `json:"fields,omitempty"`
 	}
 
 	EnterpriseVendorTaskCreateForm struct {
@@ -541,13 +541,13 @@ type (
 		// `type=0` or `type=2` in same request.
 		IncludePreTranslatedStringsOnly *bool `json:"includePreTranslatedStringsOnly,omitempty"`
 		// Task deadline date. Format: UTC, ISO 8601.
-		Deadline string `json:"deadline,omitempty"`
+		Deadline *Time `json:"deadline,omitempty"`
 		// Task started date. Format: UTC, ISO 8601.
-		StartedAt string `json:"startedAt,omitempty"`
+		StartedAt *Time `json:"startedAt,omitempty"`
 		// End date for interval when strings were modified. Format: UTC, ISO 8601.
-		DateTo string `json:"dateTo,omitempty"`
+		DateTo *Time `json:"dateTo,omitempty"`
 		// Fields for task.
-		Fields []any `json:"fields,omitempty"`
+		Fields map[string]any `json:"fields,omitempty"`
 	}
 
 	EnterprisePendingTaskCreateForm struct {
@@ -564,7 +564,7 @@ type (
 		// Task assigned teams.
 		AssignedTeams []TaskAssignedTeam `json:"assignedTeams,omitempty"`
 		// Task deadline date. Format: UTC, ISO 8601.
-		Deadline string `json:"deadline,omitempty"`
+		Deadline *Time `json:"deadline,omitempty"`
 	}
 )
 
@@ -924,8 +924,8 @@ type (
 		ID        int                        `json:"id"`
 		Name      string                     `json:"name"`
 		Config    TaskSettingsTemplateConfig `json:"config"`
-		CreatedAt string                     `json:"createdAt"`
-		UpdatedAt string                     `json:"updatedAt"`
+		CreatedAt Time                       `json:"createdAt"`
+		UpdatedAt Time                       `json:"updatedAt"`
 	}
 
 	// TaskSettingsTemplateConfig represents the configuration of a task
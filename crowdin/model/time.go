@@ -0,0 +1,90 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	// timeLayoutOffset is the layout of the API timestamps
+	// with a numeric zone offset, e.g. "2023-09-20T11:34:40+00:00".
+	timeLayoutOffset = "2006-01-02T15:04:05.999999999-07:00"
+	// timeLayoutUTC is the layout of the API timestamps
+	// in UTC, e.g. "2023-09-20T11:34:40Z".
+	timeLayoutUTC = "2006-01-02T15:04:05.999999999Z07:00"
+	// dateLayout is the layout of the API dates, e.g. "2023-09-20".
+	dateLayout = time.DateOnly
+)
+
+// Time is a timestamp of the API. It is decoded from the ISO 8601 format
+// of the API, e.g. "2023-09-20T11:34:40+00:00" or "2023-09-20T11:34:40Z",
+// and encoded back in the same format. A null or empty value is decoded
+// as the zero time, which is encoded as null.
+//
+// A time in the time.UTC location is encoded with the "Z" suffix, any other
+// time with its numeric zone offset:
+//
+//	model.Time{Time: time.Date(2023, 9, 20, 11, 34, 40, 0, time.UTC)} // "2023-09-20T11:34:40Z"
+//
+// Optional request fields are pointers, so they can be omitted:
+//
+//	req := &model.TaskAddRequest{Deadline: crowdin.ToPtr(model.Time{Time: deadline})}
+type Time struct {
+	time.Time
+}
+
+// ParseTime parses a timestamp in the API format.
+// Dates without a time (e.g. "2023-09-20") are parsed as midnight UTC.
+func ParseTime(s string) (Time, error) {
+	if s == "" {
+		return Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return Time{Time: t}, nil
+	}
+	if t, err := time.Parse(dateLayout, s); err == nil {
+		return Time{Time: t}, nil
+	}
+	return Time{}, fmt.Errorf("invalid time %q: must be in the ISO 8601 format", s)
+}
+
+// String returns the time in the API format,
+// or an empty string for the zero time.
+func (t Time) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	if t.Location() == time.UTC {
+		return t.Format(timeLayoutUTC)
+	}
+	return t.Format(timeLayoutOffset)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*t = Time{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid time %s: must be a string", data)
+	}
+	parsed, err := ParseTime(s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTime_RoundTrip(t *testing.T) {
+	tests := []string{
+		`"2023-09-20T11:34:40+00:00"`,
+		`"2023-09-20T11:34:40Z"`,
+		`"2023-09-20T13:34:40+02:00"`,
+		`"2023-09-20T11:34:40.123Z"`,
+		`null`,
+	}
+
+	for _, tt := range tests {
+		var v Time
+		require.NoError(t, json.Unmarshal([]byte(tt), &v), tt)
+
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		assert.Equal(t, tt, string(data))
+	}
+}
+
+func TestTime_UnmarshalJSON(t *testing.T) {
+	var v struct {
+		CreatedAt  Time  `json:"createdAt"`
+		UpdatedAt  *Time `json:"updatedAt"`
+		FinishedAt Time  `json:"finishedAt"`
+		Date       Time  `json:"date"`
+	}
+	err := json.Unmarshal([]byte(`{"createdAt": "2023-09-20T13:34:40+02:00", "updatedAt": null,
+		"finishedAt": "", "date": "2023-09-20"}`), &v)
+	require.NoError(t, err)
+
+	assert.True(t, v.CreatedAt.Equal(time.Date(2023, 9, 20, 11, 34, 40, 0, time.UTC)))
+	assert.Nil(t, v.UpdatedAt)
+	assert.True(t, v.FinishedAt.IsZero())
+	assert.True(t, v.Date.Equal(time.Date(2023, 9, 20, 0, 0, 0, 0, time.UTC)))
+
+	for _, data := range []string{`"2023-23T12:17:54+00:00"`, `"yesterday"`, `1695209680`} {
+		var v Time
+		assert.Error(t, json.Unmarshal([]byte(data), &v), data)
+	}
+}
+
+func TestTime_Request(t *testing.T) {
+	deadline := Time{Time: time.Date(2023, 9, 27, 7, 0, 14, 0, time.UTC)}
+
+	data, err := json.Marshal(&TaskCreateForm{Deadline: &deadline})
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"deadline":"2023-09-27T07:00:14Z"`)
+	assert.NotContains(t, string(data), "dateFrom")
+
+	assert.Equal(t, "2023-09-27T07:00:14Z", deadline.String())
+	assert.Empty(t, Time{}.String())
+}
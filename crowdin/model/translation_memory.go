@@ -17,7 +17,7 @@ type TranslationMemory struct {
 	DefaultProjectIDs []int    `json:"defaultProjectIds"`
 	ProjectIDs        []int    `json:"projectIds"`
 	WebURL            string   `json:"webUrl"`
-	CreatedAt         string   `json:"createdAt"`
+	CreatedAt         Time     `json:"createdAt"`
 }
 
 // TranslationMemoryResponse defines the structure of the response
@@ -100,10 +100,10 @@ type TranslationMemoryExport struct {
 		TargetLanguageID string `json:"targetLanguageId"`
 		Format           string `json:"format"`
 	} `json:"attributes"`
-	CreatedAt  string `json:"createdAt"`
-	UpdatedAt  string `json:"updatedAt"`
-	StartedAt  string `json:"startedAt"`
-	FinishedAt string `json:"finishedAt"`
+	CreatedAt  Time `json:"createdAt"`
+	UpdatedAt  Time `json:"updatedAt"`
+	StartedAt  Time `json:"startedAt"`
+	FinishedAt Time `json:"finishedAt"`
 }
 
 // TranslationMemoryExportResponse defines the structure of the response
@@ -160,10 +160,10 @@ type TranslationMemoryImport struct {
 		FirstLineContainsHeader int            `json:"firstLineContainsHeader"`
 		Scheme                  map[string]int `json:"scheme"`
 	} `json:"attributes"`
-	CreatedAt  string `json:"createdAt"`
-	UpdatedAt  string `json:"updatedAt"`
-	StartedAt  string `json:"startedAt"`
-	FinishedAt string `json:"finishedAt"`
+	CreatedAt  Time `json:"createdAt"`
+	UpdatedAt  Time `json:"updatedAt"`
+	StartedAt  Time `json:"startedAt"`
+	FinishedAt Time `json:"finishedAt"`
 }
 
 // TranslationMemoryImportResponse defines the structure of the response
@@ -254,7 +254,7 @@ type TMConcordanceSearch struct {
 	Target      string `json:"target"`
 	Relevant    int    `json:"relevant"`
 	Substituted string `json:"substituted"`
-	UpdatedAt   string `json:"updatedAt"`
+	UpdatedAt   Time   `json:"updatedAt"`
 }
 
 // TMConcordanceSearchResponse defines the structure of the response
@@ -288,9 +288,9 @@ type TMSegmentRecord struct {
 	// Redactor User Identifier.
 	UpdatedBy int `json:"updatedBy"`
 	// Created at time.
-	CreatedAt string `json:"createdAt"`
+	CreatedAt Time `json:"createdAt"`
 	// Updated at time.
-	UpdatedAt string `json:"updatedAt"`
+	UpdatedAt Time `json:"updatedAt"`
 }
 
 // TMSegmentResponse defines the structure of the response
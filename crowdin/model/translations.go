@@ -13,10 +13,10 @@ type (
 		Status     string                    `json:"status"`
 		Progress   int                       `json:"progress"`
 		Attributes *PreTranslationAttributes `json:"attributes"`
-		CreatedAt  string                    `json:"createdAt"`
-		UpdatedAt  string                    `json:"updatedAt"`
-		StartedAt  string                    `json:"startedAt,omitempty"`
-		FinishedAt string                    `json:"finishedAt,omitempty"`
+		CreatedAt  Time                      `json:"createdAt"`
+		UpdatedAt  Time                      `json:"updatedAt"`
+		StartedAt  *Time                     `json:"startedAt,omitempty"`
+		FinishedAt *Time                     `json:"finishedAt,omitempty"`
 	}
 
 	PreTranslationAttributes struct {
@@ -150,9 +150,9 @@ type BuildProjectDirectoryTranslation struct {
 	ProjectID  int    `json:"projectId"`
 	Status     string `json:"status"`
 	Progress   int    `json:"progress"`
-	CreatedAt  string `json:"createdAt"`
-	UpdatedAt  string `json:"updatedAt"`
-	FinishedAt string `json:"finishedAt,omitempty"`
+	CreatedAt  Time   `json:"createdAt"`
+	UpdatedAt  Time   `json:"updatedAt"`
+	FinishedAt *Time  `json:"finishedAt,omitempty"`
 }
 
 // BuildProjectFileTranslationRequest defines the structure of a request
@@ -230,9 +230,9 @@ type TranslationsProjectBuild struct {
 	ProjectID  int              `json:"projectId"`
 	Status     string           `json:"status"`
 	Progress   int              `json:"progress"`
-	CreatedAt  string           `json:"createdAt"`
-	UpdatedAt  string           `json:"updatedAt"`
-	FinishedAt string           `json:"finishedAt,omitempty"`
+	CreatedAt  Time             `json:"createdAt"`
+	UpdatedAt  Time             `json:"updatedAt"`
+	FinishedAt *Time            `json:"finishedAt,omitempty"`
 	Attributes *BuildAttributes `json:"attributes,omitempty"`
 }
 
@@ -468,7 +468,7 @@ type (
 	// DownloadLink represents a download link.
 	DownloadLink struct {
 		URL      string `json:"url"`
-		ExpireIn string `json:"expireIn"`
+		ExpireIn Time   `json:"expireIn"`
 
 		Etag *string `json:"etag,omitempty"`
 	}
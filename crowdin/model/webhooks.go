@@ -0,0 +1,248 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+)
+
+// WebhookEvent represents an event which triggers a webhook.
+type WebhookEvent string
+
+const (
+	WebhookEventFileAdded      WebhookEvent = "file.added"
+	WebhookEventFileUpdated    WebhookEvent = "file.updated"
+	WebhookEventFileReverted   WebhookEvent = "file.reverted"
+	WebhookEventFileDeleted    WebhookEvent = "file.deleted"
+	WebhookEventFileTranslated WebhookEvent = "file.translated"
+	WebhookEventFileApproved   WebhookEvent = "file.approved"
+
+	WebhookEventProjectTranslated WebhookEvent = "project.translated"
+	WebhookEventProjectApproved   WebhookEvent = "project.approved"
+	WebhookEventProjectBuilt      WebhookEvent = "project.built"
+
+	WebhookEventTranslationUpdated WebhookEvent = "translation.updated"
+
+	WebhookEventStringAdded   WebhookEvent = "string.added"
+	WebhookEventStringUpdated WebhookEvent = "string.updated"
+	WebhookEventStringDeleted WebhookEvent = "string.deleted"
+
+	WebhookEventStringCommentCreated  WebhookEvent = "stringComment.created"
+	WebhookEventStringCommentUpdated  WebhookEvent = "stringComment.updated"
+	WebhookEventStringCommentDeleted  WebhookEvent = "stringComment.deleted"
+	WebhookEventStringCommentRestored WebhookEvent = "stringComment.restored"
+
+	WebhookEventSuggestionAdded       WebhookEvent = "suggestion.added"
+	WebhookEventSuggestionUpdated     WebhookEvent = "suggestion.updated"
+	WebhookEventSuggestionDeleted     WebhookEvent = "suggestion.deleted"
+	WebhookEventSuggestionApproved    WebhookEvent = "suggestion.approved"
+	WebhookEventSuggestionDisapproved WebhookEvent = "suggestion.disapproved"
+
+	WebhookEventTaskAdded         WebhookEvent = "task.added"
+	WebhookEventTaskStatusChanged WebhookEvent = "task.statusChanged"
+	WebhookEventTaskDeleted       WebhookEvent = "task.deleted"
+)
+
+// WebhookRequestType represents the HTTP method of a webhook request.
+type WebhookRequestType string
+
+const (
+	WebhookRequestTypeGET  WebhookRequestType = "GET"
+	WebhookRequestTypePOST WebhookRequestType = "POST"
+)
+
+// WebhookContentType represents the content type of a webhook request.
+type WebhookContentType string
+
+const (
+	WebhookContentTypeJSON           WebhookContentType = "application/json"
+	WebhookContentTypeMultipartForm  WebhookContentType = "multipart/form-data"
+	WebhookContentTypeFormURLEncoded WebhookContentType = "application/x-www-form-urlencoded"
+)
+
+// Webhook represents a project webhook.
+type Webhook struct {
+	ID              int                  `json:"id"`
+	ProjectID       int                  `json:"projectId"`
+	Name            string               `json:"name"`
+	URL             string               `json:"url"`
+	Events          []WebhookEvent       `json:"events"`
+	Headers         map[string]string    `json:"headers"`
+	Payload         map[WebhookEvent]any `json:"payload"`
+	IsActive        bool                 `json:"isActive"`
+	BatchingEnabled bool                 `json:"batchingEnabled"`
+	RequestType     WebhookRequestType   `json:"requestType"`
+	ContentType     WebhookContentType   `json:"contentType"`
+	CreatedAt       Time                 `json:"createdAt"`
+	UpdatedAt       Time                 `json:"updatedAt"`
+}
+
+// WebhookResponse defines the structure of a response when
+// getting a webhook.
+type WebhookResponse struct {
+	Data *Webhook `json:"data"`
+}
+
+// WebhooksListResponse defines the structure of a response when
+// getting a list of webhooks.
+type WebhooksListResponse struct {
+	Data []*WebhookResponse `json:"data"`
+}
+
+// WebhookAddRequest defines the structure of a request to add a webhook.
+type WebhookAddRequest struct {
+	// Webhook name.
+	Name string `json:"name"`
+	// Webhook URL.
+	URL string `json:"url"`
+	// Events which trigger the webhook.
+	Events []WebhookEvent `json:"events"`
+	// Webhook request type. Enum: GET, POST.
+	RequestType WebhookRequestType `json:"requestType"`
+	// Defines whether the webhook is active. Default: true.
+	IsActive *bool `json:"isActive,omitempty"`
+	// Defines whether to group the events of the same type into one request.
+	// Default: false.
+	BatchingEnabled *bool `json:"batchingEnabled,omitempty"`
+	// Webhook content type. Enum: "application/json", "multipart/form-data",
+	// "application/x-www-form-urlencoded". Default: "application/json".
+	// Note: The content type is used only with the POST request type.
+	ContentType WebhookContentType `json:"contentType,omitempty"`
+	// Custom headers sent with the webhook request.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Payload templates by event, e.g.
+	//
+	//	map[model.WebhookEvent]any{
+	//		model.WebhookEventFileTranslated: map[string]any{
+	//			"event": "{{event}}",
+	//			"file":  "{{fileName}}",
+	//		},
+	//	}
+	//
+	// Note: The payload is used only with the POST request type.
+	Payload map[WebhookEvent]any `json:"payload,omitempty"`
+}
+
+// Validate checks if the request is valid.
+// It implements the crowdin.RequestValidator interface.
+func (r *WebhookAddRequest) Validate() error {
+	if r == nil {
+		return ErrNilRequest
+	}
+
+	return validateWebhook(r.Name, r.URL, r.Events, r.RequestType, r.ContentType, r.Payload)
+}
+
+// validateWebhook checks the fields of a request to add
+// a project or an organization webhook.
+func validateWebhook[E ~string](
+	name, rawURL string, events []E, requestType WebhookRequestType,
+	contentType WebhookContentType, payload map[E]any,
+) error {
+	if name == "" {
+		return errors.New("name is required")
+	}
+	if rawURL == "" {
+		return errors.New("url is required")
+	}
+	if u, err := url.Parse(rawURL); err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("url %q must be an absolute URL", rawURL)
+	}
+	if len(events) == 0 {
+		return errors.New("events cannot be empty")
+	}
+	if slices.Contains(events, "") {
+		return errors.New("events cannot contain an empty event")
+	}
+	if requestType != WebhookRequestTypeGET && requestType != WebhookRequestTypePOST {
+		return fmt.Errorf("requestType is required and must be one of %q, %q",
+			WebhookRequestTypeGET, WebhookRequestTypePOST)
+	}
+	if requestType == WebhookRequestTypeGET && (contentType != "" || len(payload) > 0) {
+		return errors.New("contentType and payload can be used only with the POST request type")
+	}
+	for event := range payload {
+		if !slices.Contains(events, event) {
+			return fmt.Errorf("payload: event %q is not in events", event)
+		}
+	}
+
+	return nil
+}
+
+// OrganizationWebhookEvent represents an organization event
+// which triggers an organization webhook.
+type OrganizationWebhookEvent string
+
+const (
+	OrganizationWebhookEventGroupCreated   OrganizationWebhookEvent = "group.created"
+	OrganizationWebhookEventGroupDeleted   OrganizationWebhookEvent = "group.deleted"
+	OrganizationWebhookEventProjectCreated OrganizationWebhookEvent = "project.created"
+	OrganizationWebhookEventProjectDeleted OrganizationWebhookEvent = "project.deleted"
+)
+
+// OrganizationWebhook represents an organization webhook.
+type OrganizationWebhook struct {
+	ID              int                              `json:"id"`
+	Name            string                           `json:"name"`
+	URL             string                           `json:"url"`
+	Events          []OrganizationWebhookEvent       `json:"events"`
+	Headers         map[string]string                `json:"headers"`
+	Payload         map[OrganizationWebhookEvent]any `json:"payload"`
+	IsActive        bool                             `json:"isActive"`
+	BatchingEnabled bool                             `json:"batchingEnabled"`
+	RequestType     WebhookRequestType               `json:"requestType"`
+	ContentType     WebhookContentType               `json:"contentType"`
+	CreatedAt       Time                             `json:"createdAt"`
+	UpdatedAt       Time                             `json:"updatedAt"`
+}
+
+// OrganizationWebhookResponse defines the structure of a response when
+// getting an organization webhook.
+type OrganizationWebhookResponse struct {
+	Data *OrganizationWebhook `json:"data"`
+}
+
+// OrganizationWebhooksListResponse defines the structure of a response when
+// getting a list of organization webhooks.
+type OrganizationWebhooksListResponse struct {
+	Data []*OrganizationWebhookResponse `json:"data"`
+}
+
+// OrganizationWebhookAddRequest defines the structure of a request
+// to add an organization webhook.
+type OrganizationWebhookAddRequest struct {
+	// Webhook name.
+	Name string `json:"name"`
+	// Webhook URL.
+	URL string `json:"url"`
+	// Organization events which trigger the webhook.
+	Events []OrganizationWebhookEvent `json:"events"`
+	// Webhook request type. Enum: GET, POST.
+	RequestType WebhookRequestType `json:"requestType"`
+	// Defines whether the webhook is active. Default: true.
+	IsActive *bool `json:"isActive,omitempty"`
+	// Defines whether to group the events of the same type into one request.
+	// Default: false.
+	BatchingEnabled *bool `json:"batchingEnabled,omitempty"`
+	// Webhook content type. Enum: "application/json", "multipart/form-data",
+	// "application/x-www-form-urlencoded". Default: "application/json".
+	// Note: The content type is used only with the POST request type.
+	ContentType WebhookContentType `json:"contentType,omitempty"`
+	// Custom headers sent with the webhook request.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Payload templates by event.
+	// Note: The payload is used only with the POST request type.
+	Payload map[OrganizationWebhookEvent]any `json:"payload,omitempty"`
+}
+
+// Validate checks if the request is valid.
+// It implements the crowdin.RequestValidator interface.
+func (r *OrganizationWebhookAddRequest) Validate() error {
+	if r == nil {
+		return ErrNilRequest
+	}
+
+	return validateWebhook(r.Name, r.URL, r.Events, r.RequestType, r.ContentType, r.Payload)
+}
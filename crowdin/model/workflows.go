@@ -0,0 +1,157 @@
+package model
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// WorkflowStepType represents the type of a workflow step.
+type WorkflowStepType string
+
+const (
+	WorkflowStepTypeTranslate         WorkflowStepType = "Translate"
+	WorkflowStepTypeProofread         WorkflowStepType = "Proofread"
+	WorkflowStepTypeTMPreTranslate    WorkflowStepType = "TMPreTranslate"
+	WorkflowStepTypeMTPreTranslate    WorkflowStepType = "MTPreTranslate"
+	WorkflowStepTypeAIPreTranslate    WorkflowStepType = "AIPreTranslate"
+	WorkflowStepTypeTranslateByVendor WorkflowStepType = "TranslateByVendor"
+	WorkflowStepTypeProofreadByVendor WorkflowStepType = "ProofreadByVendor"
+	WorkflowStepTypeReview            WorkflowStepType = "Review"
+	WorkflowStepTypeQACheck           WorkflowStepType = "QACheck"
+	WorkflowStepTypeCustomCode        WorkflowStepType = "CustomCode"
+)
+
+// WorkflowStepStringStatus represents the status of a string
+// on a workflow step.
+type WorkflowStepStringStatus string
+
+const (
+	WorkflowStepStringStatusTodo       WorkflowStepStringStatus = "todo"
+	WorkflowStepStringStatusDone       WorkflowStepStringStatus = "done"
+	WorkflowStepStringStatusPending    WorkflowStepStringStatus = "pending"
+	WorkflowStepStringStatusIncomplete WorkflowStepStringStatus = "incomplete"
+	WorkflowStepStringStatusNeedReview WorkflowStepStringStatus = "need_review"
+)
+
+type (
+	// WorkflowTemplate represents a workflow template of the organization.
+	WorkflowTemplate struct {
+		ID          int                     `json:"id"`
+		Title       string                  `json:"title"`
+		Description string                  `json:"description"`
+		GroupID     *int                    `json:"groupId,omitempty"`
+		IsDefault   bool                    `json:"isDefault"`
+		WebURL      string                  `json:"webUrl"`
+		Steps       []*WorkflowTemplateStep `json:"steps"`
+	}
+
+	// WorkflowTemplateStep represents a step of a workflow template.
+	WorkflowTemplateStep struct {
+		ID        int                 `json:"id"`
+		Languages []string            `json:"languages"`
+		Assignees []int               `json:"assignees"`
+		VendorID  *int                `json:"vendorId,omitempty"`
+		MTID      *int                `json:"mtId,omitempty"`
+		Config    *WorkflowStepConfig `json:"config,omitempty"`
+	}
+
+	// WorkflowStepConfig represents the configuration of a workflow step.
+	WorkflowStepConfig struct {
+		// Assignees by language, e.g. {"uk": [1, 2]}.
+		Assignees map[string][]int `json:"assignees,omitempty"`
+	}
+)
+
+// WorkflowTemplateResponse defines the structure of a response when
+// getting a workflow template.
+type WorkflowTemplateResponse struct {
+	Data *WorkflowTemplate `json:"data"`
+}
+
+// WorkflowTemplatesListResponse defines the structure of a response when
+// getting a list of workflow templates.
+type WorkflowTemplatesListResponse struct {
+	Data []*WorkflowTemplateResponse `json:"data"`
+}
+
+// WorkflowTemplatesListOptions specifies the optional parameters to the
+// WorkflowsService.ListTemplates method.
+type WorkflowTemplatesListOptions struct {
+	// Group Identifier.
+	GroupID int `json:"groupId,omitempty"`
+
+	ListOptions
+}
+
+// Values returns the url.Values representation of WorkflowTemplatesListOptions.
+// It implements the crowdin.ListOptionsProvider interface.
+func (o *WorkflowTemplatesListOptions) Values() (url.Values, bool) {
+	if o == nil {
+		return nil, false
+	}
+
+	v, _ := o.ListOptions.Values()
+	if o.GroupID > 0 {
+		v.Add("groupId", fmt.Sprintf("%d", o.GroupID))
+	}
+
+	return v, len(v) > 0
+}
+
+// WorkflowStep represents a workflow step of a project.
+type WorkflowStep struct {
+	ID        int                 `json:"id"`
+	Title     string              `json:"title"`
+	Type      WorkflowStepType    `json:"type"`
+	Languages []string            `json:"languages"`
+	Config    *WorkflowStepConfig `json:"config,omitempty"`
+}
+
+// WorkflowStepResponse defines the structure of a response when
+// getting a workflow step.
+type WorkflowStepResponse struct {
+	Data *WorkflowStep `json:"data"`
+}
+
+// WorkflowStepsListResponse defines the structure of a response when
+// getting a list of workflow steps.
+type WorkflowStepsListResponse struct {
+	Data []*WorkflowStepResponse `json:"data"`
+}
+
+// WorkflowStepStringsListOptions specifies the optional parameters to the
+// WorkflowsService.ListStepStrings method.
+type WorkflowStepStringsListOptions struct {
+	// Language Identifiers.
+	LanguageIDs []string `json:"languageIds,omitempty"`
+	// Sort strings by the specified field.
+	// Enum: id, text, identifier, context, createdAt, updatedAt. Default: id.
+	// Example: orderBy=createdAt desc,text
+	OrderBy string `json:"orderBy,omitempty"`
+	// Filter strings by the status on the step.
+	// Enum: todo, done, pending, incomplete, need_review.
+	Status WorkflowStepStringStatus `json:"status,omitempty"`
+
+	ListOptions
+}
+
+// Values returns the url.Values representation of WorkflowStepStringsListOptions.
+// It implements the crowdin.ListOptionsProvider interface.
+func (o *WorkflowStepStringsListOptions) Values() (url.Values, bool) {
+	if o == nil {
+		return nil, false
+	}
+
+	v, _ := o.ListOptions.Values()
+	if len(o.LanguageIDs) > 0 {
+		v.Add("languageIds", JoinSlice(o.LanguageIDs))
+	}
+	if o.OrderBy != "" {
+		v.Add("orderBy", o.OrderBy)
+	}
+	if o.Status != "" {
+		v.Add("status", string(o.Status))
+	}
+
+	return v, len(v) > 0
+}
@@ -0,0 +1,178 @@
+package crowdin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+const (
+	defaultPollInterval    = time.Second
+	defaultPollMaxInterval = 30 * time.Second
+	defaultPollBackoff     = 1.5
+)
+
+var (
+	// ErrOperationFailed is returned by Operation.Wait when the operation has failed.
+	ErrOperationFailed = errors.New("operation failed")
+	// ErrOperationCanceled is returned by Operation.Wait when the operation has been canceled.
+	ErrOperationCanceled = errors.New("operation canceled")
+)
+
+// PollFunc returns the current state of an asynchronous operation.
+type PollFunc[T model.AsyncOperation] func(ctx context.Context) (T, *Response, error)
+
+// Operation is an asynchronous operation (e.g. a build, a merge or an export)
+// which is polled until it reaches a terminal state.
+//
+// To track an already started operation, use NewOperation with the
+// corresponding status method:
+//
+//	op := crowdin.NewOperation(build, func(ctx context.Context) (*model.TranslationsProjectBuild, *crowdin.Response, error) {
+//		return client.Translations.CheckBuildStatus(ctx, projectID, build.ID)
+//	})
+//	build, err := op.Wait(ctx, crowdin.OnProgress(func(status model.OperationStatus, progress int) {
+//		log.Printf("build %s: %d%%", status, progress)
+//	}))
+type Operation[T model.AsyncOperation] struct {
+	state T
+	poll  PollFunc[T]
+}
+
+// NewOperation creates a new operation with the initial state
+// (as returned by the method starting the operation) and the function
+// returning its current state.
+func NewOperation[T model.AsyncOperation](state T, poll PollFunc[T]) *Operation[T] {
+	return &Operation[T]{state: state, poll: poll}
+}
+
+// State returns the last known state of the operation.
+func (o *Operation[T]) State() T {
+	return o.state
+}
+
+// Wait polls the operation until it is finished, failed or canceled,
+// or until the context is done. It returns the last known state of the operation.
+//
+// If the operation has failed or has been canceled, the returned error is
+// ErrOperationFailed or ErrOperationCanceled respectively. The polling interval
+// starts at 1 second and grows by a factor of 1.5 up to 30 seconds unless
+// configured with the PollInterval and PollBackoff options.
+func (o *Operation[T]) Wait(ctx context.Context, opts ...WaitOption) (T, error) {
+	cfg := &waitConfig{
+		interval:    defaultPollInterval,
+		maxInterval: defaultPollMaxInterval,
+		backoff:     defaultPollBackoff,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	var (
+		interval = cfg.interval
+		status   = o.state.OperationStatus()
+		progress = o.state.OperationProgress()
+	)
+	cfg.report(status, progress)
+
+	for {
+		if status.IsTerminal() {
+			return o.state, operationError(status)
+		}
+
+		if err := sleep(ctx, interval); err != nil {
+			return o.state, err
+		}
+		interval = min(time.Duration(float64(interval)*cfg.backoff), max(cfg.maxInterval, cfg.interval))
+
+		state, _, err := o.poll(ctx)
+		if err != nil {
+			return o.state, fmt.Errorf("operation: error checking status: %w", err)
+		}
+		o.state = state
+
+		if s, p := state.OperationStatus(), state.OperationProgress(); s != status || p != progress {
+			status, progress = s, p
+			cfg.report(status, progress)
+		}
+	}
+}
+
+// operationError returns an error for unsuccessful terminal statuses.
+func operationError(status model.OperationStatus) error {
+	switch status {
+	case model.OperationStatusFailed:
+		return ErrOperationFailed
+	case model.OperationStatusFinished:
+		return nil
+	default:
+		return ErrOperationCanceled
+	}
+}
+
+// startAndWait starts an operation and waits for it to complete.
+func startAndWait[T model.AsyncOperation](
+	ctx context.Context,
+	start func() (T, *Response, error),
+	poll func(state T) PollFunc[T],
+	opts []WaitOption,
+) (T, error) {
+	state, _, err := start()
+	if err != nil {
+		return state, err
+	}
+	if state.OperationStatus() == "" {
+		return state, errors.New("operation: the response does not contain the operation status")
+	}
+	return NewOperation(state, poll(state)).Wait(ctx, opts...)
+}
+
+// WaitOption configures how an operation is polled.
+type WaitOption func(*waitConfig)
+
+type waitConfig struct {
+	interval    time.Duration
+	maxInterval time.Duration
+	backoff     float64
+	onProgress  func(model.OperationStatus, int)
+}
+
+func (c *waitConfig) report(status model.OperationStatus, progress int) {
+	if c.onProgress != nil {
+		c.onProgress(status, progress)
+	}
+}
+
+// PollInterval sets the initial interval between status checks.
+func PollInterval(d time.Duration) WaitOption {
+	return func(c *waitConfig) {
+		if d > 0 {
+			c.interval = d
+		}
+	}
+}
+
+// PollBackoff sets the factor the polling interval is multiplied by after
+// every status check and the maximum interval. A factor of 1 disables
+// the backoff.
+func PollBackoff(factor float64, maxInterval time.Duration) WaitOption {
+	return func(c *waitConfig) {
+		if factor >= 1 {
+			c.backoff = factor
+		}
+		if maxInterval > 0 {
+			c.maxInterval = maxInterval
+		}
+	}
+}
+
+// OnProgress sets a callback which is called with the initial state of the
+// operation and every time its status or progress changes.
+func OnProgress(fn func(status model.OperationStatus, progress int)) WaitOption {
+	return func(c *waitConfig) {
+		c.onProgress = fn
+	}
+}
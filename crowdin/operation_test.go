@@ -0,0 +1,218 @@
+package crowdin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pollStates returns a poll function which returns the given states one by one.
+func pollStates(states ...*model.BranchMerge) (PollFunc[*model.BranchMerge], *int) {
+	calls := 0
+	return func(context.Context) (*model.BranchMerge, *Response, error) {
+		state := states[min(calls, len(states)-1)]
+		calls++
+		return state, &Response{}, nil
+	}, &calls
+}
+
+func TestOperation_Wait(t *testing.T) {
+	poll, calls := pollStates(
+		&model.BranchMerge{Status: "inProgress", Progress: 10},
+		&model.BranchMerge{Status: "inProgress", Progress: 10},
+		&model.BranchMerge{Status: "inProgress", Progress: 70},
+		&model.BranchMerge{Status: "finished", Progress: 100},
+	)
+
+	type progress struct {
+		status model.OperationStatus
+		value  int
+	}
+	var reported []progress
+
+	op := NewOperation(&model.BranchMerge{Status: "created"}, poll)
+	state, err := op.Wait(context.Background(),
+		PollInterval(time.Millisecond),
+		OnProgress(func(status model.OperationStatus, value int) {
+			reported = append(reported, progress{status, value})
+		}),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, "finished", state.Status)
+	assert.Equal(t, state, op.State())
+	assert.Equal(t, 4, *calls)
+	assert.Equal(t, []progress{
+		{"created", 0},
+		{"inProgress", 10},
+		{"inProgress", 70},
+		{"finished", 100},
+	}, reported)
+}
+
+func TestOperation_Wait_TerminalStatus(t *testing.T) {
+	tests := []struct {
+		status      string
+		expectedErr error
+	}{
+		{status: "finished"},
+		{status: "failed", expectedErr: ErrOperationFailed},
+		{status: "canceled", expectedErr: ErrOperationCanceled},
+		{status: "cancelled", expectedErr: ErrOperationCanceled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			poll, calls := pollStates(&model.BranchMerge{Status: tt.status})
+
+			state, err := NewOperation(&model.BranchMerge{Status: "created"}, poll).
+				Wait(context.Background(), PollInterval(time.Millisecond))
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.status, state.Status)
+			assert.Equal(t, 1, *calls)
+		})
+	}
+}
+
+func TestOperation_Wait_AlreadyFinished(t *testing.T) {
+	poll, calls := pollStates(&model.BranchMerge{Status: "inProgress"})
+
+	state, err := NewOperation(&model.BranchMerge{Status: "finished"}, poll).Wait(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "finished", state.Status)
+	assert.Equal(t, 0, *calls)
+}
+
+func TestOperation_Wait_ContextDone(t *testing.T) {
+	poll, _ := pollStates(&model.BranchMerge{Status: "inProgress", Progress: 50})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	state, err := NewOperation(&model.BranchMerge{Status: "created"}, poll).
+		Wait(ctx, PollInterval(time.Millisecond), PollBackoff(1, 0))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 50, state.Progress)
+}
+
+func TestOperation_Wait_PollError(t *testing.T) {
+	poll := func(context.Context) (*model.BranchMerge, *Response, error) {
+		return nil, nil, errors.New("server error")
+	}
+
+	initial := &model.BranchMerge{Status: "created"}
+	state, err := NewOperation(initial, poll).Wait(context.Background(), PollInterval(time.Millisecond))
+	require.EqualError(t, err, "operation: error checking status: server error")
+	assert.Same(t, initial, state)
+}
+
+func TestOperation_Wait_Backoff(t *testing.T) {
+	var times []time.Time
+	poll := func(context.Context) (*model.BranchMerge, *Response, error) {
+		times = append(times, time.Now())
+		if len(times) == 4 {
+			return &model.BranchMerge{Status: "finished"}, nil, nil
+		}
+		return &model.BranchMerge{Status: "inProgress"}, nil, nil
+	}
+
+	start := time.Now()
+	_, err := NewOperation(&model.BranchMerge{Status: "created"}, poll).
+		Wait(context.Background(), PollInterval(10*time.Millisecond), PollBackoff(2, 25*time.Millisecond))
+	require.NoError(t, err)
+
+	// Intervals: 10ms, 20ms, 25ms, 25ms.
+	require.Len(t, times, 4)
+	assert.GreaterOrEqual(t, times[0].Sub(start), 10*time.Millisecond)
+	assert.GreaterOrEqual(t, times[1].Sub(times[0]), 20*time.Millisecond)
+	assert.GreaterOrEqual(t, times[3].Sub(start), 80*time.Millisecond)
+}
+
+func TestTranslationsService_BuildProjectTranslationAndWait(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/projects/1/translations/builds", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testJSONBody(t, r, `{"targetLanguageIds":["uk"]}`)
+
+		fmt.Fprint(w, `{"data": {"id": 2, "projectId": 1, "status": "created", "progress": 0}}`)
+	})
+
+	checks := 0
+	mux.HandleFunc("/api/v2/projects/1/translations/builds/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if checks++; checks < 2 {
+			fmt.Fprint(w, `{"data": {"id": 2, "projectId": 1, "status": "inProgress", "progress": 50}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"id": 2, "projectId": 1, "status": "finished", "progress": 100}}`)
+	})
+
+	req := &model.BuildProjectRequest{TargetLanguageIDs: []string{"uk"}}
+	build, err := client.Translations.BuildProjectTranslationAndWait(context.Background(), 1, req, PollInterval(time.Millisecond))
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, build.ID)
+	assert.Equal(t, "finished", build.Status)
+	assert.Equal(t, 100, build.Progress)
+	assert.Equal(t, 2, checks)
+}
+
+func TestBranchesService_MergeAndWait_Failed(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/projects/1/branches/2/merges", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"data": {"identifier": "50fb3506-4127-4ba8-8296-f97dc7e3e0c3", "status": "created"}}`)
+	})
+	mux.HandleFunc("/api/v2/projects/1/branches/2/merges/50fb3506-4127-4ba8-8296-f97dc7e3e0c3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"data": {"identifier": "50fb3506-4127-4ba8-8296-f97dc7e3e0c3", "status": "failed", "progress": 30}}`)
+	})
+
+	req := &model.BranchesMergeRequest{SourceBranchID: 3}
+	merge, err := client.Branches.MergeAndWait(context.Background(), 1, 2, req, PollInterval(time.Millisecond))
+	require.ErrorIs(t, err, ErrOperationFailed)
+	assert.Equal(t, "failed", merge.Status)
+	assert.Equal(t, 30, merge.Progress)
+}
+
+func TestReportsService_GenerateAndWait_StartError(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/projects/1/reports", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error": {"code": 403, "message": "Forbidden"}}`)
+	})
+
+	req := &model.ReportGenerateRequest{
+		Name:   model.ReportTopMembers,
+		Schema: &model.TopMembersSchema{Unit: model.ReportUnitWords, Format: model.ReportFormatXLSX},
+	}
+	_, err := client.Reports.GenerateAndWait(context.Background(), 1, req)
+	require.EqualError(t, err, "403 Forbidden")
+}
+
+func TestSourceStringsService_UploadAndWait_EmptyResponse(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/projects/1/strings/uploads", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	req := &model.SourceStringsUploadRequest{StorageID: 1, BranchID: 2}
+	_, err := client.SourceStrings.UploadAndWait(context.Background(), 1, req)
+	require.EqualError(t, err, "operation: the response does not contain the operation status")
+}
@@ -0,0 +1,137 @@
+package crowdin
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+// OrganizationWebhooksService provides access to the Organization Webhooks API.
+// Organization webhooks notify your service about the events in the organization,
+// e.g. a created project or group.
+//
+// The methods are available only for Enterprise clients created with the
+// WithOrganization option. Other clients get the model.ErrEnterpriseOnly error
+// without sending a request.
+//
+// Crowdin API docs: https://developer.crowdin.com/enterprise/api/v2/#tag/Organization-Webhooks
+type OrganizationWebhooksService struct {
+	client *Client
+}
+
+// List returns a list of organization webhooks.
+//
+// https://developer.crowdin.com/enterprise/api/v2/#operation/api.webhooks.getMany
+func (s *OrganizationWebhooksService) List(ctx context.Context, opts *model.ListOptions) (
+	[]*model.OrganizationWebhook, *Response, error,
+) {
+	ctx = WithOperationName(ctx, "OrganizationWebhooks.List")
+	if !s.client.isEnterprise() {
+		return nil, nil, model.ErrEnterpriseOnly
+	}
+
+	res := new(model.OrganizationWebhooksListResponse)
+	resp, err := s.client.Get(ctx, "/api/v2/webhooks", opts, res)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	list := make([]*model.OrganizationWebhook, 0, len(res.Data))
+	for _, webhook := range res.Data {
+		list = append(list, webhook.Data)
+	}
+
+	return list, resp, err
+}
+
+// All returns an iterator over all organization webhooks.
+// It fetches the pages with List until a page shorter than the limit is returned.
+func (s *OrganizationWebhooksService) All(
+	ctx context.Context, opts *model.ListOptions,
+) iter.Seq2[*model.OrganizationWebhook, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, *o, func(ctx context.Context, page model.ListOptions) (
+		[]*model.OrganizationWebhook, *Response, error,
+	) {
+		return s.List(ctx, &page)
+	})
+}
+
+// ListAll returns all organization webhooks by fetching every page with List.
+func (s *OrganizationWebhooksService) ListAll(ctx context.Context, opts *model.ListOptions) (
+	[]*model.OrganizationWebhook, error,
+) {
+	return Collect(s.All(ctx, opts))
+}
+
+// Get returns an organization webhook by its identifier.
+//
+// https://developer.crowdin.com/enterprise/api/v2/#operation/api.webhooks.get
+func (s *OrganizationWebhooksService) Get(ctx context.Context, webhookID int) (
+	*model.OrganizationWebhook, *Response, error,
+) {
+	ctx = WithOperationName(ctx, "OrganizationWebhooks.Get")
+	if !s.client.isEnterprise() {
+		return nil, nil, model.ErrEnterpriseOnly
+	}
+
+	res := new(model.OrganizationWebhookResponse)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/webhooks/%d", webhookID), nil, res)
+
+	return res.Data, resp, err
+}
+
+// Add creates a new organization webhook.
+//
+// https://developer.crowdin.com/enterprise/api/v2/#operation/api.webhooks.post
+func (s *OrganizationWebhooksService) Add(ctx context.Context, req *model.OrganizationWebhookAddRequest) (
+	*model.OrganizationWebhook, *Response, error,
+) {
+	ctx = WithOperationName(ctx, "OrganizationWebhooks.Add")
+	if !s.client.isEnterprise() {
+		return nil, nil, model.ErrEnterpriseOnly
+	}
+
+	res := new(model.OrganizationWebhookResponse)
+	resp, err := s.client.Post(ctx, "/api/v2/webhooks", req, res)
+
+	return res.Data, resp, err
+}
+
+// Edit updates an organization webhook by its identifier.
+//
+// Request body:
+// - op - operation to perform with the webhook. Enum: replace, test.
+// - path (json-pointer) - path to the field to update.
+// Enum: "/name", "/url", "/isActive", "/batchingEnabled", "/contentType",
+// "/events", "/headers", "/payload", "/requestType".
+// - value - new value for the field.
+//
+// https://developer.crowdin.com/enterprise/api/v2/#operation/api.webhooks.patch
+func (s *OrganizationWebhooksService) Edit(ctx context.Context, webhookID int, req []*model.UpdateRequest) (
+	*model.OrganizationWebhook, *Response, error,
+) {
+	ctx = WithOperationName(ctx, "OrganizationWebhooks.Edit")
+	if !s.client.isEnterprise() {
+		return nil, nil, model.ErrEnterpriseOnly
+	}
+
+	res := new(model.OrganizationWebhookResponse)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/webhooks/%d", webhookID), req, res)
+
+	return res.Data, resp, err
+}
+
+// Delete removes an organization webhook by its identifier.
+//
+// https://developer.crowdin.com/enterprise/api/v2/#operation/api.webhooks.delete
+func (s *OrganizationWebhooksService) Delete(ctx context.Context, webhookID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "OrganizationWebhooks.Delete")
+	if !s.client.isEnterprise() {
+		return nil, model.ErrEnterpriseOnly
+	}
+
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/webhooks/%d", webhookID))
+}
@@ -0,0 +1,238 @@
+package crowdin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const organizationWebhookJSON = `{
+	"data": {
+		"id": 4,
+		"name": "Onboarding",
+		"url": "https://example.com/hooks/crowdin",
+		"events": ["project.created", "group.created"],
+		"headers": {},
+		"payload": {},
+		"isActive": true,
+		"batchingEnabled": true,
+		"requestType": "POST",
+		"contentType": "application/json",
+		"createdAt": "2023-09-23T09:19:07+00:00",
+		"updatedAt": "2023-09-23T09:19:07+00:00"
+	}
+}`
+
+func expectedOrganizationWebhook() *model.OrganizationWebhook {
+	return &model.OrganizationWebhook{
+		ID:   4,
+		Name: "Onboarding",
+		URL:  "https://example.com/hooks/crowdin",
+		Events: []model.OrganizationWebhookEvent{
+			model.OrganizationWebhookEventProjectCreated,
+			model.OrganizationWebhookEventGroupCreated,
+		},
+		Headers:         map[string]string{},
+		Payload:         map[model.OrganizationWebhookEvent]any{},
+		IsActive:        true,
+		BatchingEnabled: true,
+		RequestType:     model.WebhookRequestTypePOST,
+		ContentType:     model.WebhookContentTypeJSON,
+		CreatedAt:       testTime("2023-09-23T09:19:07+00:00"),
+		UpdatedAt:       testTime("2023-09-23T09:19:07+00:00"),
+	}
+}
+
+func TestOrganizationWebhooksService_List(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/webhooks"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, path+"?limit=10")
+
+		fmt.Fprintf(w, `{
+			"data": [%s],
+			"pagination": {
+				"offset": 0,
+				"limit": 10
+			}
+		}`, organizationWebhookJSON)
+	})
+
+	webhooks, resp, err := client.OrganizationWebhooks.List(context.Background(), &model.ListOptions{Limit: 10})
+	require.NoError(t, err)
+
+	assert.Equal(t, []*model.OrganizationWebhook{expectedOrganizationWebhook()}, webhooks)
+	assert.Equal(t, 10, resp.Pagination.Limit)
+}
+
+func TestOrganizationWebhooksService_Get(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/webhooks/4"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, path)
+
+		fmt.Fprint(w, organizationWebhookJSON)
+	})
+
+	webhook, resp, err := client.OrganizationWebhooks.Get(context.Background(), 4)
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	assert.Equal(t, expectedOrganizationWebhook(), webhook)
+}
+
+func TestOrganizationWebhooksService_Add(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/webhooks"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testURL(t, r, path)
+		testBody(t, r, `{"name":"Onboarding","url":"https://example.com/hooks/crowdin",`+
+			`"events":["project.created","group.created"],"requestType":"POST","batchingEnabled":true}`+"\n")
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, organizationWebhookJSON)
+	})
+
+	req := &model.OrganizationWebhookAddRequest{
+		Name: "Onboarding",
+		URL:  "https://example.com/hooks/crowdin",
+		Events: []model.OrganizationWebhookEvent{
+			model.OrganizationWebhookEventProjectCreated,
+			model.OrganizationWebhookEventGroupCreated,
+		},
+		RequestType:     model.WebhookRequestTypePOST,
+		BatchingEnabled: ToPtr(true),
+	}
+	webhook, resp, err := client.OrganizationWebhooks.Add(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	assert.Equal(t, expectedOrganizationWebhook(), webhook)
+}
+
+func TestOrganizationWebhooksService_Add_WithValidationErrors(t *testing.T) {
+	tests := []struct {
+		req         *model.OrganizationWebhookAddRequest
+		expectedErr string
+	}{
+		{
+			req:         nil,
+			expectedErr: "request cannot be nil",
+		},
+		{
+			req:         &model.OrganizationWebhookAddRequest{},
+			expectedErr: "name is required",
+		},
+		{
+			req: &model.OrganizationWebhookAddRequest{
+				Name:        "Onboarding",
+				URL:         "https://example.com/hooks/crowdin",
+				RequestType: model.WebhookRequestTypePOST,
+			},
+			expectedErr: "events cannot be empty",
+		},
+		{
+			req: &model.OrganizationWebhookAddRequest{
+				Name:        "Onboarding",
+				URL:         "https://example.com/hooks/crowdin",
+				Events:      []model.OrganizationWebhookEvent{model.OrganizationWebhookEventProjectDeleted},
+				RequestType: model.WebhookRequestTypePOST,
+				Payload: map[model.OrganizationWebhookEvent]any{
+					model.OrganizationWebhookEventGroupDeleted: map[string]any{"group": "{{groupId}}"},
+				},
+			},
+			expectedErr: `payload: event "group.deleted" is not in events`,
+		},
+	}
+
+	for _, tt := range tests {
+		assert.EqualError(t, tt.req.Validate(), tt.expectedErr)
+	}
+}
+
+func TestOrganizationWebhooksService_Edit(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/webhooks/4"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testURL(t, r, path)
+		testBody(t, r, `[{"op":"replace","path":"/isActive","value":true}]`+"\n")
+
+		fmt.Fprint(w, organizationWebhookJSON)
+	})
+
+	req := []*model.UpdateRequest{
+		{
+			Op:    "replace",
+			Path:  "/isActive",
+			Value: true,
+		},
+	}
+	webhook, resp, err := client.OrganizationWebhooks.Edit(context.Background(), 4, req)
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	assert.Equal(t, expectedOrganizationWebhook(), webhook)
+}
+
+func TestOrganizationWebhooksService_Delete(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/webhooks/4"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testURL(t, r, path)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.OrganizationWebhooks.Delete(context.Background(), 4)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestOrganizationWebhooksService_NotEnterprise(t *testing.T) {
+	client, mux, teardown := setupClient(WithOrganization(""))
+	defer teardown()
+
+	mux.HandleFunc("/", func(http.ResponseWriter, *http.Request) {
+		t.Error("request sent by a non-Enterprise client")
+	})
+
+	ctx := context.Background()
+	_, _, err := client.OrganizationWebhooks.List(ctx, nil)
+	require.ErrorIs(t, err, model.ErrEnterpriseOnly)
+
+	_, err = client.OrganizationWebhooks.ListAll(ctx, nil)
+	require.ErrorIs(t, err, model.ErrEnterpriseOnly)
+
+	_, _, err = client.OrganizationWebhooks.Get(ctx, 4)
+	require.ErrorIs(t, err, model.ErrEnterpriseOnly)
+
+	_, _, err = client.OrganizationWebhooks.Add(ctx, &model.OrganizationWebhookAddRequest{})
+	require.ErrorIs(t, err, model.ErrEnterpriseOnly)
+
+	_, _, err = client.OrganizationWebhooks.Edit(ctx, 4, nil)
+	require.ErrorIs(t, err, model.ErrEnterpriseOnly)
+
+	resp, err := client.OrganizationWebhooks.Delete(ctx, 4)
+	require.ErrorIs(t, err, model.ErrEnterpriseOnly)
+	assert.Nil(t, resp)
+}
@@ -0,0 +1,217 @@
+// Package otelcrowdin provides OpenTelemetry tracing and metrics
+// for the Crowdin API client.
+//
+// The instrumentation is a crowdin.Middleware, so it is off unless
+// it is added to the client:
+//
+//	client, err := crowdin.NewClient(token, otelcrowdin.WithInstrumentation())
+//
+// Every API call creates a client span named after the service method,
+// e.g. "SourceFiles.UpdateOrRestoreFile", and records the request duration
+// and the response size histograms. The span covers the retries and the
+// rate limiting of the call.
+package otelcrowdin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin"
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// ScopeName is the instrumentation scope name of the tracer and meter.
+	ScopeName = "github.com/chenshone/crowdin-api-client-go/crowdin/otelcrowdin"
+
+	// Attribute keys of the spans and metrics.
+	AttrOperation  = attribute.Key("crowdin.operation")
+	AttrProjectID  = attribute.Key("crowdin.project_id")
+	AttrErrorCode  = attribute.Key("crowdin.error.code")
+	AttrMethod     = attribute.Key("http.request.method")
+	AttrStatusCode = attribute.Key("http.response.status_code")
+	AttrURL        = attribute.Key("url.full")
+	AttrServer     = attribute.Key("server.address")
+	AttrErrorType  = attribute.Key("error.type")
+
+	// Metric names.
+	MetricDuration     = "crowdin.client.request.duration"
+	MetricResponseSize = "crowdin.client.response.size"
+)
+
+// Option configures the instrumentation.
+type Option func(*config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// WithTracerProvider sets the tracer provider.
+// If not set, the global tracer provider is used.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the meter provider.
+// If not set, the global meter provider is used.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+// WithInstrumentation returns a client option which adds
+// the instrumentation middleware to the client.
+func WithInstrumentation(opts ...Option) crowdin.ClientOption {
+	return func(c *crowdin.Client) error {
+		mw, err := Middleware(opts...)
+		if err != nil {
+			return err
+		}
+		return crowdin.WithMiddleware(mw)(c)
+	}
+}
+
+// Middleware returns the instrumentation middleware. It is added
+// by WithInstrumentation, but can be used to order it with other middleware.
+func Middleware(opts ...Option) (crowdin.Middleware, error) {
+	cfg := &config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	tracer := cfg.tracerProvider.Tracer(ScopeName)
+	meter := cfg.meterProvider.Meter(ScopeName)
+
+	duration, err := meter.Float64Histogram(MetricDuration,
+		metric.WithDescription("Duration of Crowdin API calls including retries."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	size, err := meter.Int64Histogram(MetricResponseSize,
+		metric.WithDescription("Size of Crowdin API response bodies."),
+		metric.WithUnit("By"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(next crowdin.Doer) crowdin.Doer {
+		return crowdin.DoerFunc(func(r *http.Request, v any) (*crowdin.Response, error) {
+			operation := crowdin.OperationName(r.Context())
+			name := operation
+			if name == "" {
+				name = "Crowdin " + r.Method
+			}
+
+			attrs := []attribute.KeyValue{
+				AttrMethod.String(r.Method),
+				AttrServer.String(r.URL.Hostname()),
+			}
+			if operation != "" {
+				attrs = append(attrs, AttrOperation.String(operation))
+			}
+
+			spanAttrs := append([]attribute.KeyValue{AttrURL.String(r.URL.String())}, attrs...)
+			if id, ok := projectID(r.URL.Path); ok {
+				spanAttrs = append(spanAttrs, AttrProjectID.Int(id))
+			}
+
+			ctx, span := tracer.Start(r.Context(), name,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(spanAttrs...),
+			)
+			defer span.End()
+
+			start := time.Now()
+			resp, err := next.Do(r.WithContext(ctx), v)
+			elapsed := time.Since(start)
+
+			if resp != nil && resp.Response != nil {
+				attrs = append(attrs, AttrStatusCode.Int(resp.StatusCode))
+				span.SetAttributes(AttrStatusCode.Int(resp.StatusCode))
+				if resp.ContentLength >= 0 {
+					size.Record(ctx, resp.ContentLength, metric.WithAttributes(attrs...))
+				}
+			}
+			if err != nil {
+				errorType := errorType(err)
+				attrs = append(attrs, AttrErrorType.String(errorType))
+				span.SetAttributes(AttrErrorType.String(errorType))
+				if code := errorCode(err); code != "" {
+					span.SetAttributes(AttrErrorCode.String(code))
+				}
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			duration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(attrs...))
+
+			return resp, err
+		})
+	}, nil
+}
+
+// projectID returns the project ID of the API path, e.g. 1 for "/api/v2/projects/1/files".
+func projectID(path string) (int, bool) {
+	_, rest, ok := strings.Cut(path, "/projects/")
+	if !ok {
+		return 0, false
+	}
+	rest, _, _ = strings.Cut(rest, "/")
+	id, err := strconv.Atoi(rest)
+	return id, err == nil
+}
+
+// errorType returns the type of the error: the HTTP status code
+// of API errors and "_OTHER" for other errors (e.g. network errors).
+func errorType(err error) string {
+	var validationErr *model.ValidationErrorResponse
+	if errors.As(err, &validationErr) && validationErr.Status != 0 {
+		return strconv.Itoa(validationErr.Status)
+	}
+	var apiErr *model.ErrorResponse
+	if errors.As(err, &apiErr) && apiErr.StatusCode() != 0 {
+		return strconv.Itoa(apiErr.StatusCode())
+	}
+	return "_OTHER"
+}
+
+// errorCode returns the Crowdin error code of API errors. For validation
+// errors it returns the codes of all invalid fields separated by commas.
+func errorCode(err error) string {
+	var validationErr *model.ValidationErrorResponse
+	if errors.As(err, &validationErr) {
+		var fieldCodes []string
+		for _, e := range validationErr.Errors {
+			for _, fieldErr := range e.Error.Errors {
+				if fieldErr.Code != nil {
+					fieldCodes = append(fieldCodes, fmt.Sprint(fieldErr.Code))
+				}
+			}
+		}
+		return strings.Join(fieldCodes, ",")
+	}
+
+	var apiErr *model.ErrorResponse
+	if errors.As(err, &apiErr) && apiErr.Err.Code != nil {
+		return fmt.Sprint(apiErr.Err.Code)
+	}
+	return ""
+}
@@ -0,0 +1,160 @@
+package otelcrowdin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin"
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// setupClient returns an instrumented client of the test server,
+// the span recorder and the metric reader.
+func setupClient(t *testing.T, handler http.Handler) (*crowdin.Client, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	client, err := crowdin.NewClient("token",
+		crowdin.WithBaseURL(server.URL),
+		WithInstrumentation(
+			WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+			WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+		),
+	)
+	require.NoError(t, err)
+
+	return client, spans, reader
+}
+
+func attributes(kvs []attribute.KeyValue) map[attribute.Key]attribute.Value {
+	m := make(map[attribute.Key]attribute.Value, len(kvs))
+	for _, kv := range kvs {
+		m[kv.Key] = kv.Value
+	}
+	return m
+}
+
+func TestInstrumentation(t *testing.T) {
+	const body = `{"data": {"id": 2, "name": "strings.json"}}`
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /api/v2/projects/1/files/2", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, body)
+	})
+	client, spans, reader := setupClient(t, mux)
+
+	_, _, err := client.SourceFiles.UpdateOrRestoreFile(context.Background(), 1, 2, &model.FileUpdateRestoreRequest{StorageID: 3})
+	require.NoError(t, err)
+
+	ended := spans.Ended()
+	require.Len(t, ended, 1)
+
+	span := ended[0]
+	assert.Equal(t, "SourceFiles.UpdateOrRestoreFile", span.Name())
+	assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+	assert.Equal(t, codes.Unset, span.Status().Code)
+
+	attrs := attributes(span.Attributes())
+	assert.Equal(t, int64(1), attrs[AttrProjectID].AsInt64())
+	assert.Equal(t, int64(http.StatusOK), attrs[AttrStatusCode].AsInt64())
+	assert.Equal(t, http.MethodPut, attrs[AttrMethod].AsString())
+	assert.Equal(t, "SourceFiles.UpdateOrRestoreFile", attrs[AttrOperation].AsString())
+	assert.NotContains(t, attrs, AttrErrorCode)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	assert.Equal(t, ScopeName, rm.ScopeMetrics[0].Scope.Name)
+
+	metrics := make(map[string]metricdata.Aggregation)
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		metrics[m.Name] = m.Data
+	}
+
+	duration, ok := metrics[MetricDuration].(metricdata.Histogram[float64])
+	require.True(t, ok)
+	require.Len(t, duration.DataPoints, 1)
+	assert.Equal(t, uint64(1), duration.DataPoints[0].Count)
+	op, _ := duration.DataPoints[0].Attributes.Value(AttrOperation)
+	assert.Equal(t, "SourceFiles.UpdateOrRestoreFile", op.AsString())
+
+	size, ok := metrics[MetricResponseSize].(metricdata.Histogram[int64])
+	require.True(t, ok)
+	require.Len(t, size.DataPoints, 1)
+	assert.Equal(t, int64(len(body)), size.DataPoints[0].Sum)
+}
+
+func TestInstrumentation_Error(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/projects/1", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error": {"code": 404, "message": "Project Not Found"}}`)
+	})
+	mux.HandleFunc("POST /api/v2/projects/1/strings", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errors": [{"error": {"key": "fileId", "errors": [{"code": "notFound", "message": "File Not Found"}]}}]}`)
+	})
+	client, spans, _ := setupClient(t, mux)
+
+	_, _, err := client.Projects.Get(context.Background(), 1)
+	require.Error(t, err)
+	_, _, err = client.SourceStrings.Add(context.Background(), 1, &model.SourceStringsAddRequest{Text: "Hello", FileID: 1})
+	require.Error(t, err)
+
+	ended := spans.Ended()
+	require.Len(t, ended, 2)
+
+	tests := []struct {
+		name      string
+		status    int64
+		errorCode string
+	}{
+		{name: "Projects.Get", status: http.StatusNotFound, errorCode: "404"},
+		{name: "SourceStrings.Add", status: http.StatusBadRequest, errorCode: "notFound"},
+	}
+	for i, tt := range tests {
+		span := ended[i]
+		assert.Equal(t, tt.name, span.Name())
+		assert.Equal(t, codes.Error, span.Status().Code)
+
+		attrs := attributes(span.Attributes())
+		assert.Equal(t, tt.status, attrs[AttrStatusCode].AsInt64())
+		assert.Equal(t, fmt.Sprint(tt.status), attrs[AttrErrorType].AsString())
+		assert.Equal(t, tt.errorCode, attrs[AttrErrorCode].AsString())
+	}
+}
+
+func TestProjectID(t *testing.T) {
+	tests := []struct {
+		path string
+		id   int
+		ok   bool
+	}{
+		{path: "/api/v2/projects/1/files", id: 1, ok: true},
+		{path: "/api/v2/projects/42", id: 42, ok: true},
+		{path: "/api/v2/projects", ok: false},
+		{path: "/api/v2/storages/1", ok: false},
+	}
+	for _, tt := range tests {
+		id, ok := projectID(tt.path)
+		assert.Equal(t, tt.ok, ok, tt.path)
+		assert.Equal(t, tt.id, id, tt.path)
+	}
+}
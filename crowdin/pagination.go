@@ -0,0 +1,251 @@
+package crowdin
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"net/url"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+// maxPageLimit is the maximum number of items the API returns in a single page.
+const maxPageLimit = 500
+
+// ListFunc fetches a single page of a collection using the provided
+// pagination options.
+type ListFunc[T any] func(ctx context.Context, opts model.ListOptions) ([]*T, *Response, error)
+
+// Paginate returns an iterator over all items of a collection.
+//
+// Starting from the offset in opts, it fetches page after page using the list
+// function until a page shorter than the limit is returned. If the limit is not
+// set or exceeds the maximum page size (500), the maximum page size is used.
+// Iteration stops at the first error or when the context is done.
+func Paginate[T any](ctx context.Context, opts model.ListOptions, list ListFunc[T]) iter.Seq2[*T, error] {
+	if opts.Limit <= 0 {
+		opts.Limit = maxPageLimit
+	}
+	opts.Limit = min(opts.Limit, maxPageLimit)
+
+	return func(yield func(*T, error) bool) {
+		page := opts
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			items, _, err := list(ctx, page)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if len(items) < page.Limit {
+				return
+			}
+			page.Offset += len(items)
+		}
+	}
+}
+
+// Prefetch returns an iterator over all items of a collection which fetches
+// up to `concurrency` pages ahead in parallel and yields the items in order.
+//
+// Page offsets are computed from the offset and limit in opts (if the limit is
+// not set or exceeds the maximum page size (500), the maximum page size is used). Iteration stops after the first
+// page shorter than the limit, and the pending requests for the following pages
+// are canceled. Iteration also stops at the first error or when the context is done.
+//
+// All requests go through the client, so they respect its rate limit and retry policy.
+//
+// Example:
+//
+//	opts := &model.SourceStringsListOptions{Filter: "button"}
+//	list := func(ctx context.Context, page model.ListOptions) ([]*model.SourceString, *crowdin.Response, error) {
+//		o := *opts
+//		o.ListOptions = page
+//		return client.SourceStrings.List(ctx, projectID, &o)
+//	}
+//	for str, err := range crowdin.Prefetch(ctx, model.ListOptions{Limit: 500}, 4, list) {
+//		...
+//	}
+func Prefetch[T any](ctx context.Context, opts model.ListOptions, concurrency int, list ListFunc[T]) iter.Seq2[*T, error] {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = maxPageLimit
+	}
+	opts.Limit = min(opts.Limit, maxPageLimit)
+
+	type page struct {
+		items []*T
+		err   error
+	}
+
+	return func(yield func(*T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var (
+			pending = make([]chan page, 0, concurrency)
+			next    = 0
+		)
+		fetch := func() {
+			ch := make(chan page, 1)
+			pageOpts := opts
+			pageOpts.Offset += next * opts.Limit
+			next++
+
+			go func() {
+				items, _, err := list(ctx, pageOpts)
+				ch <- page{items: items, err: err}
+			}()
+			pending = append(pending, ch)
+		}
+
+		for range concurrency {
+			fetch()
+		}
+
+		for {
+			var p page
+			select {
+			case p = <-pending[0]:
+			case <-ctx.Done():
+				p.err = ctx.Err()
+			}
+			pending = pending[1:]
+
+			if p.err != nil {
+				yield(nil, p.err)
+				return
+			}
+			for _, item := range p.items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(p.items) < opts.Limit {
+				return
+			}
+
+			fetch()
+		}
+	}
+}
+
+// GetList returns a list function which fetches pages of the collection
+// at the given path using Client.Get. The params are added to the query
+// string along with the pagination options of each page.
+//
+// The list function decodes the standard list response of the API, so it
+// can be used with Paginate or Prefetch for any list endpoint:
+//
+//	list := crowdin.GetList[model.SourceString](client, "/api/v2/projects/1/strings", opts)
+//	for str, err := range crowdin.Prefetch(ctx, model.ListOptions{}, 4, list) {
+//		...
+//	}
+func GetList[T any](c *Client, path string, params ListOptionsProvider) ListFunc[T] {
+	return func(ctx context.Context, opts model.ListOptions) ([]*T, *Response, error) {
+		if c == nil {
+			return nil, nil, errors.New("client cannot be nil")
+		}
+
+		res := new(struct {
+			Data []struct {
+				Data *T `json:"data"`
+			} `json:"data"`
+		})
+		resp, err := c.Get(ctx, path, &pageParams{params: params, page: opts}, res)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		list := make([]*T, 0, len(res.Data))
+		for _, item := range res.Data {
+			list = append(list, item.Data)
+		}
+
+		return list, resp, nil
+	}
+}
+
+// pageParams combines the query parameters of a list request
+// with the pagination options of a page.
+type pageParams struct {
+	params ListOptionsProvider
+	page   model.ListOptions
+}
+
+// Values returns the url.Values representation of the page parameters.
+// It implements the ListOptionsProvider interface.
+func (p *pageParams) Values() (url.Values, bool) {
+	v := url.Values{}
+	if p.params != nil {
+		if params, ok := p.params.Values(); ok {
+			for key, values := range params {
+				v[key] = values
+			}
+		}
+	}
+
+	v.Del("limit")
+	v.Del("offset")
+	page, _ := p.page.Values()
+	for key, values := range page {
+		v[key] = values
+	}
+
+	return v, len(v) > 0
+}
+
+// Take returns an iterator over at most n items of seq.
+// If n is less than or equal to 0, all items are returned.
+func Take[T any](seq iter.Seq2[*T, error], n int) iter.Seq2[*T, error] {
+	if n <= 0 {
+		return seq
+	}
+
+	return func(yield func(*T, error) bool) {
+		count := 0
+		for item, err := range seq {
+			if !yield(item, err) || err != nil {
+				return
+			}
+			if count++; count >= n {
+				return
+			}
+		}
+	}
+}
+
+// Collect collects the items of seq into a slice.
+// It stops at the first error and returns the items collected so far.
+func Collect[T any](seq iter.Seq2[*T, error]) ([]*T, error) {
+	list := make([]*T, 0)
+	for item, err := range seq {
+		if err != nil {
+			return list, err
+		}
+		list = append(list, item)
+	}
+	return list, nil
+}
+
+// cloneOptions returns a shallow copy of the options or new options if nil.
+func cloneOptions[T any](opts *T) *T {
+	if opts == nil {
+		return new(T)
+	}
+	o := *opts
+	return &o
+}
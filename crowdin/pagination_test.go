@@ -0,0 +1,359 @@
+package crowdin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// listOf returns a list function serving a collection of `total` items.
+// Like the API, it returns at most 500 items per page.
+func listOf(total int, calls *[]model.ListOptions) ListFunc[int] {
+	return func(_ context.Context, opts model.ListOptions) ([]*int, *Response, error) {
+		*calls = append(*calls, opts)
+
+		limit := min(opts.Limit, maxPageLimit)
+		items := make([]*int, 0, limit)
+		for i := opts.Offset; i < total && len(items) < limit; i++ {
+			items = append(items, ToPtr(i))
+		}
+		return items, &Response{}, nil
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          model.ListOptions
+		total         int
+		expectedCalls []model.ListOptions
+		expectedItems int
+	}{
+		{
+			name:  "default limit",
+			total: 600,
+			expectedCalls: []model.ListOptions{
+				{Limit: 500},
+				{Limit: 500, Offset: 500},
+			},
+			expectedItems: 600,
+		},
+		{
+			name:  "limit above the maximum",
+			opts:  model.ListOptions{Limit: 1000},
+			total: 1200,
+			expectedCalls: []model.ListOptions{
+				{Limit: 500},
+				{Limit: 500, Offset: 500},
+				{Limit: 500, Offset: 1000},
+			},
+			expectedItems: 1200,
+		},
+		{
+			name:  "custom limit and offset",
+			opts:  model.ListOptions{Limit: 2, Offset: 1},
+			total: 5,
+			expectedCalls: []model.ListOptions{
+				{Limit: 2, Offset: 1},
+				{Limit: 2, Offset: 3},
+				{Limit: 2, Offset: 5},
+			},
+			expectedItems: 4,
+		},
+		{
+			name:          "empty collection",
+			opts:          model.ListOptions{Limit: 10},
+			total:         0,
+			expectedCalls: []model.ListOptions{{Limit: 10}},
+			expectedItems: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []model.ListOptions
+			items, err := Collect(Paginate(context.Background(), tt.opts, listOf(tt.total, &calls)))
+			require.NoError(t, err)
+
+			assert.Len(t, items, tt.expectedItems)
+			for i, item := range items {
+				assert.Equal(t, tt.opts.Offset+i, *item)
+			}
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
+
+func TestPaginate_Rewind(t *testing.T) {
+	var calls []model.ListOptions
+	seq := Paginate(context.Background(), model.ListOptions{Limit: 2}, listOf(3, &calls))
+
+	for range 2 {
+		items, err := Collect(seq)
+		require.NoError(t, err)
+		assert.Len(t, items, 3)
+	}
+	assert.Equal(t, []model.ListOptions{{Limit: 2}, {Limit: 2, Offset: 2}, {Limit: 2}, {Limit: 2, Offset: 2}}, calls)
+}
+
+func TestPaginate_Error(t *testing.T) {
+	list := func(_ context.Context, opts model.ListOptions) ([]*int, *Response, error) {
+		if opts.Offset > 0 {
+			return nil, nil, errors.New("server error")
+		}
+		return []*int{ToPtr(1), ToPtr(2)}, &Response{}, nil
+	}
+
+	items, err := Collect(Paginate(context.Background(), model.ListOptions{Limit: 2}, list))
+	require.EqualError(t, err, "server error")
+	assert.Len(t, items, 2)
+}
+
+func TestPaginate_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls []model.ListOptions
+	list := listOf(100, &calls)
+	count := 0
+	for _, err := range Paginate(ctx, model.ListOptions{Limit: 10}, list) {
+		if err != nil {
+			require.ErrorIs(t, err, context.Canceled)
+			break
+		}
+		if count++; count == 10 {
+			cancel()
+		}
+	}
+
+	assert.Equal(t, 10, count)
+	assert.Len(t, calls, 1)
+}
+
+func TestTake(t *testing.T) {
+	var calls []model.ListOptions
+	seq := Paginate(context.Background(), model.ListOptions{Limit: 10}, listOf(100, &calls))
+
+	items, err := Collect(Take(seq, 15))
+	require.NoError(t, err)
+	assert.Len(t, items, 15)
+	assert.Len(t, calls, 2, "no more pages should be fetched after the cap is reached")
+
+	calls = nil
+	items, err = Collect(Take(seq, 0))
+	require.NoError(t, err)
+	assert.Len(t, items, 100)
+}
+
+func TestSourceStringsService_All(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/projects/1/strings"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		assert.Equal(t, "main", r.URL.Query().Get("filter"))
+		assert.Equal(t, "2", r.URL.Query().Get("limit"))
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		switch offset {
+		case 0:
+			fmt.Fprint(w, `{"data": [{"data": {"id": 1}}, {"data": {"id": 2}}], "pagination": {"offset": 0, "limit": 2}}`)
+		case 2:
+			fmt.Fprint(w, `{"data": [{"data": {"id": 3}}], "pagination": {"offset": 2, "limit": 2}}`)
+		default:
+			t.Errorf("unexpected offset: %d", offset)
+		}
+	})
+
+	opts := &model.SourceStringsListOptions{
+		Filter:      "main",
+		ListOptions: model.ListOptions{Limit: 2},
+	}
+
+	var ids []int
+	for str, err := range client.SourceStrings.All(context.Background(), 1, opts) {
+		require.NoError(t, err)
+		ids = append(ids, str.ID)
+	}
+	assert.Equal(t, []int{1, 2, 3}, ids)
+	assert.Equal(t, 0, opts.Offset, "options should not be modified")
+}
+
+func TestStorageService_ListAll(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v2/storages?limit=500")
+
+		fmt.Fprint(w, `{"data": [{"data": {"id": 1}}, {"data": {"id": 2}}], "pagination": {"offset": 0, "limit": 500}}`)
+	})
+
+	storages, err := client.Storages.ListAll(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.Storage{{ID: 1}, {ID: 2}}, storages)
+}
+
+func TestGlossariesService_ListAllTerms_Error(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/glossaries/1/terms", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error": {"code": 404, "message": "Glossary Not Found"}}`)
+	})
+
+	terms, err := client.Glossaries.ListAllTerms(context.Background(), 1, nil)
+	require.EqualError(t, err, "404 Glossary Not Found")
+	assert.Empty(t, terms)
+}
+
+func TestPrefetch(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		inFlight int
+		maxLoad  int
+		offsets  []int
+	)
+	list := func(ctx context.Context, opts model.ListOptions) ([]*int, *Response, error) {
+		mu.Lock()
+		inFlight++
+		maxLoad = max(maxLoad, inFlight)
+		offsets = append(offsets, opts.Offset)
+		mu.Unlock()
+
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		// Later pages are returned sooner to check the order of items.
+		select {
+		case <-time.After(time.Duration(50-opts.Offset) * time.Millisecond):
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+
+		items := make([]*int, 0, opts.Limit)
+		for i := opts.Offset; i < 23 && len(items) < opts.Limit; i++ {
+			items = append(items, ToPtr(i))
+		}
+		return items, &Response{}, nil
+	}
+
+	items, err := Collect(Prefetch(context.Background(), model.ListOptions{Limit: 5}, 3, list))
+	require.NoError(t, err)
+
+	require.Len(t, items, 23)
+	for i, item := range items {
+		assert.Equal(t, i, *item)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 3, maxLoad)
+	assert.Subset(t, offsets, []int{0, 5, 10, 15, 20})
+	assert.Subset(t, []int{0, 5, 10, 15, 20, 25, 30}, offsets, "at most 2 pages are requested after the short page")
+}
+
+func TestPrefetch_LimitAboveMaximum(t *testing.T) {
+	var calls []model.ListOptions
+	items, err := Collect(Prefetch(context.Background(), model.ListOptions{Limit: 1000}, 1, listOf(1200, &calls)))
+	require.NoError(t, err)
+
+	assert.Len(t, items, 1200)
+	assert.Equal(t, []model.ListOptions{{Limit: 500}, {Limit: 500, Offset: 500}, {Limit: 500, Offset: 1000}}, calls)
+}
+
+func TestPrefetch_Error(t *testing.T) {
+	list := func(_ context.Context, opts model.ListOptions) ([]*int, *Response, error) {
+		if opts.Offset == 4 {
+			return nil, nil, errors.New("server error")
+		}
+		return []*int{ToPtr(opts.Offset), ToPtr(opts.Offset + 1)}, &Response{}, nil
+	}
+
+	items, err := Collect(Prefetch(context.Background(), model.ListOptions{Limit: 2}, 4, list))
+	require.EqualError(t, err, "server error")
+	assert.Equal(t, []*int{ToPtr(0), ToPtr(1), ToPtr(2), ToPtr(3)}, items)
+}
+
+func TestPrefetch_Break(t *testing.T) {
+	var canceled atomic.Int32
+	list := func(ctx context.Context, opts model.ListOptions) ([]*int, *Response, error) {
+		if opts.Offset > 0 {
+			<-ctx.Done()
+			canceled.Add(1)
+			return nil, nil, ctx.Err()
+		}
+		return []*int{ToPtr(0), ToPtr(1)}, &Response{}, nil
+	}
+
+	for item, err := range Prefetch(context.Background(), model.ListOptions{Limit: 2}, 3, list) {
+		require.NoError(t, err)
+		assert.Equal(t, 0, *item)
+		break
+	}
+
+	assert.Eventually(t, func() bool { return canceled.Load() == 2 }, time.Second, time.Millisecond)
+}
+
+func TestGetList(t *testing.T) {
+	client, mux, teardown := setupClient(WithRateLimit(1000, 2))
+	defer teardown()
+
+	const path = "/api/v2/projects/1/strings"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		switch r.URL.Query().Get("offset") {
+		case "":
+			testURL(t, r, path+"?filter=main&limit=2")
+			fmt.Fprint(w, `{"data": [{"data": {"id": 1}}, {"data": {"id": 2}}], "pagination": {"offset": 0, "limit": 2}}`)
+		case "2":
+			testURL(t, r, path+"?filter=main&limit=2&offset=2")
+			fmt.Fprint(w, `{"data": [{"data": {"id": 3}}], "pagination": {"offset": 2, "limit": 2}}`)
+		default:
+			fmt.Fprint(w, `{"data": [], "pagination": {"offset": 4, "limit": 2}}`)
+		}
+	})
+
+	opts := &model.SourceStringsListOptions{
+		Filter:      "main",
+		ListOptions: model.ListOptions{Limit: 25, Offset: 100},
+	}
+	list := GetList[model.SourceString](client, path, opts)
+
+	items, err := Collect(Prefetch(context.Background(), model.ListOptions{Limit: 2}, 3, list))
+	require.NoError(t, err)
+	assert.Equal(t, []*model.SourceString{{ID: 1}, {ID: 2}, {ID: 3}}, items)
+}
+
+func TestGetList_Error(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/projects/1/strings", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error": {"code": 403, "message": "Forbidden"}}`)
+	})
+
+	items, resp, err := GetList[model.SourceString](client, "/api/v2/projects/1/strings", nil)(
+		context.Background(), model.ListOptions{})
+	require.EqualError(t, err, "403 Forbidden")
+	assert.Nil(t, items)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -28,6 +29,7 @@ type ProjectsService struct {
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.getMany
 func (s *ProjectsService) List(ctx context.Context, opts *model.ProjectsListOptions) ([]*model.Project, *Response, error) {
+	ctx = WithOperationName(ctx, "Projects.List")
 	res := new(model.ProjectsListResponse)
 	resp, err := s.client.Get(ctx, "/api/v2/projects", opts, res)
 	if err != nil {
@@ -42,10 +44,27 @@ func (s *ProjectsService) List(ctx context.Context, opts *model.ProjectsListOpti
 	return projects, resp, nil
 }
 
+// All returns an iterator over all projects.
+// It fetches the pages with List until a page shorter than the limit is returned.
+func (s *ProjectsService) All(ctx context.Context, opts *model.ProjectsListOptions) iter.Seq2[*model.Project, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.Project, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.List(ctx, &pageOpts)
+	})
+}
+
+// ListAll returns all projects by fetching every page with List.
+func (s *ProjectsService) ListAll(ctx context.Context, opts *model.ProjectsListOptions) ([]*model.Project, error) {
+	return Collect(s.All(ctx, opts))
+}
+
 // Get returns a project by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.get
 func (s *ProjectsService) Get(ctx context.Context, id int) (*model.Project, *Response, error) {
+	ctx = WithOperationName(ctx, "Projects.Get")
 	res := new(model.ProjectsGetResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d", id), nil, res)
 
@@ -56,6 +75,7 @@ func (s *ProjectsService) Get(ctx context.Context, id int) (*model.Project, *Res
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.post
 func (s *ProjectsService) Add(ctx context.Context, req *model.ProjectsAddRequest) (*model.Project, *Response, error) {
+	ctx = WithOperationName(ctx, "Projects.Add")
 	res := new(model.ProjectsGetResponse)
 	resp, err := s.client.Post(ctx, "/api/v2/projects", req, res)
 
@@ -70,8 +90,11 @@ func (s *ProjectsService) Add(ctx context.Context, req *model.ProjectsAddRequest
 //	path: A JSON Pointer as defined in RFC 6901.
 //	value: The value to be used within the operations. The value must be one of string, integer, boolean and object
 //
+// Use model.ProjectPatch to build the request with the checked paths.
+//
 // https://developer.crowdin.com/api/v2/#operation/api.projects.patch
 func (s *ProjectsService) Edit(ctx context.Context, id int, req []*model.UpdateRequest) (*model.Project, *Response, error) {
+	ctx = WithOperationName(ctx, "Projects.Edit")
 	res := new(model.ProjectsGetResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d", id), req, res)
 
@@ -82,6 +105,7 @@ func (s *ProjectsService) Edit(ctx context.Context, id int, req []*model.UpdateR
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.delete
 func (s *ProjectsService) Delete(ctx context.Context, id int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Projects.Delete")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d", id))
 }
 
@@ -92,6 +116,7 @@ func (s *ProjectsService) Delete(ctx context.Context, id int) (*Response, error)
 func (s *ProjectsService) DownloadFileFormatSettingsCustomSegmentation(ctx context.Context, projectID, settingsID int) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Projects.DownloadFileFormatSettingsCustomSegmentation")
 	path := fmt.Sprintf("/api/v2/projects/%d/file-format-settings/%d/custom-segmentations", projectID, settingsID)
 	res := new(model.DownloadLinkResponse)
 	resp, err := s.client.Get(ctx, path, nil, res)
@@ -104,6 +129,7 @@ func (s *ProjectsService) DownloadFileFormatSettingsCustomSegmentation(ctx conte
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.file-format-settings.custom-segmentations.delete
 func (s *ProjectsService) ResetFileFormatSettingsCustomSegmentation(ctx context.Context, projectID, settingsID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Projects.ResetFileFormatSettingsCustomSegmentation")
 	path := fmt.Sprintf("/api/v2/projects/%d/file-format-settings/%d/custom-segmentations", projectID, settingsID)
 	return s.client.Delete(ctx, path)
 }
@@ -114,6 +140,7 @@ func (s *ProjectsService) ResetFileFormatSettingsCustomSegmentation(ctx context.
 func (s *ProjectsService) ListFileFormatSettings(ctx context.Context, projectID int) (
 	[]*model.ProjectsFileFormatSettings, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Projects.ListFileFormatSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/file-format-settings", projectID)
 	res := new(model.ProjectsFileFormatSettingsListResponse)
 	resp, err := s.client.Get(ctx, path, nil, res)
@@ -135,6 +162,7 @@ func (s *ProjectsService) ListFileFormatSettings(ctx context.Context, projectID
 func (s *ProjectsService) GetFileFormatSettings(ctx context.Context, projectID, settingsID int) (
 	*model.ProjectsFileFormatSettings, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Projects.GetFileFormatSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/file-format-settings/%d", projectID, settingsID)
 	res := new(model.ProjectsFileFormatSettingsResponse)
 	resp, err := s.client.Get(ctx, path, nil, res)
@@ -148,6 +176,7 @@ func (s *ProjectsService) GetFileFormatSettings(ctx context.Context, projectID,
 func (s *ProjectsService) AddFileFormatSettings(ctx context.Context, projectID int, req *model.ProjectsAddFileFormatSettingsRequest) (
 	*model.ProjectsFileFormatSettings, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Projects.AddFileFormatSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/file-format-settings", projectID)
 	res := new(model.ProjectsFileFormatSettingsResponse)
 	resp, err := s.client.Post(ctx, path, req, res)
@@ -167,6 +196,7 @@ func (s *ProjectsService) AddFileFormatSettings(ctx context.Context, projectID i
 func (s *ProjectsService) EditFileFormatSettings(ctx context.Context, projectID, settingsID int, req []*model.UpdateRequest) (
 	*model.ProjectsFileFormatSettings, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Projects.EditFileFormatSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/file-format-settings/%d", projectID, settingsID)
 	res := new(model.ProjectsFileFormatSettingsResponse)
 	resp, err := s.client.Patch(ctx, path, req, res)
@@ -178,6 +208,7 @@ func (s *ProjectsService) EditFileFormatSettings(ctx context.Context, projectID,
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.file-format-settings.delete
 func (s *ProjectsService) DeleteFileFormatSettings(ctx context.Context, projectID, settingsID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Projects.DeleteFileFormatSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/file-format-settings/%d", projectID, settingsID)
 	return s.client.Delete(ctx, path)
 }
@@ -188,6 +219,7 @@ func (s *ProjectsService) DeleteFileFormatSettings(ctx context.Context, projectI
 func (s *ProjectsService) ListStringsExporterSettings(ctx context.Context, projectID int) (
 	[]*model.ProjectsStringsExporterSettings, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Projects.ListStringsExporterSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/strings-exporter-settings", projectID)
 	res := new(model.ProjectsStringsExporterSettingsListResponse)
 	resp, err := s.client.Get(ctx, path, nil, res)
@@ -210,6 +242,7 @@ func (s *ProjectsService) ListStringsExporterSettings(ctx context.Context, proje
 func (s *ProjectsService) GetStringsExporterSettings(ctx context.Context, projectID, settingsID int) (
 	*model.ProjectsStringsExporterSettings, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Projects.GetStringsExporterSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/strings-exporter-settings/%d", projectID, settingsID)
 	res := new(model.ProjectsStringsExporterSettingsResponse)
 	resp, err := s.client.Get(ctx, path, nil, res)
@@ -231,6 +264,7 @@ func (s *ProjectsService) AddStringsExporterSettings(
 	req *model.ProjectsStringsExporterSettingsRequest,
 ) (*model.ProjectsStringsExporterSettings, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Projects.AddStringsExporterSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/strings-exporter-settings", projectID)
 	res := new(model.ProjectsStringsExporterSettingsResponse)
 	resp, err := s.client.Post(ctx, path, req, res)
@@ -253,6 +287,7 @@ func (s *ProjectsService) EditStringsExporterSettings(
 	req *model.ProjectsStringsExporterSettingsRequest,
 ) (*model.ProjectsStringsExporterSettings, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Projects.EditStringsExporterSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/strings-exporter-settings/%d", projectID, settingsID)
 	res := new(model.ProjectsStringsExporterSettingsResponse)
 	resp, err := s.client.Patch(ctx, path, req, res)
@@ -265,6 +300,7 @@ func (s *ProjectsService) EditStringsExporterSettings(
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.strings-exporter-settings.delete
 func (s *ProjectsService) DeleteStringsExporterSettings(ctx context.Context, projectID, settingsID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Projects.DeleteStringsExporterSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/strings-exporter-settings/%d", projectID, settingsID)
 	return s.client.Delete(ctx, path)
 }
@@ -235,9 +235,9 @@ func TestProjectsService_Get(t *testing.T) {
 		Visibility:           "private",
 		Logo:                 "data:image/png;base64,iVBORw0KGg",
 		PublicDownloads:      true,
-		CreatedAt:            "2023-09-20T11:34:40+00:00",
-		UpdatedAt:            "2023-09-20T11:34:40+00:00",
-		LastActivity:         "2023-09-20T11:34:40+00:00",
+		CreatedAt:            testTime("2023-09-20T11:34:40+00:00"),
+		UpdatedAt:            testTime("2023-09-20T11:34:40+00:00"),
+		LastActivity:         testTime("2023-09-20T11:34:40+00:00"),
 		SourceLanguage: &model.Language{
 			ID:                  "es",
 			Name:                "Spanish",
@@ -627,9 +627,9 @@ func TestProjectsService_Get_Enterprise(t *testing.T) {
 		WorkflowID:        3,
 		HasCrowdsourcing:  false,
 		PublicDownloads:   true,
-		CreatedAt:         "2023-09-20T11:34:40+00:00",
-		UpdatedAt:         "2023-09-20T11:34:40+00:00",
-		LastActivity:      "2023-09-20T11:34:40+00:00",
+		CreatedAt:         testTime("2023-09-20T11:34:40+00:00"),
+		UpdatedAt:         testTime("2023-09-20T11:34:40+00:00"),
+		LastActivity:      testTime("2023-09-20T11:34:40+00:00"),
 		SourceLanguage: &model.Language{
 			ID:                  "es",
 			Name:                "Spanish",
@@ -1289,7 +1289,7 @@ func TestProjectsService_DownloadFileFormatSettingsCustomSegmentation(t *testing
 
 	expectedDownloadLink := &model.DownloadLink{
 		URL:      "https://production-enterprise-importer.downloads.crowdin.com/992000002/2/14.xliff?response-content-disposition=attachment%3B20filename%3D%22APP.xliff",
-		ExpireIn: "2023-09-20T10:31:21+00:00",
+		ExpireIn: testTime("2023-09-20T10:31:21+00:00"),
 	}
 	assert.Equal(t, expectedDownloadLink, downloadLink)
 	assert.NotNil(t, resp)
@@ -1360,8 +1360,8 @@ func TestProjectsService_ListFileFormatSettings(t *testing.T) {
 				"escapeQuotes":            float64(1),
 				"escapeSpecialCharacters": float64(1),
 			},
-			CreatedAt: "2023-09-19T15:10:43+00:00",
-			UpdatedAt: "2023-09-19T15:10:46+00:00",
+			CreatedAt: testTime("2023-09-19T15:10:43+00:00"),
+			UpdatedAt: testTime("2023-09-19T15:10:46+00:00"),
 		},
 	}
 	assert.Equal(t, expectedSettings, settings)
@@ -1413,8 +1413,8 @@ func TestProjectsService_GetFileFormatSettings(t *testing.T) {
 			"escapeQuotes":            float64(1),
 			"escapeSpecialCharacters": float64(1),
 		},
-		CreatedAt: "2023-09-19T15:10:43+00:00",
-		UpdatedAt: "2023-09-19T15:10:46+00:00",
+		CreatedAt: testTime("2023-09-19T15:10:43+00:00"),
+		UpdatedAt: testTime("2023-09-19T15:10:46+00:00"),
 	}
 	assert.Equal(t, expectedFileFormatSettings, fileFormatSettings)
 }
@@ -1471,8 +1471,8 @@ func TestProjectsService_AddFileFormatSettings(t *testing.T) {
 			"escapeQuotes":            float64(1),
 			"escapeSpecialCharacters": float64(1),
 		},
-		CreatedAt: "2023-09-19T15:10:43+00:00",
-		UpdatedAt: "2023-09-19T15:10:46+00:00",
+		CreatedAt: testTime("2023-09-19T15:10:43+00:00"),
+		UpdatedAt: testTime("2023-09-19T15:10:46+00:00"),
 	}
 	assert.Equal(t, expectedFileFormatSettings, fileFormatSettings)
 }
@@ -1683,8 +1683,8 @@ func TestProjectsService_EditFileFormatSettings(t *testing.T) {
 			"escapeQuotes":            float64(1),
 			"escapeSpecialCharacters": float64(1),
 		},
-		CreatedAt: "2023-09-19T15:10:43+00:00",
-		UpdatedAt: "2023-09-19T15:10:46+00:00",
+		CreatedAt: testTime("2023-09-19T15:10:43+00:00"),
+		UpdatedAt: testTime("2023-09-19T15:10:46+00:00"),
 	}
 	assert.Equal(t, expectedFileFormatSettings, fileFormatSettings)
 }
@@ -1749,8 +1749,8 @@ func TestProjectsService_ListStringsExporterSettings(t *testing.T) {
 			Settings: model.StringsExporterSettings{
 				ConvertPlaceholders: ToPtr(false),
 			},
-			CreatedAt: "2023-09-19T15:10:43+00:00",
-			UpdatedAt: "2023-09-19T15:10:46+00:00",
+			CreatedAt: testTime("2023-09-19T15:10:43+00:00"),
+			UpdatedAt: testTime("2023-09-19T15:10:46+00:00"),
 		},
 	}
 	assert.Equal(t, expectedSettings, settings)
@@ -1792,8 +1792,8 @@ func TestProjectsService_GetStringsExporterSettings(t *testing.T) {
 		Settings: model.StringsExporterSettings{
 			ConvertPlaceholders: ToPtr(false),
 		},
-		CreatedAt: "2023-09-19T15:10:43+00:00",
-		UpdatedAt: "2023-09-19T15:10:46+00:00",
+		CreatedAt: testTime("2023-09-19T15:10:43+00:00"),
+		UpdatedAt: testTime("2023-09-19T15:10:46+00:00"),
 	}
 	assert.Equal(t, expectedSettings, settings)
 	assert.Nil(t, expectedSettings.Settings.LanguagePairMapping)
@@ -1840,8 +1840,8 @@ func TestProjectsService_AddStringsExporterSettings(t *testing.T) {
 		Settings: model.StringsExporterSettings{
 			ConvertPlaceholders: ToPtr(false),
 		},
-		CreatedAt: "2023-09-19T15:10:43+00:00",
-		UpdatedAt: "2023-09-19T15:10:46+00:00",
+		CreatedAt: testTime("2023-09-19T15:10:43+00:00"),
+		UpdatedAt: testTime("2023-09-19T15:10:46+00:00"),
 	}
 	assert.Equal(t, expectedSettings, settings)
 	assert.Nil(t, expectedSettings.Settings.LanguagePairMapping)
@@ -2013,8 +2013,8 @@ func TestProjectsService_EditStringsExporterSettings(t *testing.T) {
 				"de": "en",
 			},
 		},
-		CreatedAt: "2023-09-19T15:10:43+00:00",
-		UpdatedAt: "2023-09-19T15:10:46+00:00",
+		CreatedAt: testTime("2023-09-19T15:10:43+00:00"),
+		UpdatedAt: testTime("2023-09-19T15:10:46+00:00"),
 	}
 	assert.Equal(t, expectedSettings, settings)
 	assert.Nil(t, expectedSettings.Settings.ConvertPlaceholders)
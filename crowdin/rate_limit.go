@@ -0,0 +1,134 @@
+package crowdin
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// rateLimitDecrease is the factor the rate is multiplied by
+	// when the API responds with 429 Too Many Requests.
+	rateLimitDecrease = 0.5
+	// rateLimitRecovery is the share of the configured rate which is
+	// restored after every successful response.
+	rateLimitRecovery = 0.05
+	// rateLimitFloor is the minimum share of the configured rate
+	// the limiter can adapt down to.
+	rateLimitFloor = 0.05
+)
+
+// WithRateLimit limits the rate of requests sent by the client to `rps` requests
+// per second with bursts of at most `burst` requests. The limit is shared by
+// all services of the client.
+//
+// Requests wait for the limiter until the context is done. If the wait would
+// exceed the context deadline, the request fails immediately.
+// When the API responds with 429 Too Many Requests, the rate is reduced and
+// gradually restored with the following successful responses.
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return func(c *Client) error {
+		if rps <= 0 || math.IsInf(rps, 0) || math.IsNaN(rps) {
+			return errors.New("rate limit: rps must be a positive number")
+		}
+		if burst < 1 {
+			return errors.New("rate limit: burst must be at least 1")
+		}
+
+		c.rateLimiter = newRateLimiter(rps, burst)
+		return nil
+	}
+}
+
+// rateLimiter is a token bucket limiter which adapts its rate
+// to the rate limit responses of the API.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	rate    float64 // current rate in tokens per second
+	maxRate float64 // configured rate
+	burst   float64
+	tokens  float64
+	last    time.Time
+
+	now func() time.Time
+}
+
+// newRateLimiter creates a new rate limiter with a full bucket.
+func newRateLimiter(rps float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:    rps,
+		maxRate: rps,
+		burst:   float64(burst),
+		tokens:  float64(burst),
+		now:     time.Now,
+	}
+}
+
+// wait blocks until a token is available or the context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := l.now()
+	l.advance(now)
+	l.tokens--
+
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	if deadline, ok := ctx.Deadline(); ok && now.Add(delay).After(deadline) {
+		l.tokens++
+		l.mu.Unlock()
+		return errors.New("rate limit: waiting for a request slot would exceed the context deadline")
+	}
+	l.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+	if err := sleep(ctx, delay); err != nil {
+		// Return the unused token.
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// update adapts the rate to the response status code.
+func (l *rateLimiter) update(resp *Response) {
+	if l == nil || resp == nil || resp.Response == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance(l.now())
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		l.rate = max(l.rate*rateLimitDecrease, l.maxRate*rateLimitFloor)
+		l.tokens = min(l.tokens, 0)
+	case resp.StatusCode < http.StatusBadRequest && l.rate < l.maxRate:
+		l.rate = min(l.rate+l.maxRate*rateLimitRecovery, l.maxRate)
+	}
+}
+
+// advance refills the bucket according to the time elapsed since the last call.
+func (l *rateLimiter) advance(now time.Time) {
+	if !l.last.IsZero() {
+		if elapsed := now.Sub(l.last).Seconds(); elapsed > 0 {
+			l.tokens = min(l.tokens+elapsed*l.rate, l.burst)
+		}
+	}
+	l.last = now
+}
@@ -0,0 +1,148 @@
+package crowdin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRateLimit(t *testing.T) {
+	tests := []struct {
+		rps         float64
+		burst       int
+		expectedErr string
+	}{
+		{
+			rps:         0,
+			burst:       1,
+			expectedErr: "rate limit: rps must be a positive number",
+		},
+		{
+			rps:         -1,
+			burst:       1,
+			expectedErr: "rate limit: rps must be a positive number",
+		},
+		{
+			rps:         10,
+			burst:       0,
+			expectedErr: "rate limit: burst must be at least 1",
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := NewClient("token", WithRateLimit(tt.rps, tt.burst))
+		assert.EqualError(t, err, tt.expectedErr)
+	}
+
+	c, err := NewClient("token", WithRateLimit(10, 5))
+	require.NoError(t, err)
+	assert.Equal(t, 10.0, c.rateLimiter.rate)
+	assert.Equal(t, 5.0, c.rateLimiter.burst)
+}
+
+func TestRateLimiter_Wait(t *testing.T) {
+	l := newRateLimiter(50, 2)
+	ctx := context.Background()
+
+	start := time.Now()
+	for range 4 {
+		require.NoError(t, l.wait(ctx))
+	}
+
+	// Two requests are sent immediately, the other two wait for 20ms each.
+	assert.GreaterOrEqual(t, time.Since(start), 35*time.Millisecond)
+}
+
+func TestRateLimiter_Wait_Deadline(t *testing.T) {
+	l := newRateLimiter(1, 1)
+	require.NoError(t, l.wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := l.wait(ctx)
+	require.EqualError(t, err, "rate limit: waiting for a request slot would exceed the context deadline")
+	assert.Less(t, time.Since(start), 10*time.Millisecond)
+
+	// The token is returned to the bucket.
+	assert.InDelta(t, 0, l.tokens, 0.1)
+}
+
+func TestRateLimiter_Wait_Canceled(t *testing.T) {
+	l := newRateLimiter(1, 1)
+	require.NoError(t, l.wait(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	assert.ErrorIs(t, l.wait(ctx), context.Canceled)
+}
+
+func TestRateLimiter_Update(t *testing.T) {
+	now := time.Now()
+	l := newRateLimiter(10, 10)
+	l.now = func() time.Time { return now }
+
+	tooManyRequests := &Response{Response: &http.Response{StatusCode: http.StatusTooManyRequests}}
+	ok := &Response{Response: &http.Response{StatusCode: http.StatusOK}}
+
+	l.update(tooManyRequests)
+	assert.Equal(t, 5.0, l.rate)
+	assert.Equal(t, 0.0, l.tokens)
+
+	l.update(tooManyRequests)
+	assert.Equal(t, 2.5, l.rate)
+
+	for range 10 {
+		l.update(tooManyRequests)
+	}
+	assert.Equal(t, 0.5, l.rate, "rate should not go below the floor")
+
+	l.update(ok)
+	assert.Equal(t, 1.0, l.rate)
+
+	for range 100 {
+		l.update(ok)
+	}
+	assert.Equal(t, 10.0, l.rate, "rate should not exceed the configured value")
+
+	// Tokens are refilled with the current rate.
+	now = now.Add(500 * time.Millisecond)
+	l.advance(now)
+	assert.Equal(t, 5.0, l.tokens)
+}
+
+func TestClient_RateLimit(t *testing.T) {
+	client, mux, teardown := setupClient(WithRateLimit(100, 1))
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, _ *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"error": {"code": 429, "message": "Too Many Requests"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"id": 1}}`)
+	})
+
+	_, _, err := client.Storages.Get(context.Background(), 1)
+	require.Error(t, err)
+	assert.Equal(t, 50.0, client.rateLimiter.rate)
+
+	start := time.Now()
+	_, _, err = client.Storages.Get(context.Background(), 1)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 15*time.Millisecond)
+	assert.Equal(t, 55.0, client.rateLimiter.rate)
+}
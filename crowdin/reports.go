@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -26,6 +27,7 @@ type ReportsService struct {
 func (s *ReportsService) ListArchives(ctx context.Context, userID int, opts *model.ReportArchivesListOptions) (
 	[]*model.ReportArchive, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Reports.ListArchives")
 	res := new(model.ReportArchiveListResponse)
 	resp, err := s.client.Get(ctx, s.getArchivePath("archives", userID), opts, res)
 	if err != nil {
@@ -40,12 +42,33 @@ func (s *ReportsService) ListArchives(ctx context.Context, userID int, opts *mod
 	return list, resp, err
 }
 
+// AllArchives returns an iterator over all report archives.
+// It fetches the pages with ListArchives until a page shorter than the limit is returned.
+func (s *ReportsService) AllArchives(
+	ctx context.Context, userID int, opts *model.ReportArchivesListOptions,
+) iter.Seq2[*model.ReportArchive, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.ReportArchive, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListArchives(ctx, userID, &pageOpts)
+	})
+}
+
+// ListAllArchives returns all report archives by fetching every page with ListArchives.
+func (s *ReportsService) ListAllArchives(ctx context.Context, userID int, opts *model.ReportArchivesListOptions) (
+	[]*model.ReportArchive, error,
+) {
+	return Collect(s.AllArchives(ctx, userID, opts))
+}
+
 // GetArchive returns a report archive bu its identifier.
 //
 //	For the Enterprise client, set the userID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.users.reports.archives.get
 func (s *ReportsService) GetArchive(ctx context.Context, userID, archiveID int) (*model.ReportArchive, *Response, error) {
+	ctx = WithOperationName(ctx, "Reports.GetArchive")
 	path := s.getArchivePath(fmt.Sprintf("archives/%d", archiveID), userID)
 	res := new(model.ReportArchiveResponse)
 	resp, err := s.client.Get(ctx, path, nil, res)
@@ -59,6 +82,7 @@ func (s *ReportsService) GetArchive(ctx context.Context, userID, archiveID int)
 //
 // https://developer.crowdin.com/api/v2/#operation/api.users.reports.archives.delete
 func (s *ReportsService) DeleteArchive(ctx context.Context, userID, archiveID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Reports.DeleteArchive")
 	return s.client.Delete(ctx, s.getArchivePath(fmt.Sprintf("archives/%d", archiveID), userID))
 }
 
@@ -71,6 +95,7 @@ func (s *ReportsService) DeleteArchive(ctx context.Context, userID, archiveID in
 func (s *ReportsService) ExportArchive(ctx context.Context, userID, archiveID int, req *model.ExportReportArchiveRequest) (
 	*model.ReportStatus, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Reports.ExportArchive")
 	if req == nil || req.Format == "" {
 		req = &model.ExportReportArchiveRequest{Format: model.ReportFormatXLSX}
 	}
@@ -90,6 +115,7 @@ func (s *ReportsService) ExportArchive(ctx context.Context, userID, archiveID in
 func (s *ReportsService) CheckArchiveExportStatus(ctx context.Context, userID, archiveID int, exportID string) (
 	*model.ReportStatus, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Reports.CheckArchiveExportStatus")
 	path := s.getArchivePath(fmt.Sprintf("archives/%d/exports/%s", archiveID, exportID), userID)
 	res := new(model.ReportStatusResponse)
 	resp, err := s.client.Get(ctx, path, nil, res)
@@ -105,6 +131,7 @@ func (s *ReportsService) CheckArchiveExportStatus(ctx context.Context, userID, a
 func (s *ReportsService) DownloadArchive(ctx context.Context, userID, archiveID int, exportID string) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Reports.DownloadArchive")
 	path := s.getArchivePath(fmt.Sprintf("archives/%d/exports/%s/download", archiveID, exportID), userID)
 	res := new(model.DownloadLinkResponse)
 	resp, err := s.client.Get(ctx, path, nil, res)
@@ -118,6 +145,7 @@ func (s *ReportsService) DownloadArchive(ctx context.Context, userID, archiveID
 func (s *ReportsService) Generate(ctx context.Context, projectID int, req *model.ReportGenerateRequest) (
 	*model.ReportStatus, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Reports.Generate")
 	res := new(model.ReportStatusResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/reports", projectID), req, res)
 
@@ -130,18 +158,41 @@ func (s *ReportsService) Generate(ctx context.Context, projectID int, req *model
 func (s *ReportsService) CheckStatus(ctx context.Context, projectID int, reportID string) (
 	*model.ReportStatus, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Reports.CheckStatus")
 	res := new(model.ReportStatusResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/reports/%s", projectID, reportID), nil, res)
 
 	return res.Data, resp, err
 }
 
+// GenerateAndWait generates a project report and waits until the report is ready.
+// Use Download with the returned identifier to get the download link.
+func (s *ReportsService) GenerateAndWait(
+	ctx context.Context,
+	projectID int,
+	req *model.ReportGenerateRequest,
+	opts ...WaitOption,
+) (*model.ReportStatus, error) {
+	return startAndWait(ctx,
+		func() (*model.ReportStatus, *Response, error) {
+			return s.Generate(ctx, projectID, req)
+		},
+		func(r *model.ReportStatus) PollFunc[*model.ReportStatus] {
+			return func(ctx context.Context) (*model.ReportStatus, *Response, error) {
+				return s.CheckStatus(ctx, projectID, r.Identifier)
+			}
+		},
+		opts,
+	)
+}
+
 // Download returns a download link for the report.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.reports.download.download
 func (s *ReportsService) Download(ctx context.Context, projectID int, reportID string) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Reports.Download")
 	res := new(model.DownloadLinkResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/reports/%s/download", projectID, reportID), nil, res)
 
@@ -156,6 +207,7 @@ func (s *ReportsService) Download(ctx context.Context, projectID int, reportID s
 func (s *ReportsService) ListSettingsTemplates(ctx context.Context, projectID int, opts *model.ReportSettingsTemplatesListOptions) (
 	[]*model.ReportSettingsTemplate, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Reports.ListSettingsTemplates")
 	res := new(model.ReportSettingsTemplateListResponse)
 	resp, err := s.client.Get(ctx, s.getSettingsTemplatePath(projectID, 0), opts, res)
 	if err != nil {
@@ -170,6 +222,26 @@ func (s *ReportsService) ListSettingsTemplates(ctx context.Context, projectID in
 	return list, resp, err
 }
 
+// AllSettingsTemplates returns an iterator over all report settings templates.
+// It fetches the pages with ListSettingsTemplates until a page shorter than the limit is returned.
+func (s *ReportsService) AllSettingsTemplates(
+	ctx context.Context, projectID int, opts *model.ReportSettingsTemplatesListOptions,
+) iter.Seq2[*model.ReportSettingsTemplate, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.ReportSettingsTemplate, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListSettingsTemplates(ctx, projectID, &pageOpts)
+	})
+}
+
+// ListAllSettingsTemplates returns all report settings templates by fetching every page with ListSettingsTemplates.
+func (s *ReportsService) ListAllSettingsTemplates(ctx context.Context, projectID int, opts *model.ReportSettingsTemplatesListOptions) (
+	[]*model.ReportSettingsTemplate, error,
+) {
+	return Collect(s.AllSettingsTemplates(ctx, projectID, opts))
+}
+
 // GetSettingsTemplate returns a report settings template by its identifier.
 //
 //	For the Enterprise client, set the projectID to 0.
@@ -178,6 +250,7 @@ func (s *ReportsService) ListSettingsTemplates(ctx context.Context, projectID in
 func (s *ReportsService) GetSettingsTemplate(ctx context.Context, projectID, settingsTemplateID int) (
 	*model.ReportSettingsTemplate, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Reports.GetSettingsTemplate")
 	res := new(model.ReportSettingsTemplateResponse)
 	resp, err := s.client.Get(ctx, s.getSettingsTemplatePath(projectID, settingsTemplateID), nil, res)
 
@@ -192,6 +265,7 @@ func (s *ReportsService) GetSettingsTemplate(ctx context.Context, projectID, set
 func (s *ReportsService) AddSettingsTemplate(ctx context.Context, projectID int, req *model.ReportSettingsTemplateAddRequest) (
 	*model.ReportSettingsTemplate, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Reports.AddSettingsTemplate")
 	res := new(model.ReportSettingsTemplateResponse)
 	resp, err := s.client.Post(ctx, s.getSettingsTemplatePath(projectID, 0), req, res)
 
@@ -212,6 +286,7 @@ func (s *ReportsService) AddSettingsTemplate(ctx context.Context, projectID int,
 func (s *ReportsService) EditSettingsTemplate(ctx context.Context, projectID, settingsTemplateID int, req []*model.UpdateRequest) (
 	*model.ReportSettingsTemplate, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Reports.EditSettingsTemplate")
 	res := new(model.ReportSettingsTemplateResponse)
 	resp, err := s.client.Patch(ctx, s.getSettingsTemplatePath(projectID, settingsTemplateID), req, res)
 
@@ -224,6 +299,7 @@ func (s *ReportsService) EditSettingsTemplate(ctx context.Context, projectID, se
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.reports.settings-templates.delete
 func (s *ReportsService) DeleteSettingsTemplate(ctx context.Context, projectID, settingsTemplateID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Reports.DeleteSettingsTemplate")
 	return s.client.Delete(ctx, s.getSettingsTemplatePath(projectID, settingsTemplateID))
 }
 
@@ -233,6 +309,7 @@ func (s *ReportsService) DeleteSettingsTemplate(ctx context.Context, projectID,
 func (s *ReportsService) GenerateGroupReport(ctx context.Context, groupID int, req *model.GroupReportGenerateRequest) (
 	*model.ReportStatus, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Reports.GenerateGroupReport")
 	res := new(model.ReportStatusResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/groups/%d/reports", groupID), req, res)
 
@@ -245,6 +322,7 @@ func (s *ReportsService) GenerateGroupReport(ctx context.Context, groupID int, r
 func (s *ReportsService) CheckGroupReportStatus(ctx context.Context, groupID int, reportID string) (
 	*model.ReportStatus, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Reports.CheckGroupReportStatus")
 	res := new(model.ReportStatusResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/groups/%d/reports/%s", groupID, reportID), nil, res)
 
@@ -257,6 +335,7 @@ func (s *ReportsService) CheckGroupReportStatus(ctx context.Context, groupID int
 func (s *ReportsService) DownloadGroupReport(ctx context.Context, groupID int, reportID string) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Reports.DownloadGroupReport")
 	res := new(model.DownloadLinkResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/groups/%d/reports/%s/download", groupID, reportID), nil, res)
 
@@ -269,6 +348,7 @@ func (s *ReportsService) DownloadGroupReport(ctx context.Context, groupID int, r
 func (s *ReportsService) GenerateOrganizationReport(ctx context.Context, req *model.GroupReportGenerateRequest) (
 	*model.ReportStatus, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Reports.GenerateOrganizationReport")
 	res := new(model.ReportStatusResponse)
 	resp, err := s.client.Post(ctx, "/api/v2/reports", req, res)
 
@@ -279,6 +359,7 @@ func (s *ReportsService) GenerateOrganizationReport(ctx context.Context, req *mo
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.reports.get
 func (s *ReportsService) CheckOrganizationReportStatus(ctx context.Context, reportID string) (*model.ReportStatus, *Response, error) {
+	ctx = WithOperationName(ctx, "Reports.CheckOrganizationReportStatus")
 	res := new(model.ReportStatusResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/reports/%s", reportID), nil, res)
 
@@ -289,6 +370,7 @@ func (s *ReportsService) CheckOrganizationReportStatus(ctx context.Context, repo
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.reports.download.download
 func (s *ReportsService) DownloadOrganizationReport(ctx context.Context, reportID string) (*model.DownloadLink, *Response, error) {
+	ctx = WithOperationName(ctx, "Reports.DownloadOrganizationReport")
 	res := new(model.DownloadLinkResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/reports/%s/download", reportID), nil, res)
 
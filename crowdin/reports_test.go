@@ -46,7 +46,7 @@ func TestReportsService_GetArchive(t *testing.T) {
 		Name:      "string",
 		WebURL:    "https://crowdin.com/project/project-identifier/reports/archive/1",
 		Scheme:    map[string]any{},
-		CreatedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	assert.Equal(t, expected, archive)
 
@@ -133,7 +133,7 @@ func TestReportsService_ListArchive(t *testing.T) {
 					Name:      "string",
 					WebURL:    "https://crowdin.com/project/project-identifier/reports/archive/1",
 					Scheme:    map[string]any{},
-					CreatedAt: "2023-09-23T11:26:54+00:00",
+					CreatedAt: testTime("2023-09-23T11:26:54+00:00"),
 				},
 			}
 			assert.Equal(t, expected, archives)
@@ -231,10 +231,10 @@ func TestReportsService_ExportArchive(t *testing.T) {
 					ReportName: "costs-estimation",
 					Schema:     map[string]any{},
 				},
-				CreatedAt:  "2023-09-23T11:26:54+00:00",
-				UpdatedAt:  "2023-09-23T11:26:54+00:00",
-				StartedAt:  "2023-09-23T11:26:54+00:00",
-				FinishedAt: "2023-09-23T11:26:54+00:00",
+				CreatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+				UpdatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+				StartedAt:  testTime("2023-09-23T11:26:54+00:00"),
+				FinishedAt: testTime("2023-09-23T11:26:54+00:00"),
 			}
 			assert.Equal(t, expected, status)
 		})
@@ -282,10 +282,10 @@ func TestReportsService_CheckArchiveExportStatus(t *testing.T) {
 			ReportName: "costs-estimation",
 			Schema:     map[string]any{},
 		},
-		CreatedAt:  "2023-09-23T11:26:54+00:00",
-		UpdatedAt:  "2023-09-23T11:26:54+00:00",
-		StartedAt:  "2023-09-23T11:26:54+00:00",
-		FinishedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		StartedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		FinishedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	assert.Equal(t, expected, status)
 
@@ -330,7 +330,7 @@ func TestReportsService_DownloadArchive(t *testing.T) {
 
 	expected := &model.DownloadLink{
 		URL:      "https://production-enterprise-importer.downloads.crowdin.com/992000002/2/14.xliff",
-		ExpireIn: "2023-09-20T10:31:21+00:00",
+		ExpireIn: testTime("2023-09-20T10:31:21+00:00"),
 	}
 	assert.Equal(t, expected, downloadLink)
 
@@ -388,8 +388,8 @@ func TestReportsService_Generate(t *testing.T) {
 			Unit:       model.ReportUnitWords,
 			LanguageID: "uk",
 			Format:     model.ReportFormatXLSX,
-			DateFrom:   "2023-09-23T07:00:14+00:00",
-			DateTo:     "2023-09-27T07:00:14+00:00",
+			DateFrom:   ToPtr(testTime("2023-09-23T07:00:14+00:00")),
+			DateTo:     ToPtr(testTime("2023-09-27T07:00:14+00:00")),
 		},
 	}
 	status, resp, err := client.Reports.Generate(context.Background(), 1, req)
@@ -405,10 +405,10 @@ func TestReportsService_Generate(t *testing.T) {
 			ReportName: "top-members",
 			Schema:     map[string]any{},
 		},
-		CreatedAt:  "2023-09-23T11:26:54+00:00",
-		UpdatedAt:  "2023-09-23T11:26:54+00:00",
-		StartedAt:  "2023-09-23T11:26:54+00:00",
-		FinishedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		StartedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		FinishedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	assert.Equal(t, excepted, status)
 }
@@ -505,10 +505,10 @@ func TestReportsService_CheckStatus(t *testing.T) {
 			ReportName: "costs-estimation",
 			Schema:     map[string]any{},
 		},
-		CreatedAt:  "2023-09-23T11:26:54+00:00",
-		UpdatedAt:  "2023-09-23T11:26:54+00:00",
-		StartedAt:  "2023-09-23T11:26:54+00:00",
-		FinishedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		StartedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		FinishedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	assert.Equal(t, expected, status)
 }
@@ -536,7 +536,7 @@ func TestReportsService_Download(t *testing.T) {
 
 	expected := &model.DownloadLink{
 		URL:      "https://production-enterprise-importer.downloads.crowdin.com/992000002/2/14.xliff",
-		ExpireIn: "2023-09-20T10:31:21+00:00",
+		ExpireIn: testTime("2023-09-20T10:31:21+00:00"),
 	}
 	assert.Equal(t, expected, downloadLink)
 }
@@ -623,8 +623,8 @@ func TestReportsService_GetSettingsTemplate(t *testing.T) {
 				SuggestionMatch: []model.ReportNetRateSchemeMatch{{MatchType: "100", Price: 0.1}},
 			},
 		},
-		CreatedAt: "2023-09-23T11:26:54+00:00",
-		UpdatedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt: testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt: testTime("2023-09-23T11:26:54+00:00"),
 		IsPublic:  true,
 		IsGlobal:  ToPtr(false),
 	}
@@ -979,10 +979,10 @@ func TestReportsService_CheckGroupReportStatus(t *testing.T) {
 			ReportName: "costs-estimation",
 			Schema:     map[string]any{},
 		},
-		CreatedAt:  "2023-09-23T11:26:54+00:00",
-		UpdatedAt:  "2023-09-23T11:26:54+00:00",
-		StartedAt:  "2023-09-23T11:26:54+00:00",
-		FinishedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		StartedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		FinishedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	assert.Equal(t, expected, status)
 }
@@ -1009,7 +1009,7 @@ func TestReportsService_DownloadGroupReport(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, resp)
 	assert.Equal(t, "https://production-enterprise-importer.downloads.crowdin.com/992000002/2/14.xliff", downloadLink.URL)
-	assert.Equal(t, "2023-09-20T10:31:21+00:00", downloadLink.ExpireIn)
+	assert.Equal(t, "2023-09-20T10:31:21+00:00", downloadLink.ExpireIn.String())
 }
 
 func TestReportsService_GenerateGroupReport(t *testing.T) {
@@ -1215,8 +1215,8 @@ func TestReportsService_GenerateOrganizationReport(t *testing.T) {
 				},
 				ExcludeApprovalsForEditedTranslations: ToPtr(false),
 				GroupBy:                               "user",
-				DateFrom:                              "2023-09-23T11:26:54+00:00",
-				DateTo:                                "2023-09-23T11:26:54+00:00",
+				DateFrom:                              ToPtr(testTime("2023-09-23T11:26:54+00:00")),
+				DateTo:                                ToPtr(testTime("2023-09-23T11:26:54+00:00")),
 				UserIDs:                               []int{1, 2},
 			},
 		}
@@ -1260,8 +1260,8 @@ func TestReportsService_GenerateOrganizationReport(t *testing.T) {
 				Unit:       model.ReportUnitWords,
 				LanguageID: "uk",
 				Format:     model.ReportFormatJSON,
-				DateFrom:   "2023-09-23T07:00:14+00:00",
-				DateTo:     "2023-09-27T07:00:14+00:00",
+				DateFrom:   ToPtr(testTime("2023-09-23T07:00:14+00:00")),
+				DateTo:     ToPtr(testTime("2023-09-27T07:00:14+00:00")),
 			},
 		}
 
@@ -1357,10 +1357,10 @@ func TestReportsService_CheckOrganizationReportStatus(t *testing.T) {
 			ReportName: "costs-estimation",
 			Schema:     map[string]any{},
 		},
-		CreatedAt:  "2023-09-23T11:26:54+00:00",
-		UpdatedAt:  "2023-09-23T11:26:54+00:00",
-		StartedAt:  "2023-09-23T11:26:54+00:00",
-		FinishedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		StartedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		FinishedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	assert.Equal(t, expected, status)
 }
@@ -1389,7 +1389,7 @@ func TestReportsService_DownloadOrganizationReport(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, resp)
 	assert.Equal(t, "https://production-enterprise-importer.downloads.crowdin.com/992000002/2/14.xliff", downloadLink.URL)
-	assert.Equal(t, "2023-09-20T10:31:21+00:00", downloadLink.ExpireIn)
+	assert.Equal(t, "2023-09-20T10:31:21+00:00", downloadLink.ExpireIn.String())
 }
 
 func jsonReportStatus() string {
@@ -0,0 +1,204 @@
+package crowdin
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// RetryPolicy defines how the client retries requests that failed with
+// a rate limit (429), a server error (5xx) or a transient network error.
+//
+// The delay between attempts grows exponentially starting from MinBackoff
+// and is capped by MaxBackoff. A random jitter is applied to every delay.
+// If the API responds with a `Retry-After` or `X-RateLimit-Reset` header,
+// the delay from the header is used instead. If that delay is longer than
+// MaxBackoff, the request is not retried and the API error is returned.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts including the first one.
+	// Values less than 2 disable retries.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry (default 500ms).
+	MinBackoff time.Duration
+	// MaxBackoff is the upper limit of the delay (default 30s).
+	MaxBackoff time.Duration
+	// RetryNonIdempotent allows retrying requests with non-idempotent
+	// methods (POST, PATCH). Such requests may have already been
+	// processed by the API when a failure occurs, so they are not
+	// retried by default.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a retry policy with 3 attempts and
+// default backoff values.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  defaultMinBackoff,
+		MaxBackoff:  defaultMaxBackoff,
+	}
+}
+
+// WithRetryPolicy enables automatic retries with the provided policy.
+// By default, requests are not retried.
+func WithRetryPolicy(p RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		if p.MaxAttempts < 0 {
+			return errors.New("retry policy: max attempts cannot be negative")
+		}
+		if p.MinBackoff < 0 || p.MaxBackoff < 0 {
+			return errors.New("retry policy: backoff cannot be negative")
+		}
+		if p.MinBackoff == 0 {
+			p.MinBackoff = defaultMinBackoff
+		}
+		if p.MaxBackoff == 0 {
+			p.MaxBackoff = defaultMaxBackoff
+		}
+		if p.MinBackoff > p.MaxBackoff {
+			return errors.New("retry policy: min backoff cannot be greater than max backoff")
+		}
+
+		c.retryPolicy = &p
+		return nil
+	}
+}
+
+// retryDelay reports whether the request should be retried after the given
+// attempt and returns the delay before the next attempt.
+func (p *RetryPolicy) retryDelay(r *http.Request, resp *Response, err error, attempt int) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	if !p.RetryNonIdempotent && !isIdempotent(r.Method) {
+		return 0, false
+	}
+	if !isReplayable(r) {
+		return 0, false
+	}
+
+	if resp == nil || resp.Response == nil {
+		if !isTransientError(err) {
+			return 0, false
+		}
+		return p.backoff(attempt), true
+	}
+
+	if !isRetryableStatus(resp.StatusCode) {
+		return 0, false
+	}
+	if d, ok := retryAfter(resp.Response); ok {
+		return d, p.allowsDelay(d)
+	}
+
+	return p.backoff(attempt), true
+}
+
+// backoff returns the jittered exponential delay for the given attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MinBackoff
+	for i := 1; i < attempt && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+
+	// Use a random delay in the range [d/2, d].
+	half := d / 2
+	return half + rand.N(half+1) //nolint:gosec // jitter does not need a secure random source
+}
+
+// allowsDelay reports whether the client can wait for the delay requested
+// by the API. A shorter wait would fail again, so a delay longer than
+// MaxBackoff ends the retries.
+func (p *RetryPolicy) allowsDelay(d time.Duration) bool {
+	return d <= p.MaxBackoff
+}
+
+// retryAfter returns the delay requested by the API using the `Retry-After`
+// or the `X-RateLimit-*` response headers.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return max(time.Until(t), 0), true
+		}
+	}
+
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		if v := resp.Header.Get("X-RateLimit-Reset"); v != "" {
+			if reset, err := strconv.ParseInt(v, 10, 64); err == nil && reset >= 0 {
+				// The reset value is either a Unix timestamp or a number of seconds.
+				if reset > 1e9 {
+					return max(time.Until(time.Unix(reset, 0)), 0), true
+				}
+				return time.Duration(reset) * time.Second, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// isIdempotent reports whether the HTTP method is idempotent.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isRetryableStatus reports whether the response status code is worth retrying.
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests ||
+		(code >= http.StatusInternalServerError && code != http.StatusNotImplemented)
+}
+
+// isReplayable reports whether the request can be sent again,
+// i.e. it has no body or its body can be replayed.
+func isReplayable(r *http.Request) bool {
+	return r.Body == nil || r.Body == http.NoBody || r.GetBody != nil
+}
+
+// isTransientError reports whether the error returned by the HTTP client
+// is a temporary network error.
+func isTransientError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// sleep waits for the given duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
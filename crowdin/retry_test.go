@@ -0,0 +1,398 @@
+package crowdin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+	}
+}
+
+func TestClient_Retry_ServerError(t *testing.T) {
+	client, mux, teardown := setupClient(WithRetryPolicy(testRetryPolicy()))
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"error": {"code": 503, "message": "Service Unavailable"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"id": 1, "fileName": "file.xliff"}}`)
+	})
+
+	storage, resp, err := client.Storages.Get(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 1, storage.ID)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestClient_Retry_MaxAttempts(t *testing.T) {
+	client, mux, teardown := setupClient(WithRetryPolicy(testRetryPolicy()))
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, _ *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"error": {"code": 429, "message": "Too Many Requests"}}`)
+	})
+
+	_, resp, err := client.Storages.Get(context.Background(), 1)
+	require.Error(t, err)
+
+	var errResponse *model.ErrorResponse
+	assert.ErrorAs(t, err, &errResponse)
+	assert.Equal(t, "429 Too Many Requests", err.Error())
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestClient_Retry_NotRetryableStatus(t *testing.T) {
+	client, mux, teardown := setupClient(WithRetryPolicy(testRetryPolicy()))
+	defer teardown()
+
+	for _, status := range []int{http.StatusNotFound, http.StatusNotImplemented} {
+		attempts := 0
+		path := fmt.Sprintf("/api/v2/storages/%d", status)
+		mux.HandleFunc(path, func(w http.ResponseWriter, _ *http.Request) {
+			attempts++
+			w.WriteHeader(status)
+			fmt.Fprintf(w, `{"error": {"code": %d, "message": "Error"}}`, status)
+		})
+
+		_, _, err := client.Storages.Get(context.Background(), status)
+		require.Error(t, err)
+		assert.Equal(t, 1, attempts)
+	}
+}
+
+func TestClient_Retry_RetryAfter(t *testing.T) {
+	policy := testRetryPolicy()
+	policy.MaxBackoff = time.Minute
+	policy.MinBackoff = time.Minute
+	client, mux, teardown := setupClient(WithRetryPolicy(policy))
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, _ *http.Request) {
+		attempts++
+		if attempts == 1 {
+			// Without the header the client would wait for a minute.
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"id": 1}}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, _, err := client.Storages.Get(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestClient_Retry_DelayExceedsMaxBackoff(t *testing.T) {
+	client, mux, teardown := setupClient(WithRetryPolicy(testRetryPolicy()))
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, _ *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	start := time.Now()
+	_, resp, err := client.Storages.Get(context.Background(), 1)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, 1, attempts)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestClient_Retry_DelayExceedsDeadline(t *testing.T) {
+	policy := testRetryPolicy()
+	policy.MaxBackoff = 2 * time.Hour
+	client, mux, teardown := setupClient(WithRetryPolicy(policy))
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, _ *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, resp, err := client.Storages.Get(ctx, 1)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestClient_Retry_NonIdempotent(t *testing.T) {
+	tests := []struct {
+		name             string
+		retryPost        bool
+		expectedAttempts int
+	}{
+		{
+			name:             "not allowed by default",
+			retryPost:        false,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "allowed",
+			retryPost:        true,
+			expectedAttempts: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := testRetryPolicy()
+			policy.RetryNonIdempotent = tt.retryPost
+			client, mux, teardown := setupClient(WithRetryPolicy(policy))
+			defer teardown()
+
+			attempts := 0
+			mux.HandleFunc("/api/v2/projects/1/labels", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, http.MethodPost)
+				testBody(t, r, `{"title":"main"}`+"\n")
+
+				attempts++
+				if attempts == 1 {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				fmt.Fprint(w, `{"data": {"id": 1, "title": "main"}}`)
+			})
+
+			_, _, _ = client.Labels.Add(context.Background(), 1, &model.LabelAddRequest{Title: "main"})
+			assert.Equal(t, tt.expectedAttempts, attempts)
+		})
+	}
+}
+
+func TestClient_Retry_StorageUpload(t *testing.T) {
+	policy := testRetryPolicy()
+	policy.RetryNonIdempotent = true
+	client, mux, teardown := setupClient(WithRetryPolicy(policy))
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testHeader(t, r, "Content-Length", "13")
+		testBody(t, r, "file content\n")
+
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"id": 1, "fileName": "upload.txt"}}`)
+	})
+
+	file, dir, err := openFile("upload.txt", "file content\n")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	defer file.Close()
+
+	storage, _, err := client.Storages.Add(context.Background(), file)
+	require.NoError(t, err)
+	assert.Equal(t, 1, storage.ID)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestClient_Retry_NetworkError(t *testing.T) {
+	client, mux, teardown := setupClient(WithRetryPolicy(testRetryPolicy()))
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, _ *http.Request) {
+		attempts++
+		if attempts == 1 {
+			// Close the connection without sending a response.
+			conn, _, err := w.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			conn.Close()
+			return
+		}
+		fmt.Fprint(w, `{"data": {"id": 1}}`)
+	})
+
+	_, _, err := client.Storages.Get(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestClient_NoRetryPolicy(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, _ *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, _, err := client.Storages.Get(context.Background(), 1)
+	require.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestWithRetryPolicy_Validation(t *testing.T) {
+	tests := []struct {
+		policy      RetryPolicy
+		expectedErr string
+	}{
+		{
+			policy:      RetryPolicy{MaxAttempts: -1},
+			expectedErr: "retry policy: max attempts cannot be negative",
+		},
+		{
+			policy:      RetryPolicy{MaxAttempts: 3, MinBackoff: -time.Second},
+			expectedErr: "retry policy: backoff cannot be negative",
+		},
+		{
+			policy:      RetryPolicy{MaxAttempts: 3, MinBackoff: time.Minute, MaxBackoff: time.Second},
+			expectedErr: "retry policy: min backoff cannot be greater than max backoff",
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := NewClient("token", WithRetryPolicy(tt.policy))
+		assert.EqualError(t, err, tt.expectedErr)
+	}
+
+	c, err := NewClient("token", WithRetryPolicy(RetryPolicy{MaxAttempts: 5}))
+	require.NoError(t, err)
+	assert.Equal(t, &RetryPolicy{MaxAttempts: 5, MinBackoff: defaultMinBackoff, MaxBackoff: defaultMaxBackoff}, c.retryPolicy)
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for attempt, limit := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		3: 400 * time.Millisecond,
+		4: 800 * time.Millisecond,
+		5: time.Second,
+		9: time.Second,
+	} {
+		d := p.backoff(attempt)
+		assert.GreaterOrEqual(t, d, limit/2, "attempt %d", attempt)
+		assert.LessOrEqual(t, d, limit, "attempt %d", attempt)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	reset := time.Now().Add(time.Hour).Unix()
+
+	tests := []struct {
+		name     string
+		headers  map[string]string
+		expected time.Duration
+		ok       bool
+	}{
+		{
+			name:     "seconds",
+			headers:  map[string]string{"Retry-After": "5"},
+			expected: 5 * time.Second,
+			ok:       true,
+		},
+		{
+			name:     "date in the past",
+			headers:  map[string]string{"Retry-After": "Wed, 21 Oct 2015 07:28:00 GMT"},
+			expected: 0,
+			ok:       true,
+		},
+		{
+			name:     "rate limit reset seconds",
+			headers:  map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "10"},
+			expected: 10 * time.Second,
+			ok:       true,
+		},
+		{
+			name:    "rate limit not exceeded",
+			headers: map[string]string{"X-RateLimit-Remaining": "5", "X-RateLimit-Reset": "10"},
+		},
+		{
+			name:    "invalid",
+			headers: map[string]string{"Retry-After": "soon"},
+		},
+		{
+			name: "no headers",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			for k, v := range tt.headers {
+				resp.Header.Set(k, v)
+			}
+
+			d, ok := retryAfter(resp)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, d)
+		})
+	}
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("X-RateLimit-Remaining", "0")
+	resp.Header.Set("X-RateLimit-Reset", strconv.FormatInt(reset, 10))
+	d, ok := retryAfter(resp)
+	assert.True(t, ok)
+	assert.InDelta(t, time.Hour, d, float64(5*time.Second))
+}
+
+func TestSetSeekableBody(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "https://api.crowdin.com/", nil)
+	require.NoError(t, err)
+
+	file, dir, err := openFile("upload.txt", "file content\n")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	defer file.Close()
+
+	_, err = file.Seek(5, io.SeekStart)
+	require.NoError(t, err)
+
+	setSeekableBody(req, file)
+	assert.Equal(t, int64(8), req.ContentLength)
+
+	for range 2 {
+		body, err := req.GetBody()
+		require.NoError(t, err)
+		b, err := io.ReadAll(body)
+		require.NoError(t, err)
+		assert.Equal(t, "content\n", string(b))
+	}
+}
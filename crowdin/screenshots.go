@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -24,6 +25,7 @@ type ScreenshotsService struct {
 func (s *ScreenshotsService) GetScreenshot(ctx context.Context, projectID, screenshotID int) (
 	*model.Screenshot, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Screenshots.GetScreenshot")
 	res := new(model.ScreenshotResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d", projectID, screenshotID), nil, res)
 
@@ -36,6 +38,7 @@ func (s *ScreenshotsService) GetScreenshot(ctx context.Context, projectID, scree
 func (s *ScreenshotsService) ListScreenshots(ctx context.Context, projectID int, opts *model.ScreenshotListOptions) (
 	[]*model.Screenshot, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Screenshots.ListScreenshots")
 	res := new(model.ScreenshotListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots", projectID), opts, res)
 	if err != nil {
@@ -50,12 +53,33 @@ func (s *ScreenshotsService) ListScreenshots(ctx context.Context, projectID int,
 	return list, resp, err
 }
 
+// AllScreenshots returns an iterator over all screenshots in the project.
+// It fetches the pages with ListScreenshots until a page shorter than the limit is returned.
+func (s *ScreenshotsService) AllScreenshots(
+	ctx context.Context, projectID int, opts *model.ScreenshotListOptions,
+) iter.Seq2[*model.Screenshot, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.Screenshot, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListScreenshots(ctx, projectID, &pageOpts)
+	})
+}
+
+// ListAllScreenshots returns all screenshots in the project by fetching every page with ListScreenshots.
+func (s *ScreenshotsService) ListAllScreenshots(ctx context.Context, projectID int, opts *model.ScreenshotListOptions) (
+	[]*model.Screenshot, error,
+) {
+	return Collect(s.AllScreenshots(ctx, projectID, opts))
+}
+
 // AddScreenshot adds a new screenshot to the project.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.screenshots.post
 func (s *ScreenshotsService) AddScreenshot(ctx context.Context, projectID int, req *model.ScreenshotAddRequest) (
 	*model.Screenshot, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Screenshots.AddScreenshot")
 	res := new(model.ScreenshotResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots", projectID), req, res)
 
@@ -68,6 +92,7 @@ func (s *ScreenshotsService) AddScreenshot(ctx context.Context, projectID int, r
 func (s *ScreenshotsService) UpdateScreenshot(ctx context.Context, projectID, screenshotID int, req *model.ScreenshotUpdateRequest) (
 	*model.Screenshot, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Screenshots.UpdateScreenshot")
 	res := new(model.ScreenshotResponse)
 	resp, err := s.client.Put(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d", projectID, screenshotID), req, res)
 
@@ -86,6 +111,7 @@ func (s *ScreenshotsService) UpdateScreenshot(ctx context.Context, projectID, sc
 func (s *ScreenshotsService) EditScreenshot(ctx context.Context, projectID, screenshotID int, req []*model.UpdateRequest) (
 	*model.Screenshot, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Screenshots.EditScreenshot")
 	res := new(model.ScreenshotResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d", projectID, screenshotID), req, res)
 
@@ -96,6 +122,7 @@ func (s *ScreenshotsService) EditScreenshot(ctx context.Context, projectID, scre
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.screenshots.delete
 func (s *ScreenshotsService) DeleteScreenshot(ctx context.Context, projectID, screenshotID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Screenshots.DeleteScreenshot")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d", projectID, screenshotID))
 }
 
@@ -105,6 +132,7 @@ func (s *ScreenshotsService) DeleteScreenshot(ctx context.Context, projectID, sc
 func (s *ScreenshotsService) ListTags(ctx context.Context, projectID, screenshotID int, opts *model.ListOptions) (
 	[]*model.Tag, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Screenshots.ListTags")
 	res := new(model.TagListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags", projectID, screenshotID), opts, res)
 	if err != nil {
@@ -119,12 +147,31 @@ func (s *ScreenshotsService) ListTags(ctx context.Context, projectID, screenshot
 	return list, resp, err
 }
 
+// AllTags returns an iterator over all tags for the screenshot.
+// It fetches the pages with ListTags until a page shorter than the limit is returned.
+func (s *ScreenshotsService) AllTags(
+	ctx context.Context, projectID, screenshotID int, opts *model.ListOptions,
+) iter.Seq2[*model.Tag, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, *o, func(ctx context.Context, page model.ListOptions) ([]*model.Tag, *Response, error) {
+		return s.ListTags(ctx, projectID, screenshotID, &page)
+	})
+}
+
+// ListAllTags returns all tags for the screenshot by fetching every page with ListTags.
+func (s *ScreenshotsService) ListAllTags(ctx context.Context, projectID, screenshotID int, opts *model.ListOptions) (
+	[]*model.Tag, error,
+) {
+	return Collect(s.AllTags(ctx, projectID, screenshotID, opts))
+}
+
 // GetTag returns a specific tag by its identifier.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.screenshots.tags.get
 func (s *ScreenshotsService) GetTag(ctx context.Context, projectID, screenshotID, tagID int) (
 	*model.Tag, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Screenshots.GetTag")
 	res := new(model.TagResponse)
 	path := fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags/%d", projectID, screenshotID, tagID)
 	resp, err := s.client.Get(ctx, path, nil, res)
@@ -138,6 +185,7 @@ func (s *ScreenshotsService) GetTag(ctx context.Context, projectID, screenshotID
 func (s *ScreenshotsService) AddTag(ctx context.Context, projectID, screenshotID int, req *model.TagAddRequest) (
 	*model.Tag, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Screenshots.AddTag")
 	res := new(model.TagResponse)
 	path := fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags", projectID, screenshotID)
 	resp, err := s.client.Post(ctx, path, req, res)
@@ -151,6 +199,7 @@ func (s *ScreenshotsService) AddTag(ctx context.Context, projectID, screenshotID
 func (s *ScreenshotsService) ReplaceTags(ctx context.Context, projectID, screenshotID int, req []*model.ReplaceTagsRequest) (
 	*Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Screenshots.ReplaceTags")
 	if len(req) == 0 {
 		return nil, errors.New("request is required")
 	}
@@ -170,6 +219,7 @@ func (s *ScreenshotsService) ReplaceTags(ctx context.Context, projectID, screens
 func (s *ScreenshotsService) AutoTag(ctx context.Context, projectID, screenshotID int, req *model.AutoTagRequest) (
 	*Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Screenshots.AutoTag")
 	return s.client.Put(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags", projectID, screenshotID), req, nil)
 }
 
@@ -185,6 +235,7 @@ func (s *ScreenshotsService) AutoTag(ctx context.Context, projectID, screenshotI
 func (s *ScreenshotsService) EditTag(ctx context.Context, projectID, screenshotID, tagID int, req []*model.UpdateRequest) (
 	*model.Tag, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Screenshots.EditTag")
 	res := new(model.TagResponse)
 	path := fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags/%d", projectID, screenshotID, tagID)
 	resp, err := s.client.Patch(ctx, path, req, res)
@@ -196,6 +247,7 @@ func (s *ScreenshotsService) EditTag(ctx context.Context, projectID, screenshotI
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.screenshots.tags.deleteMany
 func (s *ScreenshotsService) ClearTags(ctx context.Context, projectID, screenshotID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Screenshots.ClearTags")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags", projectID, screenshotID))
 }
 
@@ -203,5 +255,6 @@ func (s *ScreenshotsService) ClearTags(ctx context.Context, projectID, screensho
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.screenshots.tags.delete
 func (s *ScreenshotsService) DeleteTag(ctx context.Context, projectID, screenshotID, tagID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Screenshots.DeleteTag")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags/%d", projectID, screenshotID, tagID))
 }
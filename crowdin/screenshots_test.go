@@ -82,12 +82,12 @@ func TestScreenshotsService_GetScreenshot(t *testing.T) {
 					Width:  ToPtr(490),
 					Height: ToPtr(99),
 				},
-				CreatedAt: "2023-09-23T09:35:31+00:00",
+				CreatedAt: testTime("2023-09-23T09:35:31+00:00"),
 			},
 		},
 		LabelIDs:  []int{1},
-		CreatedAt: "2023-09-23T09:29:19+00:00",
-		UpdatedAt: "2023-09-23T09:29:19+00:00",
+		CreatedAt: testTime("2023-09-23T09:29:19+00:00"),
+		UpdatedAt: testTime("2023-09-23T09:29:19+00:00"),
 	}
 	assert.Equal(t, expected, screenshot)
 }
@@ -472,7 +472,7 @@ func TestScreenshotsService_GetTag(t *testing.T) {
 			Width:  ToPtr(490),
 			Height: ToPtr(99),
 		},
-		CreatedAt: "2023-09-23T09:35:31+00:00",
+		CreatedAt: testTime("2023-09-23T09:35:31+00:00"),
 	}
 	assert.Equal(t, expected, tag)
 }
@@ -545,7 +545,7 @@ func TestScreenshotsService_ListTags(t *testing.T) {
 				Width:  ToPtr(490),
 				Height: ToPtr(99),
 			},
-			CreatedAt: "2023-09-23T09:35:31+00:00",
+			CreatedAt: testTime("2023-09-23T09:35:31+00:00"),
 		},
 	}
 	assert.Equal(t, expected, tags)
@@ -611,7 +611,7 @@ func TestScreenshotsService_AddTag(t *testing.T) {
 			Width:  ToPtr(490),
 			Height: ToPtr(0),
 		},
-		CreatedAt: "2023-09-23T09:35:31+00:00",
+		CreatedAt: testTime("2023-09-23T09:35:31+00:00"),
 	}
 	assert.Equal(t, expected, tag)
 }
@@ -867,7 +867,7 @@ func TestScreenshotsService_EditTag(t *testing.T) {
 			Width:  ToPtr(490),
 			Height: ToPtr(99),
 		},
-		CreatedAt: "2023-09-23T09:35:31+00:00",
+		CreatedAt: testTime("2023-09-23T09:35:31+00:00"),
 	}
 	assert.Equal(t, expected, tag)
 }
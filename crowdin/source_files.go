@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -33,6 +34,7 @@ type SourceFilesService struct {
 func (s *SourceFilesService) ListDirectories(ctx context.Context, projectID int, opts *model.DirectoryListOptions) (
 	[]*model.Directory, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "SourceFiles.ListDirectories")
 	res := new(model.DirectoryListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/directories", projectID), opts, res)
 	if err != nil {
@@ -47,10 +49,31 @@ func (s *SourceFilesService) ListDirectories(ctx context.Context, projectID int,
 	return dir, resp, err
 }
 
+// AllDirectories returns an iterator over all directories in the project.
+// It fetches the pages with ListDirectories until a page shorter than the limit is returned.
+func (s *SourceFilesService) AllDirectories(
+	ctx context.Context, projectID int, opts *model.DirectoryListOptions,
+) iter.Seq2[*model.Directory, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.Directory, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListDirectories(ctx, projectID, &pageOpts)
+	})
+}
+
+// ListAllDirectories returns all directories in the project by fetching every page with ListDirectories.
+func (s *SourceFilesService) ListAllDirectories(ctx context.Context, projectID int, opts *model.DirectoryListOptions) (
+	[]*model.Directory, error,
+) {
+	return Collect(s.AllDirectories(ctx, projectID, opts))
+}
+
 // GetDirectory returns a single directory in the project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.directories.get
 func (s *SourceFilesService) GetDirectory(ctx context.Context, projectID, directoryID int) (*model.Directory, *Response, error) {
+	ctx = WithOperationName(ctx, "SourceFiles.GetDirectory")
 	res := new(model.DirectoryGetResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/directories/%d", projectID, directoryID), nil, res)
 
@@ -63,6 +86,7 @@ func (s *SourceFilesService) GetDirectory(ctx context.Context, projectID, direct
 func (s *SourceFilesService) AddDirectory(ctx context.Context, projectID int, req *model.DirectoryAddRequest) (
 	*model.Directory, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "SourceFiles.AddDirectory")
 	res := new(model.DirectoryGetResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/directories", projectID), req, res)
 
@@ -81,6 +105,7 @@ func (s *SourceFilesService) AddDirectory(ctx context.Context, projectID int, re
 func (s *SourceFilesService) EditDirectory(ctx context.Context, projectID, directoryID int, req []*model.UpdateRequest) (
 	*model.Directory, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "SourceFiles.EditDirectory")
 	res := new(model.DirectoryGetResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/directories/%d", projectID, directoryID), req, res)
 
@@ -91,6 +116,7 @@ func (s *SourceFilesService) EditDirectory(ctx context.Context, projectID, direc
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.directories.delete
 func (s *SourceFilesService) DeleteDirectory(ctx context.Context, projectID, directoryID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "SourceFiles.DeleteDirectory")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/directories/%d", projectID, directoryID))
 }
 
@@ -108,6 +134,7 @@ func (s *SourceFilesService) DeleteDirectory(ctx context.Context, projectID, dir
 func (s *SourceFilesService) ListFiles(ctx context.Context, projectID int, opts *model.FileListOptions) (
 	[]*model.File, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "SourceFiles.ListFiles")
 	res := new(model.FileListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/files", projectID), opts, res)
 	if err != nil {
@@ -122,10 +149,31 @@ func (s *SourceFilesService) ListFiles(ctx context.Context, projectID int, opts
 	return files, resp, err
 }
 
+// AllFiles returns an iterator over all files in the project.
+// It fetches the pages with ListFiles until a page shorter than the limit is returned.
+func (s *SourceFilesService) AllFiles(
+	ctx context.Context, projectID int, opts *model.FileListOptions,
+) iter.Seq2[*model.File, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.File, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListFiles(ctx, projectID, &pageOpts)
+	})
+}
+
+// ListAllFiles returns all files in the project by fetching every page with ListFiles.
+func (s *SourceFilesService) ListAllFiles(ctx context.Context, projectID int, opts *model.FileListOptions) (
+	[]*model.File, error,
+) {
+	return Collect(s.AllFiles(ctx, projectID, opts))
+}
+
 // GetFile returns a single file in the project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.files.get
 func (s *SourceFilesService) GetFile(ctx context.Context, projectID, fileID int) (*model.File, *Response, error) {
+	ctx = WithOperationName(ctx, "SourceFiles.GetFile")
 	res := new(model.FileGetResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d", projectID, fileID), nil, res)
 
@@ -138,6 +186,7 @@ func (s *SourceFilesService) GetFile(ctx context.Context, projectID, fileID int)
 func (s *SourceFilesService) AddFile(ctx context.Context, projectID int, req *model.FileAddRequest) (
 	*model.File, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "SourceFiles.AddFile")
 	res := new(model.FileGetResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/files", projectID), req, res)
 
@@ -153,6 +202,7 @@ func (s *SourceFilesService) AddFile(ctx context.Context, projectID int, req *mo
 func (s *SourceFilesService) UpdateOrRestoreFile(ctx context.Context, projectID, fileID int, req *model.FileUpdateRestoreRequest) (
 	*model.File, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "SourceFiles.UpdateOrRestoreFile")
 	res := new(model.FileGetResponse)
 	resp, err := s.client.Put(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d", projectID, fileID), req, res)
 
@@ -165,6 +215,7 @@ func (s *SourceFilesService) UpdateOrRestoreFile(ctx context.Context, projectID,
 func (s *SourceFilesService) EditFile(ctx context.Context, projectID, fileID int, req []*model.UpdateRequest) (
 	*model.File, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "SourceFiles.EditFile")
 	res := new(model.FileGetResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d", projectID, fileID), req, res)
 
@@ -175,6 +226,7 @@ func (s *SourceFilesService) EditFile(ctx context.Context, projectID, fileID int
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.files.delete
 func (s *SourceFilesService) DeleteFile(ctx context.Context, projectID, fileID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "SourceFiles.DeleteFile")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d", projectID, fileID))
 }
 
@@ -182,6 +234,7 @@ func (s *SourceFilesService) DeleteFile(ctx context.Context, projectID, fileID i
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.files.preview.get
 func (s *SourceFilesService) DownloadFilePreview(ctx context.Context, projectID, fileID int) (*model.DownloadLink, *Response, error) {
+	ctx = WithOperationName(ctx, "SourceFiles.DownloadFilePreview")
 	res := new(model.DownloadLinkResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d/preview", projectID, fileID), nil, res)
 
@@ -192,6 +245,7 @@ func (s *SourceFilesService) DownloadFilePreview(ctx context.Context, projectID,
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.files.download.get
 func (s *SourceFilesService) DownloadFile(ctx context.Context, projectID, fileID int) (*model.DownloadLink, *Response, error) {
+	ctx = WithOperationName(ctx, "SourceFiles.DownloadFile")
 	res := new(model.DownloadLinkResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d/download", projectID, fileID), nil, res)
 
@@ -204,6 +258,7 @@ func (s *SourceFilesService) DownloadFile(ctx context.Context, projectID, fileID
 func (s *SourceFilesService) ListFileRevisions(ctx context.Context, projectID, fileID int, opts *model.ListOptions) (
 	[]*model.FileRevision, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "SourceFiles.ListFileRevisions")
 	res := new(model.FileRevisionListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d/revisions", projectID, fileID), opts, res)
 	if err != nil {
@@ -218,10 +273,29 @@ func (s *SourceFilesService) ListFileRevisions(ctx context.Context, projectID, f
 	return revisions, resp, err
 }
 
+// AllFileRevisions returns an iterator over all file revisions.
+// It fetches the pages with ListFileRevisions until a page shorter than the limit is returned.
+func (s *SourceFilesService) AllFileRevisions(
+	ctx context.Context, projectID, fileID int, opts *model.ListOptions,
+) iter.Seq2[*model.FileRevision, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, *o, func(ctx context.Context, page model.ListOptions) ([]*model.FileRevision, *Response, error) {
+		return s.ListFileRevisions(ctx, projectID, fileID, &page)
+	})
+}
+
+// ListAllFileRevisions returns all file revisions by fetching every page with ListFileRevisions.
+func (s *SourceFilesService) ListAllFileRevisions(ctx context.Context, projectID, fileID int, opts *model.ListOptions) (
+	[]*model.FileRevision, error,
+) {
+	return Collect(s.AllFileRevisions(ctx, projectID, fileID, opts))
+}
+
 // GetFileRevision returns a single file revision.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.files.revisions.get
 func (s *SourceFilesService) GetFileRevision(ctx context.Context, projectID, fileID, revisionID int) (*model.FileRevision, *Response, error) {
+	ctx = WithOperationName(ctx, "SourceFiles.GetFileRevision")
 	res := new(model.FileRevisionResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d/revisions/%d", projectID, fileID, revisionID), nil, res)
 
@@ -234,6 +308,7 @@ func (s *SourceFilesService) GetFileRevision(ctx context.Context, projectID, fil
 func (s *SourceFilesService) ListReviewedBuilds(ctx context.Context, projectID int, opts *model.ReviewedBuildListOptions) (
 	[]*model.ReviewedBuild, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "SourceFiles.ListReviewedBuilds")
 	res := new(model.ReviewedBuildListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/reviewed-builds", projectID), opts, res)
 	if err != nil {
@@ -248,10 +323,31 @@ func (s *SourceFilesService) ListReviewedBuilds(ctx context.Context, projectID i
 	return builds, resp, err
 }
 
+// AllReviewedBuilds returns an iterator over all reviewed source files builds.
+// It fetches the pages with ListReviewedBuilds until a page shorter than the limit is returned.
+func (s *SourceFilesService) AllReviewedBuilds(
+	ctx context.Context, projectID int, opts *model.ReviewedBuildListOptions,
+) iter.Seq2[*model.ReviewedBuild, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.ReviewedBuild, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListReviewedBuilds(ctx, projectID, &pageOpts)
+	})
+}
+
+// ListAllReviewedBuilds returns all reviewed source files builds by fetching every page with ListReviewedBuilds.
+func (s *SourceFilesService) ListAllReviewedBuilds(ctx context.Context, projectID int, opts *model.ReviewedBuildListOptions) (
+	[]*model.ReviewedBuild, error,
+) {
+	return Collect(s.AllReviewedBuilds(ctx, projectID, opts))
+}
+
 // CheckReviewedBuildStatus checks the status of a specific reviewed source files build.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.strings.reviewed-builds.get
 func (s *SourceFilesService) CheckReviewedBuildStatus(ctx context.Context, projectID, buildID int) (*model.ReviewedBuild, *Response, error) {
+	ctx = WithOperationName(ctx, "SourceFiles.CheckReviewedBuildStatus")
 	res := new(model.ReviewedBuildResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/reviewed-builds/%d", projectID, buildID), nil, res)
 
@@ -264,6 +360,7 @@ func (s *SourceFilesService) CheckReviewedBuildStatus(ctx context.Context, proje
 func (s *SourceFilesService) BuildReviewedFiles(ctx context.Context, projectID int, req *model.ReviewedBuildRequest) (
 	*model.ReviewedBuild, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "SourceFiles.BuildReviewedFiles")
 	res := new(model.ReviewedBuildResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/reviewed-builds", projectID), req, res)
 
@@ -274,6 +371,7 @@ func (s *SourceFilesService) BuildReviewedFiles(ctx context.Context, projectID i
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.strings.reviewed-builds.download.download
 func (s *SourceFilesService) DownloadReviewedBuild(ctx context.Context, projectID, buildID int) (*model.DownloadLink, *Response, error) {
+	ctx = WithOperationName(ctx, "SourceFiles.DownloadReviewedBuild")
 	res := new(model.DownloadLinkResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/reviewed-builds/%d/download", projectID, buildID), nil, res)
 
@@ -59,8 +59,8 @@ func TestSourceFilesService_ListDirectories(t *testing.T) {
 			ExportPattern: "/localization/%locale%/file_name",
 			Path:          "/main",
 			Priority:      "normal",
-			CreatedAt:     "2024-04-18T14:14:00+00:00",
-			UpdatedAt:     "2024-04-18T14:14:00+00:00",
+			CreatedAt:     testTime("2024-04-18T14:14:00+00:00"),
+			UpdatedAt:     testTime("2024-04-18T14:14:00+00:00"),
 		},
 	}
 	assert.Equal(t, expected, directories)
@@ -166,8 +166,8 @@ func TestSourceFilesService_GetDirectory(t *testing.T) {
 		ExportPattern: "/localization/%locale%/file_name",
 		Path:          "/main",
 		Priority:      "normal",
-		CreatedAt:     "2024-04-18T14:14:00+00:00",
-		UpdatedAt:     "2024-04-18T14:14:00+00:00",
+		CreatedAt:     testTime("2024-04-18T14:14:00+00:00"),
+		UpdatedAt:     testTime("2024-04-18T14:14:00+00:00"),
 	}
 	assert.Equal(t, expected, directory)
 	assert.NotNil(t, resp)
@@ -222,8 +222,8 @@ func TestSourceFilesService_AddDirectory(t *testing.T) {
 		ExportPattern: "/localization/%locale%/new_file_name",
 		Path:          "/new_directory",
 		Priority:      "normal",
-		CreatedAt:     "2024-04-18T14:14:00+00:00",
-		UpdatedAt:     "2024-04-18T14:14:00+00:00",
+		CreatedAt:     testTime("2024-04-18T14:14:00+00:00"),
+		UpdatedAt:     testTime("2024-04-18T14:14:00+00:00"),
 	}
 	assert.Equal(t, expected, directory)
 	assert.NotNil(t, resp)
@@ -319,8 +319,8 @@ func TestSourceFilesService_EditDirectory(t *testing.T) {
 		ExportPattern: "/localization/%locale%/file_name",
 		Path:          "/main",
 		Priority:      "normal",
-		CreatedAt:     "2024-04-18T14:14:00+00:00",
-		UpdatedAt:     "2024-04-18T14:14:00+00:00",
+		CreatedAt:     testTime("2024-04-18T14:14:00+00:00"),
+		UpdatedAt:     testTime("2024-04-18T14:14:00+00:00"),
 	}
 	assert.Equal(t, expected, directory)
 	assert.NotNil(t, resp)
@@ -858,7 +858,7 @@ func TestSourceFilesService_DownloadFilePreview(t *testing.T) {
 
 	expected := &model.DownloadLink{
 		URL:      "https://production-enterprise-importer.downloads.crowdin.com/992000002/2/14.xliff?response-content-disposition",
-		ExpireIn: "2023-09-20T10:31:21+00:00",
+		ExpireIn: testTime("2023-09-20T10:31:21+00:00"),
 	}
 	assert.Equal(t, expected, downloadLink)
 	assert.NotNil(t, resp)
@@ -886,7 +886,7 @@ func TestSourceFilesService_DownloadFile(t *testing.T) {
 
 	expected := &model.DownloadLink{
 		URL:      "https://production-enterprise-importer.downloads.crowdin.com/992000002/2/14.xliff?response-content-disposition",
-		ExpireIn: "2023-09-20T10:31:21+00:00",
+		ExpireIn: testTime("2023-09-20T10:31:21+00:00"),
 	}
 	assert.Equal(t, expected, downloadLink)
 	assert.NotNil(t, resp)
@@ -961,7 +961,7 @@ func TestSourceFilesService_ListFileRevisions(t *testing.T) {
 					Words:   43,
 				},
 			},
-			Date: "2023-09-20T09:08:16+00:00",
+			Date: testTime("2023-09-20T09:08:16+00:00"),
 		},
 	}
 	assert.Equal(t, expected, revisions)
@@ -1030,7 +1030,7 @@ func TestSourceFilesService_GetFileRevision(t *testing.T) {
 				Words:   43,
 			},
 		},
-		Date: "2023-09-20T09:08:16+00:00",
+		Date: testTime("2023-09-20T09:08:16+00:00"),
 	}
 	assert.Equal(t, expected, fileRevision)
 	assert.NotNil(t, resp)
@@ -1180,7 +1180,7 @@ func TestSourceFilesService_DownloadReviewedBuild(t *testing.T) {
 
 	expected := &model.DownloadLink{
 		URL:      "https://production-enterprise-importer.downloads.crowdin.com/992000002/2/14.xliff?response-content-disposition=attachment",
-		ExpireIn: "2019-09-20T10:31:21+00:00",
+		ExpireIn: testTime("2019-09-20T10:31:21+00:00"),
 	}
 	assert.Equal(t, expected, downloadLink)
 	assert.NotNil(t, resp)
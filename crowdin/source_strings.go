@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -25,6 +26,7 @@ type SourceStringsService struct {
 func (s *SourceStringsService) List(ctx context.Context, projectID int, opts *model.SourceStringsListOptions) (
 	[]*model.SourceString, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "SourceStrings.List")
 	res := new(model.SourceStringsListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/strings", projectID), opts, res)
 	if err != nil {
@@ -39,12 +41,33 @@ func (s *SourceStringsService) List(ctx context.Context, projectID int, opts *mo
 	return list, resp, nil
 }
 
+// All returns an iterator over all source strings in the project.
+// It fetches the pages with List until a page shorter than the limit is returned.
+func (s *SourceStringsService) All(
+	ctx context.Context, projectID int, opts *model.SourceStringsListOptions,
+) iter.Seq2[*model.SourceString, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.SourceString, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.List(ctx, projectID, &pageOpts)
+	})
+}
+
+// ListAll returns all source strings in the project by fetching every page with List.
+func (s *SourceStringsService) ListAll(ctx context.Context, projectID int, opts *model.SourceStringsListOptions) (
+	[]*model.SourceString, error,
+) {
+	return Collect(s.All(ctx, projectID, opts))
+}
+
 // Get returns a specific source string by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.strings.get
 func (s *SourceStringsService) Get(ctx context.Context, projectID, stringID int, opts *model.SourceStringsGetOptions) (
 	*model.SourceString, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "SourceStrings.Get")
 	res := new(model.SourceStringsGetResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/%d", projectID, stringID), opts, res)
 
@@ -57,6 +80,7 @@ func (s *SourceStringsService) Get(ctx context.Context, projectID, stringID int,
 func (s *SourceStringsService) Add(ctx context.Context, projectID int, req *model.SourceStringsAddRequest) (
 	*model.SourceString, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "SourceStrings.Add")
 	res := new(model.SourceStringsGetResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/strings", projectID), req, res)
 
@@ -77,6 +101,7 @@ func (s *SourceStringsService) Add(ctx context.Context, projectID int, req *mode
 func (s *SourceStringsService) BatchOperations(ctx context.Context, projectID int, req []*model.UpdateRequest) (
 	[]*model.SourceString, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "SourceStrings.BatchOperations")
 	res := new(model.SourceStringsListResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/strings", projectID), req, res)
 	if err != nil {
@@ -105,6 +130,7 @@ func (s *SourceStringsService) BatchOperations(ctx context.Context, projectID in
 func (s *SourceStringsService) Edit(ctx context.Context, projectID, stringID int, req []*model.UpdateRequest) (
 	*model.SourceString, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "SourceStrings.Edit")
 	res := new(model.SourceStringsGetResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/%d", projectID, stringID), req, res)
 
@@ -115,6 +141,7 @@ func (s *SourceStringsService) Edit(ctx context.Context, projectID, stringID int
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.strings.delete
 func (s *SourceStringsService) Delete(ctx context.Context, projectID, stringID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "SourceStrings.Delete")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/%d", projectID, stringID))
 }
 
@@ -124,6 +151,7 @@ func (s *SourceStringsService) Delete(ctx context.Context, projectID, stringID i
 func (s *SourceStringsService) GetUploadStatus(ctx context.Context, projectID int, uploadID string) (
 	*model.SourceStringsUpload, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "SourceStrings.GetUploadStatus")
 	res := new(model.SourceStringsUploadResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/uploads/%s", projectID, uploadID), nil, res)
 
@@ -136,8 +164,29 @@ func (s *SourceStringsService) GetUploadStatus(ctx context.Context, projectID in
 func (s *SourceStringsService) Upload(ctx context.Context, projectID int, req *model.SourceStringsUploadRequest) (
 	*model.SourceStringsUpload, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "SourceStrings.Upload")
 	res := new(model.SourceStringsUploadResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/uploads", projectID), req, res)
 
 	return res.Data, resp, err
 }
+
+// UploadAndWait uploads strings to the project and waits until the upload is processed.
+func (s *SourceStringsService) UploadAndWait(
+	ctx context.Context,
+	projectID int,
+	req *model.SourceStringsUploadRequest,
+	opts ...WaitOption,
+) (*model.SourceStringsUpload, error) {
+	return startAndWait(ctx,
+		func() (*model.SourceStringsUpload, *Response, error) {
+			return s.Upload(ctx, projectID, req)
+		},
+		func(u *model.SourceStringsUpload) PollFunc[*model.SourceStringsUpload] {
+			return func(ctx context.Context) (*model.SourceStringsUpload, *Response, error) {
+				return s.GetUploadStatus(ctx, projectID, u.Identifier)
+			}
+		},
+		opts,
+	)
+}
@@ -75,8 +75,8 @@ func TestSourceStringsService_List(t *testing.T) {
 			MasterStringID: ToPtr(1),
 			LabelIDs:       []int{3},
 			WebURL:         "https://example.crowdin.com/editor/1/all/en-pl?filter=basic&value=0&view=comfortable#2",
-			CreatedAt:      ToPtr("2023-09-20T12:43:57+00:00"),
-			UpdatedAt:      ToPtr("2023-09-20T13:24:01+00:00"),
+			CreatedAt:      ToPtr(testTime("2023-09-20T12:43:57+00:00")),
+			UpdatedAt:      ToPtr(testTime("2023-09-20T13:24:01+00:00")),
 			Fields:         map[string]interface{}{"fieldSlug": "fieldValue"},
 			FileID:         ToPtr(48),
 			DirectoryID:    ToPtr(13),
@@ -210,8 +210,8 @@ func TestSourceStringsService_Get(t *testing.T) {
 		MasterStringID: ToPtr(1),
 		LabelIDs:       []int{3},
 		WebURL:         "https://example.crowdin.com/editor/1/all/en-pl?filter=basic&value=0&view=comfortable#2",
-		CreatedAt:      ToPtr("2023-09-20T12:43:57+00:00"),
-		UpdatedAt:      ToPtr("2023-09-20T13:24:01+00:00"),
+		CreatedAt:      ToPtr(testTime("2023-09-20T12:43:57+00:00")),
+		UpdatedAt:      ToPtr(testTime("2023-09-20T13:24:01+00:00")),
 		Fields:         map[string]interface{}{"fieldSlug": "fieldValue"},
 		FileID:         ToPtr(48),
 		DirectoryID:    ToPtr(13),
@@ -331,8 +331,8 @@ func TestSourceStringsService_Add(t *testing.T) {
 		MasterStringID: ToPtr(1),
 		LabelIDs:       []int{3},
 		WebURL:         "https://example.crowdin.com/editor/1/all/en-pl?filter=basic&value=0&view=comfortable#2",
-		CreatedAt:      ToPtr("2023-09-20T12:43:57+00:00"),
-		UpdatedAt:      ToPtr("2023-09-20T13:24:01+00:00"),
+		CreatedAt:      ToPtr(testTime("2023-09-20T12:43:57+00:00")),
+		UpdatedAt:      ToPtr(testTime("2023-09-20T13:24:01+00:00")),
 		Fields:         map[string]interface{}{"fieldSlug": "fieldValue"},
 		FileID:         ToPtr(48),
 		DirectoryID:    ToPtr(13),
@@ -507,8 +507,8 @@ func TestSourceStringsService_BatchOperations(t *testing.T) {
 			MasterStringID: ToPtr(1),
 			LabelIDs:       []int{3},
 			WebURL:         "https://example.crowdin.com/editor/1/all/en-pl?filter=basic&value=0&view=comfortable#1",
-			CreatedAt:      ToPtr("2023-09-20T12:43:57+00:00"),
-			UpdatedAt:      ToPtr("2023-09-20T13:24:01+00:00"),
+			CreatedAt:      ToPtr(testTime("2023-09-20T12:43:57+00:00")),
+			UpdatedAt:      ToPtr(testTime("2023-09-20T13:24:01+00:00")),
 		},
 	}
 	if !reflect.DeepEqual(sourceStrings, want) {
@@ -583,8 +583,8 @@ func TestSourceStringsService_Edit(t *testing.T) {
 		MasterStringID: ToPtr(1),
 		LabelIDs:       []int{3},
 		WebURL:         "https://example.crowdin.com/editor/1/all/en-pl?filter=basic&value=0&view=comfortable#2",
-		CreatedAt:      ToPtr("2023-09-20T12:43:57+00:00"),
-		UpdatedAt:      ToPtr("2023-09-20T13:24:01+00:00"),
+		CreatedAt:      ToPtr(testTime("2023-09-20T12:43:57+00:00")),
+		UpdatedAt:      ToPtr(testTime("2023-09-20T13:24:01+00:00")),
 	}
 	if !reflect.DeepEqual(sourceString, want) {
 		t.Errorf("SourceStrings.Edit returned %+v, want %+v", sourceString, want)
@@ -645,7 +645,7 @@ func TestSourceStringsService_GetUploadStatus(t *testing.T) {
 					"cleanupMode": false
 				},
 				"createdAt": "2023-09-23T11:26:54+00:00",
-				"updatedAt": "20123-09-23T11:26:54+00:00",
+				"updatedAt": "2023-09-23T11:26:54+00:00",
 				"startedAt": "2023-09-23T11:26:54+00:00",
 				"finishedAt": "2023-09-23T11:26:54+00:00"
 			}
@@ -697,10 +697,10 @@ func TestSourceStringsService_GetUploadStatus(t *testing.T) {
 			UpdateStrings: false,
 			CleanupMode:   false,
 		},
-		CreatedAt:  "2023-09-23T11:26:54+00:00",
-		UpdatedAt:  "20123-09-23T11:26:54+00:00",
-		StartedAt:  "2023-09-23T11:26:54+00:00",
-		FinishedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		StartedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		FinishedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	if !reflect.DeepEqual(uploadStatus, want) {
 		t.Errorf("SourceStrings.GetUploadStatus returned %+v, want %+v", uploadStatus, want)
@@ -820,10 +820,10 @@ func TestSourceStringsService_Upload(t *testing.T) {
 			UpdateStrings: false,
 			CleanupMode:   false,
 		},
-		CreatedAt:  "2023-09-23T11:26:54+00:00",
-		UpdatedAt:  "2023-09-23T11:26:54+00:00",
-		StartedAt:  "2023-09-23T11:26:54+00:00",
-		FinishedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		StartedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		FinishedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	if !reflect.DeepEqual(upload, want) {
 		t.Errorf("SourceStrings.Upload returned %+v, want %+v", upload, want)
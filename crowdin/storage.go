@@ -2,7 +2,10 @@ package crowdin
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"iter"
 	"mime"
 	"net/url"
 	"os"
@@ -31,15 +34,64 @@ type StorageService struct {
 //
 // https://developer.crowdin.com/api/v2/#operation/api.storages.post
 func (s *StorageService) Add(ctx context.Context, file *os.File) (*model.Storage, *Response, error) {
-	mediaType := mime.TypeByExtension(filepath.Ext(file.Name()))
+	ctx = WithOperationName(ctx, "Storages.Add")
+	if file == nil {
+		return nil, nil, errors.New("file cannot be nil")
+	}
+	return s.add(ctx, filepath.Base(file.Name()), file, -1)
+}
+
+// AddReader adds a new file to the storage with the content read from r.
+//
+// `name` is the file name. It is used to detect the media type of the content.
+// `size` is the number of bytes to be read from r. If it is negative, the size
+// is determined by seeking if r is an io.ReadSeeker, otherwise the content is
+// streamed with chunked encoding. If r is an io.ReadSeeker, a non-negative size
+// must match the number of bytes left in r, or an error is returned before
+// the request is sent.
+//
+// If r is an io.ReadSeeker, it is rewound when the request is retried
+// (POST requests are retried only if RetryPolicy.RetryNonIdempotent is set).
+// ZIP files are not supported.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.storages.post
+func (s *StorageService) AddReader(ctx context.Context, name string, r io.Reader, size int64, opts ...UploadOption) (
+	*model.Storage, *Response, error,
+) {
+	ctx = WithOperationName(ctx, "Storages.AddReader")
+	return s.add(ctx, name, r, size, opts...)
+}
+
+func (s *StorageService) add(ctx context.Context, name string, r io.Reader, size int64, opts ...UploadOption) (
+	*model.Storage, *Response, error,
+) {
+	if name == "" {
+		return nil, nil, errors.New("name cannot be empty")
+	}
+	if r == nil {
+		return nil, nil, errors.New("reader cannot be nil")
+	}
+
+	cfg := &uploadConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	mediaType := mime.TypeByExtension(filepath.Ext(name))
 	if mediaType == "" {
 		mediaType = "application/octet-stream"
 	}
 
+	body := io.Reader(r)
+	if cfg.onProgress != nil {
+		body = newProgressReader(r, size, cfg.onProgress)
+	}
+
 	res := new(model.StorageGetResponse)
-	resp, err := s.client.Post(ctx, "/api/v2/storages", file, res,
+	resp, err := s.client.Post(ctx, "/api/v2/storages", body, res,
 		Header("Content-Type", mediaType),
-		Header("Crowdin-API-FileName", url.QueryEscape(filepath.Base(file.Name()))),
+		Header("Crowdin-API-FileName", url.QueryEscape(filepath.Base(name))),
+		ContentLength(size),
 	)
 
 	return res.Data, resp, err
@@ -53,6 +105,7 @@ func (s *StorageService) Add(ctx context.Context, file *os.File) (*model.Storage
 //
 // https://developer.crowdin.com/api/v2/#operation/api.storages.getMany
 func (s *StorageService) List(ctx context.Context, opts *model.ListOptions) ([]*model.Storage, *Response, error) {
+	ctx = WithOperationName(ctx, "Storages.List")
 	res := new(model.StorageListResponse)
 	resp, err := s.client.Get(ctx, "/api/v2/storages", opts, res)
 	if err != nil {
@@ -67,9 +120,24 @@ func (s *StorageService) List(ctx context.Context, opts *model.ListOptions) ([]*
 	return storages, resp, nil
 }
 
+// All returns an iterator over all storages.
+// It fetches the pages with List until a page shorter than the limit is returned.
+func (s *StorageService) All(ctx context.Context, opts *model.ListOptions) iter.Seq2[*model.Storage, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, *o, func(ctx context.Context, page model.ListOptions) ([]*model.Storage, *Response, error) {
+		return s.List(ctx, &page)
+	})
+}
+
+// ListAll returns all storages by fetching every page with List.
+func (s *StorageService) ListAll(ctx context.Context, opts *model.ListOptions) ([]*model.Storage, error) {
+	return Collect(s.All(ctx, opts))
+}
+
 // Get returns a file in the storage by its identifier.
 // https://developer.crowdin.com/api/v2/#operation/api.storages.get
 func (s *StorageService) Get(ctx context.Context, id int) (*model.Storage, *Response, error) {
+	ctx = WithOperationName(ctx, "Storages.Get")
 	res := new(model.StorageGetResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/storages/%d", id), nil, res)
 
@@ -79,5 +147,79 @@ func (s *StorageService) Get(ctx context.Context, id int) (*model.Storage, *Resp
 // Delete deletes a file from the storage by its identifier.
 // https://developer.crowdin.com/api/v2/#operation/api.storages.delete
 func (s *StorageService) Delete(ctx context.Context, id int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Storages.Delete")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/storages/%d", id))
 }
+
+// UploadOption configures an upload made with StorageService.AddReader.
+type UploadOption func(*uploadConfig)
+
+type uploadConfig struct {
+	onProgress func(sent, total int64)
+}
+
+// UploadProgress sets a callback which is called every time a chunk of the
+// content is read for sending. `total` is -1 if the size of the content is
+// unknown. If the upload is retried, the progress starts over.
+func UploadProgress(fn func(sent, total int64)) UploadOption {
+	return func(c *uploadConfig) {
+		c.onProgress = fn
+	}
+}
+
+// progressReader reports the progress of reading the content.
+type progressReader struct {
+	r          io.Reader
+	sent       int64
+	total      int64
+	onProgress func(sent, total int64)
+}
+
+func (pr *progressReader) Read(p []byte) (int, error) {
+	n, err := pr.r.Read(p)
+	if n > 0 {
+		pr.sent += int64(n)
+		pr.onProgress(pr.sent, pr.total)
+	}
+	return n, err
+}
+
+// seekableProgressReader is a progressReader which can be rewound,
+// so the request body can be replayed on retries.
+type seekableProgressReader struct {
+	progressReader
+	start int64
+}
+
+func (pr *seekableProgressReader) Seek(offset int64, whence int) (int64, error) {
+	pos, err := pr.r.(io.Seeker).Seek(offset, whence)
+	if err == nil {
+		pr.sent = pos - pr.start
+	}
+	return pos, err
+}
+
+// newProgressReader returns a reader reporting the progress of reading r.
+// The returned reader implements io.Seeker if r does.
+func newProgressReader(r io.Reader, size int64, fn func(sent, total int64)) io.Reader {
+	pr := progressReader{r: r, total: size, onProgress: fn}
+
+	rs, ok := r.(io.ReadSeeker)
+	if !ok {
+		return &pr
+	}
+	start, err := rs.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return &pr
+	}
+	if size < 0 {
+		if end, err := rs.Seek(0, io.SeekEnd); err == nil {
+			pr.total = end - start
+		}
+		if _, err := rs.Seek(start, io.SeekStart); err != nil {
+			return &pr
+		}
+	}
+
+	return &seekableProgressReader{progressReader: pr, start: start}
+}
@@ -3,13 +3,18 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"path"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestStoragesService_List(t *testing.T) {
@@ -152,6 +157,135 @@ func TestStorageService_Add(t *testing.T) {
 	}
 }
 
+func TestStorageService_AddReader(t *testing.T) {
+	tests := []struct {
+		name                  string
+		reader                func() io.Reader
+		size                  int64
+		expectedContentLength int64
+		expectedTotal         int64
+	}{
+		{
+			name:                  "seekable reader",
+			reader:                func() io.Reader { return strings.NewReader("file content") },
+			size:                  -1,
+			expectedContentLength: 12,
+			expectedTotal:         12,
+		},
+		{
+			name:                  "seekable reader with size",
+			reader:                func() io.Reader { return strings.NewReader("file content") },
+			size:                  12,
+			expectedContentLength: 12,
+			expectedTotal:         12,
+		},
+		{
+			name:                  "reader with size",
+			reader:                func() io.Reader { return io.MultiReader(strings.NewReader("file "), strings.NewReader("content")) },
+			size:                  12,
+			expectedContentLength: 12,
+			expectedTotal:         12,
+		},
+		{
+			name:                  "reader without size",
+			reader:                func() io.Reader { return io.MultiReader(strings.NewReader("file "), strings.NewReader("content")) },
+			size:                  -1,
+			expectedContentLength: -1,
+			expectedTotal:         -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux, teardown := setupClient()
+			defer teardown()
+
+			mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, http.MethodPost)
+				testHeader(t, r, "Content-Type", "application/json")
+				testHeader(t, r, "Crowdin-API-FileName", "strings+%28en%29.json")
+				testBody(t, r, "file content")
+				assert.Equal(t, tt.expectedContentLength, r.ContentLength)
+
+				fmt.Fprint(w, `{"data": {"id": 1, "fileName": "strings (en).json"}}`)
+			})
+
+			var sent, total int64
+			storage, _, err := client.Storages.AddReader(context.Background(), "strings (en).json", tt.reader(), tt.size,
+				UploadProgress(func(s, t int64) {
+					sent, total = s, t
+				}),
+			)
+			require.NoError(t, err)
+
+			assert.Equal(t, &model.Storage{ID: 1, FileName: "strings (en).json"}, storage)
+			assert.Equal(t, int64(12), sent)
+			assert.Equal(t, tt.expectedTotal, total)
+		})
+	}
+}
+
+func TestStorageService_AddReader_Retry(t *testing.T) {
+	client, mux, teardown := setupClient(WithRetryPolicy(RetryPolicy{
+		MaxAttempts:        3,
+		MinBackoff:         time.Millisecond,
+		MaxBackoff:         time.Millisecond,
+		RetryNonIdempotent: true,
+	}))
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		testBody(t, r, "content")
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"error": {"code": 503, "message": "Service Unavailable"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"id": 1, "fileName": "file.txt"}}`)
+	})
+
+	var progress []int64
+	r := strings.NewReader("skipped content")
+	_, err := r.Seek(8, io.SeekStart)
+	require.NoError(t, err)
+
+	_, _, err = client.Storages.AddReader(context.Background(), "file.txt", r, -1, UploadProgress(func(sent, total int64) {
+		assert.Equal(t, int64(7), total)
+		progress = append(progress, sent)
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, []int64{7, 7}, progress, "progress should start over on retry")
+}
+
+func TestStorageService_AddReader_InvalidArgs(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/storages", func(http.ResponseWriter, *http.Request) {
+		t.Error("request with invalid arguments sent")
+	})
+
+	_, _, err := client.Storages.AddReader(context.Background(), "", strings.NewReader("content"), -1)
+	require.EqualError(t, err, "name cannot be empty")
+
+	_, _, err = client.Storages.AddReader(context.Background(), "file.txt", nil, -1)
+	require.EqualError(t, err, "reader cannot be nil")
+
+	_, _, err = client.Storages.Add(context.Background(), nil)
+	require.EqualError(t, err, "file cannot be nil")
+
+	_, _, err = client.Storages.AddReader(context.Background(), "file.txt", strings.NewReader("content"), 5)
+	require.EqualError(t, err, "content length 5 does not match the body length 7")
+
+	_, _, err = client.Storages.AddReader(context.Background(), "file.txt", strings.NewReader(""), 5,
+		UploadProgress(func(int64, int64) {}))
+	require.EqualError(t, err, "content length 5 does not match the body length 0")
+}
+
 func openFile(name, content string) (*os.File, string, error) {
 	dir, err := os.MkdirTemp("", "crowdin")
 	if err != nil {
@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -20,6 +21,7 @@ type StringCommentsService struct {
 func (s *StringCommentsService) List(ctx context.Context, projectID int, opts *model.StringCommentsListOptions) (
 	[]*model.StringComment, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "StringComments.List")
 	res := new(model.StringCommentsListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/comments", projectID), opts, res)
 	if err != nil {
@@ -34,12 +36,33 @@ func (s *StringCommentsService) List(ctx context.Context, projectID int, opts *m
 	return list, resp, nil
 }
 
+// All returns an iterator over all string comments.
+// It fetches the pages with List until a page shorter than the limit is returned.
+func (s *StringCommentsService) All(
+	ctx context.Context, projectID int, opts *model.StringCommentsListOptions,
+) iter.Seq2[*model.StringComment, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.StringComment, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.List(ctx, projectID, &pageOpts)
+	})
+}
+
+// ListAll returns all string comments by fetching every page with List.
+func (s *StringCommentsService) ListAll(ctx context.Context, projectID int, opts *model.StringCommentsListOptions) (
+	[]*model.StringComment, error,
+) {
+	return Collect(s.All(ctx, projectID, opts))
+}
+
 // Get returns a string comment by its ID.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.comments.post
 func (s *StringCommentsService) Get(ctx context.Context, projectID, commentID int) (
 	*model.StringComment, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "StringComments.Get")
 	res := new(model.StringCommentsResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/comments/%d", projectID, commentID), nil, res)
 
@@ -52,6 +75,7 @@ func (s *StringCommentsService) Get(ctx context.Context, projectID, commentID in
 func (s *StringCommentsService) Add(ctx context.Context, projectID int, req *model.StringCommentsAddRequest) (
 	*model.StringComment, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "StringComments.Add")
 	res := new(model.StringCommentsResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/comments", projectID), req, res)
 
@@ -70,6 +94,7 @@ func (s *StringCommentsService) Add(ctx context.Context, projectID int, req *mod
 func (s *StringCommentsService) Edit(ctx context.Context, projectID, commentID int, req []*model.UpdateRequest) (
 	*model.StringComment, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "StringComments.Edit")
 	res := new(model.StringCommentsResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/comments/%d", projectID, commentID), req, res)
 
@@ -80,5 +105,6 @@ func (s *StringCommentsService) Edit(ctx context.Context, projectID, commentID i
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.comments.delete
 func (s *StringCommentsService) Delete(ctx context.Context, projectID, commentID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "StringComments.Delete")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/comments/%d", projectID, commentID))
 }
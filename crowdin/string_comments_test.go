@@ -57,8 +57,8 @@ func TestStringCommentsService_Get(t *testing.T) {
 			FullName:  "John Smith",
 			AvatarURL: "",
 		},
-		ResolvedAt: "2023-09-20T11:05:24+00:00",
-		CreatedAt:  "2023-09-20T11:05:24+00:00",
+		ResolvedAt: testTime("2023-09-20T11:05:24+00:00"),
+		CreatedAt:  testTime("2023-09-20T11:05:24+00:00"),
 		IsShared:   ToPtr(false),
 		SenderOrganization: &model.Organization{
 			ID:     200000101,
@@ -257,8 +257,8 @@ func TestStringCommentsService_Add(t *testing.T) {
 			FullName:  "John Smith",
 			AvatarURL: "",
 		},
-		ResolvedAt: "2023-09-20T11:05:24+00:00",
-		CreatedAt:  "2023-09-20T11:05:24+00:00",
+		ResolvedAt: testTime("2023-09-20T11:05:24+00:00"),
+		CreatedAt:  testTime("2023-09-20T11:05:24+00:00"),
 		IsShared:   ToPtr(false),
 		SenderOrganization: &model.Organization{
 			ID:     200000101,
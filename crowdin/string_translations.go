@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -20,6 +21,7 @@ type StringTranslationsService struct {
 func (s *StringTranslationsService) ListApprovals(ctx context.Context, projectID int, opts *model.ApprovalsListOptions) (
 	[]*model.Approval, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "StringTranslations.ListApprovals")
 	res := new(model.ApprovalsListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/approvals", projectID), opts, res)
 	if err != nil {
@@ -34,10 +36,31 @@ func (s *StringTranslationsService) ListApprovals(ctx context.Context, projectID
 	return list, resp, nil
 }
 
+// AllApprovals returns an iterator over all translation approvals.
+// It fetches the pages with ListApprovals until a page shorter than the limit is returned.
+func (s *StringTranslationsService) AllApprovals(
+	ctx context.Context, projectID int, opts *model.ApprovalsListOptions,
+) iter.Seq2[*model.Approval, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.Approval, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListApprovals(ctx, projectID, &pageOpts)
+	})
+}
+
+// ListAllApprovals returns all translation approvals by fetching every page with ListApprovals.
+func (s *StringTranslationsService) ListAllApprovals(ctx context.Context, projectID int, opts *model.ApprovalsListOptions) (
+	[]*model.Approval, error,
+) {
+	return Collect(s.AllApprovals(ctx, projectID, opts))
+}
+
 // GetApproval returns a single translation approval by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.approvals.get
 func (s *StringTranslationsService) GetApproval(ctx context.Context, projectID, approvalID int) (*model.Approval, *Response, error) {
+	ctx = WithOperationName(ctx, "StringTranslations.GetApproval")
 	res := new(model.ApprovalsGetResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/approvals/%d", projectID, approvalID), nil, res)
 
@@ -50,6 +73,7 @@ func (s *StringTranslationsService) GetApproval(ctx context.Context, projectID,
 func (s *StringTranslationsService) AddApproval(ctx context.Context, projectID, translationID int) (
 	*model.Approval, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "StringTranslations.AddApproval")
 	req := struct {
 		TranslationID int `json:"translationId"`
 	}{TranslationID: translationID}
@@ -64,6 +88,7 @@ func (s *StringTranslationsService) AddApproval(ctx context.Context, projectID,
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.approvals.delete
 func (s *StringTranslationsService) RemoveApproval(ctx context.Context, projectID, approvalID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "StringTranslations.RemoveApproval")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/approvals/%d", projectID, approvalID))
 }
 
@@ -73,6 +98,7 @@ func (s *StringTranslationsService) RemoveApproval(ctx context.Context, projectI
 func (s *StringTranslationsService) TranslationAlignment(ctx context.Context, projectID int, req *model.TranslationAlignmentRequest) (
 	*model.TranslationAlignment, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "StringTranslations.TranslationAlignment")
 	res := new(model.TranslationAlignmentResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/alignment", projectID), req, res)
 
@@ -92,6 +118,7 @@ func (s *StringTranslationsService) ListLanguageTranslations(
 	opts *model.LanguageTranslationsListOptions) (
 	[]*model.LanguageTranslation, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "StringTranslations.ListLanguageTranslations")
 	res := new(model.LanguageTranslationsListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/languages/%s/translations", projectID, languageID), opts, res)
 	if err != nil {
@@ -106,6 +133,26 @@ func (s *StringTranslationsService) ListLanguageTranslations(
 	return list, resp, nil
 }
 
+// AllLanguageTranslations returns an iterator over all translations in the language.
+// It fetches the pages with ListLanguageTranslations until a page shorter than the limit is returned.
+func (s *StringTranslationsService) AllLanguageTranslations(
+	ctx context.Context, projectID int, languageID string, opts *model.LanguageTranslationsListOptions,
+) iter.Seq2[*model.LanguageTranslation, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.LanguageTranslation, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListLanguageTranslations(ctx, projectID, languageID, &pageOpts)
+	})
+}
+
+// ListAllLanguageTranslations returns all translations in the language by fetching every page with ListLanguageTranslations.
+func (s *StringTranslationsService) ListAllLanguageTranslations(ctx context.Context, projectID int, languageID string, opts *model.LanguageTranslationsListOptions) (
+	[]*model.LanguageTranslation, error,
+) {
+	return Collect(s.AllLanguageTranslations(ctx, projectID, languageID, opts))
+}
+
 // ListStringTranslations returns a list of string translations.
 //
 // Note: For instant translation delivery to your mobile, web, server, or desktop apps,
@@ -115,6 +162,7 @@ func (s *StringTranslationsService) ListLanguageTranslations(
 func (s *StringTranslationsService) ListStringTranslations(ctx context.Context, projectID int, opts *model.StringTranslationsListOptions) (
 	[]*model.Translation, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "StringTranslations.ListStringTranslations")
 	res := new(model.TranslationsListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/translations", projectID), opts, res)
 	if err != nil {
@@ -129,12 +177,33 @@ func (s *StringTranslationsService) ListStringTranslations(ctx context.Context,
 	return list, resp, nil
 }
 
+// AllStringTranslations returns an iterator over all string translations.
+// It fetches the pages with ListStringTranslations until a page shorter than the limit is returned.
+func (s *StringTranslationsService) AllStringTranslations(
+	ctx context.Context, projectID int, opts *model.StringTranslationsListOptions,
+) iter.Seq2[*model.Translation, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.Translation, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListStringTranslations(ctx, projectID, &pageOpts)
+	})
+}
+
+// ListAllStringTranslations returns all string translations by fetching every page with ListStringTranslations.
+func (s *StringTranslationsService) ListAllStringTranslations(ctx context.Context, projectID int, opts *model.StringTranslationsListOptions) (
+	[]*model.Translation, error,
+) {
+	return Collect(s.AllStringTranslations(ctx, projectID, opts))
+}
+
 // DeleteStringTranslations deletes string translations by its identifiers.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.translations.deleteMany
 func (s *StringTranslationsService) DeleteStringTranslations(ctx context.Context, projectID, stringID int, languageID string) (
 	*Response, error,
 ) {
+	ctx = WithOperationName(ctx, "StringTranslations.DeleteStringTranslations")
 	path := fmt.Sprintf("/api/v2/projects/%d/translations?stringId=%d&languageId=%s", projectID, stringID, languageID)
 	return s.client.Delete(ctx, path)
 }
@@ -145,6 +214,7 @@ func (s *StringTranslationsService) DeleteStringTranslations(ctx context.Context
 func (s *StringTranslationsService) GetTranslation(ctx context.Context, projectID, translationID int, opts *model.TranslationGetOptions) (
 	*model.Translation, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "StringTranslations.GetTranslation")
 	res := new(model.TranslationGetResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/%d", projectID, translationID), opts, res)
 
@@ -157,6 +227,7 @@ func (s *StringTranslationsService) GetTranslation(ctx context.Context, projectI
 func (s *StringTranslationsService) AddTranslation(ctx context.Context, projectID int, req *model.TranslationAddRequest) (
 	*model.Translation, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "StringTranslations.AddTranslation")
 	res := new(model.TranslationGetResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/translations", projectID), req, res)
 
@@ -169,6 +240,7 @@ func (s *StringTranslationsService) AddTranslation(ctx context.Context, projectI
 func (s *StringTranslationsService) RestoreTranslation(ctx context.Context, projectID, translationID int) (
 	*model.Translation, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "StringTranslations.RestoreTranslation")
 	res := new(model.TranslationGetResponse)
 	resp, err := s.client.Put(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/%d", projectID, translationID), nil, res)
 
@@ -179,6 +251,7 @@ func (s *StringTranslationsService) RestoreTranslation(ctx context.Context, proj
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.translations.delete
 func (s *StringTranslationsService) DeleteTranslation(ctx context.Context, projectID, translationID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "StringTranslations.DeleteTranslation")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/%d", projectID, translationID))
 }
 
@@ -191,6 +264,7 @@ func (s *StringTranslationsService) DeleteTranslation(ctx context.Context, proje
 func (s *StringTranslationsService) ListVotes(ctx context.Context, projectID int, opts *model.VotesListOptions) (
 	[]*model.Vote, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "StringTranslations.ListVotes")
 	res := new(model.VotesListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/votes", projectID), opts, res)
 	if err != nil {
@@ -205,10 +279,31 @@ func (s *StringTranslationsService) ListVotes(ctx context.Context, projectID int
 	return list, resp, nil
 }
 
+// AllVotes returns an iterator over all translation votes.
+// It fetches the pages with ListVotes until a page shorter than the limit is returned.
+func (s *StringTranslationsService) AllVotes(
+	ctx context.Context, projectID int, opts *model.VotesListOptions,
+) iter.Seq2[*model.Vote, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.Vote, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListVotes(ctx, projectID, &pageOpts)
+	})
+}
+
+// ListAllVotes returns all translation votes by fetching every page with ListVotes.
+func (s *StringTranslationsService) ListAllVotes(ctx context.Context, projectID int, opts *model.VotesListOptions) (
+	[]*model.Vote, error,
+) {
+	return Collect(s.AllVotes(ctx, projectID, opts))
+}
+
 // GetVote gets a single translation vote by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.votes.get
 func (s *StringTranslationsService) GetVote(ctx context.Context, projectID, voteID int) (*model.Vote, *Response, error) {
+	ctx = WithOperationName(ctx, "StringTranslations.GetVote")
 	res := new(model.VoteGetResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/votes/%d", projectID, voteID), nil, res)
 
@@ -221,6 +316,7 @@ func (s *StringTranslationsService) GetVote(ctx context.Context, projectID, vote
 func (s *StringTranslationsService) AddVote(ctx context.Context, projectID int, req *model.VoteAddRequest) (
 	*model.Vote, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "StringTranslations.AddVote")
 	res := new(model.VoteGetResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/votes", projectID), req, res)
 
@@ -231,5 +327,6 @@ func (s *StringTranslationsService) AddVote(ctx context.Context, projectID int,
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.votes.delete
 func (s *StringTranslationsService) CancelVote(ctx context.Context, projectID, voteID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "StringTranslations.CancelVote")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/votes/%d", projectID, voteID))
 }
@@ -95,7 +95,7 @@ func TestStringTranslationsService_ListApprovals(t *testing.T) {
 				TranslationID: 190695,
 				StringID:      2345,
 				LanguageID:    "uk",
-				CreatedAt:     "2023-09-19T12:42:12+00:00",
+				CreatedAt:     testTime("2023-09-19T12:42:12+00:00"),
 			},
 		}
 		assert.Equal(t, expected, list)
@@ -145,7 +145,7 @@ func TestStringTranslationsService_GetApproval(t *testing.T) {
 		TranslationID: 190695,
 		StringID:      2345,
 		LanguageID:    "uk",
-		CreatedAt:     "2023-09-19T12:42:12+00:00",
+		CreatedAt:     testTime("2023-09-19T12:42:12+00:00"),
 	}
 	assert.Equal(t, expected, approval)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
@@ -193,7 +193,7 @@ func TestStringTranslationsService_AddApproval(t *testing.T) {
 		TranslationID: 190695,
 		StringID:      2345,
 		LanguageID:    "uk",
-		CreatedAt:     "2023-09-19T12:42:12+00:00",
+		CreatedAt:     testTime("2023-09-19T12:42:12+00:00"),
 	}
 	assert.Equal(t, expected, approval)
 	assert.Equal(t, http.StatusCreated, resp.StatusCode)
@@ -306,7 +306,7 @@ func TestStringTranslationsService_ListLanguageTranslations(t *testing.T) {
 					FullName:  "John Smith",
 					AvatarURL: "",
 				},
-				CreatedAt: ToPtr("2023-09-23T11:26:54+00:00"),
+				CreatedAt: ToPtr(testTime("2023-09-23T11:26:54+00:00")),
 			},
 		}
 		assert.Equal(t, expected, list)
@@ -416,7 +416,7 @@ func TestStringTranslationsService_ListStringTranslations(t *testing.T) {
 				Rating:          10,
 				Provider:        ToPtr("tm"),
 				IsPreTranslated: true,
-				CreatedAt:       "2023-09-23T11:26:54+00:00",
+				CreatedAt:       testTime("2023-09-23T11:26:54+00:00"),
 			},
 		}
 		assert.Equal(t, expected, list)
@@ -588,7 +588,7 @@ func TestStringTranslationsService_GetTranslation(t *testing.T) {
 			Rating:          10,
 			Provider:        ToPtr("tm"),
 			IsPreTranslated: true,
-			CreatedAt:       "2023-09-23T11:26:54+00:00",
+			CreatedAt:       testTime("2023-09-23T11:26:54+00:00"),
 		}
 		assert.Equal(t, expected, translation)
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
@@ -646,7 +646,7 @@ func TestStringTranslationsService_AddTranslation(t *testing.T) {
 		Rating:          10,
 		Provider:        ToPtr("tm"),
 		IsPreTranslated: true,
-		CreatedAt:       "2023-09-23T11:26:54+00:00",
+		CreatedAt:       testTime("2023-09-23T11:26:54+00:00"),
 	}
 	assert.Equal(t, expected, translation)
 	assert.Equal(t, http.StatusCreated, resp.StatusCode)
@@ -741,7 +741,7 @@ func TestStringTranslationsService_RestoreTranslation(t *testing.T) {
 		Rating:          10,
 		Provider:        ToPtr("tm"),
 		IsPreTranslated: true,
-		CreatedAt:       "2023-09-23T11:26:54+00:00",
+		CreatedAt:       testTime("2023-09-23T11:26:54+00:00"),
 	}
 	assert.Equal(t, expected, translation)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
@@ -796,7 +796,7 @@ func TestStringTranslationsService_GetVote(t *testing.T) {
 		ID:            6643,
 		User:          &model.ShortUser{ID: 19, Username: "john_doe", FullName: "John Smith", AvatarURL: ""},
 		TranslationID: 19069345,
-		VotedAt:       "2023-09-19T12:42:12+00:00",
+		VotedAt:       testTime("2023-09-19T12:42:12+00:00"),
 		Mark:          "up",
 	}
 	assert.Equal(t, expected, vote)
@@ -927,7 +927,7 @@ func TestStringTranslationsService_AddVote(t *testing.T) {
 		ID:            6643,
 		User:          &model.ShortUser{ID: 19, Username: "john_doe", FullName: "John Smith", AvatarURL: ""},
 		TranslationID: 19069345,
-		VotedAt:       "2023-09-19T12:42:12+00:00",
+		VotedAt:       testTime("2023-09-19T12:42:12+00:00"),
 		Mark:          "up",
 	}
 	assert.Equal(t, expected, vote)
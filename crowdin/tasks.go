@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -23,6 +24,7 @@ type TasksService struct {
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.tasks.getMany
 func (s *TasksService) List(ctx context.Context, projectID int, opts *model.TasksListOptions) ([]*model.Task, *Response, error) {
+	ctx = WithOperationName(ctx, "Tasks.List")
 	res := new(model.TasksListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks", projectID), opts, res)
 	if err != nil {
@@ -37,10 +39,31 @@ func (s *TasksService) List(ctx context.Context, projectID int, opts *model.Task
 	return list, resp, err
 }
 
+// All returns an iterator over all tasks in the project.
+// It fetches the pages with List until a page shorter than the limit is returned.
+func (s *TasksService) All(
+	ctx context.Context, projectID int, opts *model.TasksListOptions,
+) iter.Seq2[*model.Task, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.Task, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.List(ctx, projectID, &pageOpts)
+	})
+}
+
+// ListAll returns all tasks in the project by fetching every page with List.
+func (s *TasksService) ListAll(ctx context.Context, projectID int, opts *model.TasksListOptions) (
+	[]*model.Task, error,
+) {
+	return Collect(s.All(ctx, projectID, opts))
+}
+
 // Get returns a single task in a project by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.tasks.get
 func (s *TasksService) Get(ctx context.Context, projectID, taskID int) (*model.Task, *Response, error) {
+	ctx = WithOperationName(ctx, "Tasks.Get")
 	res := new(model.TaskResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d", projectID, taskID), nil, res)
 
@@ -51,6 +74,7 @@ func (s *TasksService) Get(ctx context.Context, projectID, taskID int) (*model.T
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.tasks.post
 func (s *TasksService) Add(ctx context.Context, projectID int, req model.TaskAddRequest) (*model.Task, *Response, error) {
+	ctx = WithOperationName(ctx, "Tasks.Add")
 	res := new(model.TaskResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks", projectID), req, res)
 
@@ -84,8 +108,11 @@ func (s *TasksService) Add(ctx context.Context, projectID int, req model.TaskAdd
 //   - path (string <json-pointer>): JSON path to the field to be updated. Enum: "/title", "/description".
 //   - value (any): Value to be set. Enum: string, bool, array of integers, array of objects.
 //
+// Use model.TaskPatch to build the request with the checked paths.
+//
 // https://developer.crowdin.com/api/v2/#operation/api.projects.tasks.patch
 func (s *TasksService) Edit(ctx context.Context, projectID, taskID int, req []*model.UpdateRequest) (*model.Task, *Response, error) {
+	ctx = WithOperationName(ctx, "Tasks.Edit")
 	res := new(model.TaskResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d", projectID, taskID), req, res)
 
@@ -96,6 +123,7 @@ func (s *TasksService) Edit(ctx context.Context, projectID, taskID int, req []*m
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.tasks.delete
 func (s *TasksService) Delete(ctx context.Context, projectID, taskID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Tasks.Delete")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d", projectID, taskID))
 }
 
@@ -103,6 +131,7 @@ func (s *TasksService) Delete(ctx context.Context, projectID, taskID int) (*Resp
 //
 // https://developer.crowdin.com/api/v2/#operation/api.user.tasks.getMany
 func (s *TasksService) ListUserTasks(ctx context.Context, opts *model.UserTasksListOptions) ([]*model.Task, *Response, error) {
+	ctx = WithOperationName(ctx, "Tasks.ListUserTasks")
 	res := new(model.TasksListResponse)
 	resp, err := s.client.Get(ctx, "/api/v2/user/tasks", opts, res)
 	if err != nil {
@@ -117,6 +146,24 @@ func (s *TasksService) ListUserTasks(ctx context.Context, opts *model.UserTasksL
 	return list, resp, err
 }
 
+// AllUserTasks returns an iterator over all tasks of the authorized user.
+// It fetches the pages with ListUserTasks until a page shorter than the limit is returned.
+func (s *TasksService) AllUserTasks(
+	ctx context.Context, opts *model.UserTasksListOptions,
+) iter.Seq2[*model.Task, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.Task, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListUserTasks(ctx, &pageOpts)
+	})
+}
+
+// ListAllUserTasks returns all tasks of the authorized user by fetching every page with ListUserTasks.
+func (s *TasksService) ListAllUserTasks(ctx context.Context, opts *model.UserTasksListOptions) ([]*model.Task, error) {
+	return Collect(s.AllUserTasks(ctx, opts))
+}
+
 // EditArchivedStatus changes the archived status of the task.
 //
 // Request body:
@@ -129,6 +176,7 @@ func (s *TasksService) ListUserTasks(ctx context.Context, opts *model.UserTasksL
 func (s *TasksService) EditArchivedStatus(ctx context.Context, projectID, taskID int, req []*model.UpdateRequest) (
 	*model.Task, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Tasks.EditArchivedStatus")
 	res := new(model.TaskResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/tasks/%d?projectId=%d", taskID, projectID), req, res)
 
@@ -139,6 +187,7 @@ func (s *TasksService) EditArchivedStatus(ctx context.Context, projectID, taskID
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.tasks.exports.post
 func (s *TasksService) ExportStrings(ctx context.Context, projectID, taskID int) (*model.DownloadLink, *Response, error) {
+	ctx = WithOperationName(ctx, "Tasks.ExportStrings")
 	res := new(model.DownloadLinkResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d/exports", projectID, taskID), "", res)
 
@@ -151,6 +200,7 @@ func (s *TasksService) ExportStrings(ctx context.Context, projectID, taskID int)
 func (s *TasksService) ListSettingsTemplates(ctx context.Context, projectID int, opts *model.ListOptions) (
 	[]*model.TaskSettingsTemplate, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Tasks.ListSettingsTemplates")
 	res := new(model.TaskSettingsTemplatesListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/settings-templates", projectID), opts, res)
 	if err != nil {
@@ -165,12 +215,31 @@ func (s *TasksService) ListSettingsTemplates(ctx context.Context, projectID int,
 	return list, resp, err
 }
 
+// AllSettingsTemplates returns an iterator over all task settings templates.
+// It fetches the pages with ListSettingsTemplates until a page shorter than the limit is returned.
+func (s *TasksService) AllSettingsTemplates(
+	ctx context.Context, projectID int, opts *model.ListOptions,
+) iter.Seq2[*model.TaskSettingsTemplate, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, *o, func(ctx context.Context, page model.ListOptions) ([]*model.TaskSettingsTemplate, *Response, error) {
+		return s.ListSettingsTemplates(ctx, projectID, &page)
+	})
+}
+
+// ListAllSettingsTemplates returns all task settings templates by fetching every page with ListSettingsTemplates.
+func (s *TasksService) ListAllSettingsTemplates(ctx context.Context, projectID int, opts *model.ListOptions) (
+	[]*model.TaskSettingsTemplate, error,
+) {
+	return Collect(s.AllSettingsTemplates(ctx, projectID, opts))
+}
+
 // GetSettingsTemplate returns a single task settings template in a project by its identifier.
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.tasks.settings-templates.get
 func (s *TasksService) GetSettingsTemplate(ctx context.Context, projectID, taskSettingTemplateID int) (
 	*model.TaskSettingsTemplate, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Tasks.GetSettingsTemplate")
 	path := fmt.Sprintf("/api/v2/projects/%d/tasks/settings-templates/%d", projectID, taskSettingTemplateID)
 	res := new(model.TaskSettingsTemplateResponse)
 	resp, err := s.client.Get(ctx, path, nil, res)
@@ -184,6 +253,7 @@ func (s *TasksService) GetSettingsTemplate(ctx context.Context, projectID, taskS
 func (s *TasksService) AddSettingsTemplate(ctx context.Context, projectID int, req *model.TaskSettingsTemplateAddRequest) (
 	*model.TaskSettingsTemplate, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Tasks.AddSettingsTemplate")
 	res := new(model.TaskSettingsTemplateResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/settings-templates", projectID), req, res)
 
@@ -201,6 +271,7 @@ func (s *TasksService) AddSettingsTemplate(ctx context.Context, projectID int, r
 func (s *TasksService) EditSettingsTemplate(ctx context.Context, projectID, taskSettingTemplateID int, req []*model.UpdateRequest) (
 	*model.TaskSettingsTemplate, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Tasks.EditSettingsTemplate")
 	path := fmt.Sprintf("/api/v2/projects/%d/tasks/settings-templates/%d", projectID, taskSettingTemplateID)
 	res := new(model.TaskSettingsTemplateResponse)
 	resp, err := s.client.Patch(ctx, path, req, res)
@@ -212,5 +283,6 @@ func (s *TasksService) EditSettingsTemplate(ctx context.Context, projectID, task
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.tasks.settings-templates.delete
 func (s *TasksService) DeleteSettingsTemplate(ctx context.Context, projectID, taskSettingTemplateID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Tasks.DeleteSettingsTemplate")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/settings-templates/%d", projectID, taskSettingTemplateID))
 }
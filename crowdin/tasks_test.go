@@ -156,14 +156,14 @@ func TestTasksService_Get(t *testing.T) {
 		WebURL:           "https://crowdin.com/project/example-project/tasks/1",
 		WordsCount:       24,
 		CommentsCount:    0,
-		Deadline:         "2023-09-27T07:00:14+00:00",
-		StartedAt:        "2023-09-27T07:00:14+00:00",
-		ResolvedAt:       "2023-09-27T07:00:14+00:00",
+		Deadline:         testTime("2023-09-27T07:00:14+00:00"),
+		StartedAt:        testTime("2023-09-27T07:00:14+00:00"),
+		ResolvedAt:       testTime("2023-09-27T07:00:14+00:00"),
 		TimeRange:        "2023-08-23T09:04:29+00:00|2019-07-23T09:04:29+00:00",
 		WorkflowStepID:   10,
 		BuyURL:           "https://www.paypal.com/cgi-bin/webscr?cmd=...",
-		CreatedAt:        "2023-09-23T09:04:29+00:00",
-		UpdatedAt:        "2023-09-23T09:04:29+00:00",
+		CreatedAt:        testTime("2023-09-23T09:04:29+00:00"),
+		UpdatedAt:        testTime("2023-09-23T09:04:29+00:00"),
 		SourceLanguage: &model.Language{
 			ID:                  "es",
 			Name:                "Spanish",
@@ -426,10 +426,10 @@ func TestTasksService_Add_TaskCreateForm(t *testing.T) {
 		SkipAssignedStrings:             ToPtr(true),
 		IncludePreTranslatedStringsOnly: ToPtr(true),
 		Assignees:                       []model.CrowdinTaskAssignee{{ID: 1, WordsCount: 5}},
-		Deadline:                        "2023-09-27T07:00:14+00:00",
-		StartedAt:                       "2023-08-27T07:00:14+00:00",
-		DateFrom:                        "2023-08-23T09:04:29+00:00",
-		DateTo:                          "2023-09-23T09:04:29+00:00",
+		Deadline:                        ToPtr(testTime("2023-09-27T07:00:14+00:00")),
+		StartedAt:                       ToPtr(testTime("2023-08-27T07:00:14+00:00")),
+		DateFrom:                        ToPtr(testTime("2023-08-23T09:04:29+00:00")),
+		DateTo:                          ToPtr(testTime("2023-09-23T09:04:29+00:00")),
 	}
 	task, resp, err := client.Tasks.Add(context.Background(), 1, req)
 	require.NoError(t, err)
@@ -470,14 +470,14 @@ func TestTasksService_Add_TaskCreateForm(t *testing.T) {
 		WebURL:           "https://crowdin.com/project/example-project/tasks/1",
 		WordsCount:       24,
 		CommentsCount:    0,
-		Deadline:         "2023-09-27T07:00:14+00:00",
-		StartedAt:        "2023-09-27T07:00:14+00:00",
-		ResolvedAt:       "2023-09-27T07:00:14+00:00",
+		Deadline:         testTime("2023-09-27T07:00:14+00:00"),
+		StartedAt:        testTime("2023-09-27T07:00:14+00:00"),
+		ResolvedAt:       testTime("2023-09-27T07:00:14+00:00"),
 		TimeRange:        "2023-08-23T09:04:29+00:00|2019-07-23T09:04:29+00:00",
 		WorkflowStepID:   10,
 		BuyURL:           "https://www.paypal.com/cgi-bin/webscr?cmd=...",
-		CreatedAt:        "2023-09-23T09:04:29+00:00",
-		UpdatedAt:        "2023-09-23T09:04:29+00:00",
+		CreatedAt:        testTime("2023-09-23T09:04:29+00:00"),
+		UpdatedAt:        testTime("2023-09-23T09:04:29+00:00"),
 		SourceLanguage: &model.Language{
 			ID:                  "es",
 			Name:                "Spanish",
@@ -1401,7 +1401,7 @@ func TestTasksService_ExportStrings(t *testing.T) {
 	assert.NotNil(t, resp)
 
 	assert.Equal(t, "https://production-enterprise-importer.downloads.crowdin.com/992000002/2/14.xliff", link.URL)
-	assert.Equal(t, "2023-09-27T07:00:14+00:00", link.ExpireIn)
+	assert.Equal(t, "2023-09-27T07:00:14+00:00", link.ExpireIn.String())
 }
 
 func TestTasksService_ExportStrings_NoStrings(t *testing.T) {
@@ -1464,8 +1464,8 @@ func TestTasksService_GetSettingsTepmlate(t *testing.T) {
 				},
 			},
 		},
-		CreatedAt: "2023-09-23T11:26:54+00:00",
-		UpdatedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt: testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	assert.Equal(t, expected, template)
 }
@@ -1549,8 +1549,8 @@ func TestTasksService_ListSettingsTepmlates(t *testing.T) {
 							},
 						},
 					},
-					CreatedAt: "2023-09-23T11:26:54+00:00",
-					UpdatedAt: "2023-09-23T11:26:54+00:00",
+					CreatedAt: testTime("2023-09-23T11:26:54+00:00"),
+					UpdatedAt: testTime("2023-09-23T11:26:54+00:00"),
 				},
 			}
 			assert.Equal(t, expected, templates)
@@ -1616,8 +1616,8 @@ func TestTasksService_AddSettingsTepmlates(t *testing.T) {
 				},
 			},
 		},
-		CreatedAt: "2023-09-23T11:26:54+00:00",
-		UpdatedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt: testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	assert.Equal(t, expected, template)
 }
@@ -1705,8 +1705,8 @@ func TestTasksService_EditSettingsTepmlates(t *testing.T) {
 				},
 			},
 		},
-		CreatedAt: "2023-09-23T11:26:54+00:00",
-		UpdatedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt: testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	assert.Equal(t, expected, template)
 }
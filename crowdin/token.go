@@ -0,0 +1,153 @@
+package crowdin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenExpiryDelta is how long before the expiry a token is refreshed,
+// so it does not expire while a request is in flight.
+const tokenExpiryDelta = time.Minute
+
+// Token is an access token used to authorize API requests.
+type Token struct {
+	// AccessToken is the token sent in the Authorization header.
+	AccessToken string
+	// Expiry is the expiration time of the token.
+	// A zero value means that the token does not expire.
+	Expiry time.Time
+}
+
+// Valid reports whether the token is set and does not expire
+// within the next minute.
+func (t *Token) Valid() bool {
+	return t != nil && t.AccessToken != "" &&
+		(t.Expiry.IsZero() || time.Now().Add(tokenExpiryDelta).Before(t.Expiry))
+}
+
+// TokenSource returns access tokens, e.g. by refreshing an OAuth token.
+type TokenSource interface {
+	// Token returns a new token. It is called only when the cached
+	// token is missing, expires soon or is rejected by the API.
+	Token(ctx context.Context) (*Token, error)
+}
+
+// TokenSourceFunc is an adapter to allow the use of ordinary functions
+// as token sources.
+type TokenSourceFunc func(ctx context.Context) (*Token, error)
+
+// Token calls f(ctx).
+func (f TokenSourceFunc) Token(ctx context.Context) (*Token, error) {
+	return f(ctx)
+}
+
+// WithTokenSource sets the source of access tokens, e.g. for OAuth tokens
+// which expire. The token passed to NewClient is ignored and can be empty.
+//
+// The token is cached and refreshed a minute before it expires. If the API
+// rejects a token with the 401 Unauthorized status, the request is sent once
+// more with a new token (unless its body cannot be replayed).
+// The token source is called by one request at a time.
+//
+// Example:
+//
+//	client, err := crowdin.NewClient("", crowdin.WithTokenSource(crowdin.TokenSourceFunc(
+//		func(ctx context.Context) (*crowdin.Token, error) {
+//			tok, err := refreshOAuthToken(ctx)
+//			if err != nil {
+//				return nil, err
+//			}
+//			return &crowdin.Token{AccessToken: tok.AccessToken, Expiry: tok.Expiry}, nil
+//		},
+//	)))
+func WithTokenSource(ts TokenSource) ClientOption {
+	return func(c *Client) error {
+		if ts == nil {
+			return errors.New("token source cannot be nil")
+		}
+		c.tokenSource = &cachingTokenSource{source: ts}
+		return nil
+	}
+}
+
+// cachingTokenSource caches the token of the underlying source
+// until it expires or is invalidated. It is safe for concurrent use.
+type cachingTokenSource struct {
+	source TokenSource
+
+	mu    sync.Mutex
+	token *Token
+}
+
+// Token returns the cached token or a new one if the cached token is not valid.
+func (s *cachingTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Valid() {
+		return s.token, nil
+	}
+
+	tok, err := s.source.Token(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("client: error getting access token: %w", err)
+	}
+	if tok == nil || tok.AccessToken == "" {
+		return nil, errors.New("client: token source returned an empty token")
+	}
+	s.token = tok
+
+	return tok, nil
+}
+
+// invalidate drops the cached token if it is still the given one,
+// so the next call to Token refreshes it.
+func (s *cachingTokenSource) invalidate(accessToken string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && s.token.AccessToken == accessToken {
+		s.token = nil
+	}
+}
+
+// authorize sets the Authorization header of the request.
+func (c *Client) authorize(r *http.Request) error {
+	token := c.token
+	if c.tokenSource != nil {
+		tok, err := c.tokenSource.Token(r.Context())
+		if err != nil {
+			return err
+		}
+		token = tok.AccessToken
+	}
+
+	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return nil
+}
+
+// reauthorize returns a copy of the request rejected with the 401 Unauthorized
+// status with a new token. It reports false if the token cannot be refreshed.
+func (c *Client) reauthorize(r *http.Request) (*http.Request, bool) {
+	if c.tokenSource == nil || !isReplayable(r) {
+		return nil, false
+	}
+
+	accessToken, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	c.tokenSource.invalidate(accessToken)
+
+	next, err := rewindRequest(r)
+	if err != nil {
+		return nil, false
+	}
+	if err := c.authorize(next); err != nil {
+		return nil, false
+	}
+
+	return next, true
+}
@@ -0,0 +1,171 @@
+package crowdin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenSequence returns a token source issuing the given tokens one by one
+// and counting the calls.
+func tokenSequence(calls *atomic.Int32, expiry time.Duration, tokens ...string) TokenSource {
+	return TokenSourceFunc(func(context.Context) (*Token, error) {
+		n := int(calls.Add(1))
+		tok := tokens[min(n, len(tokens))-1]
+		return &Token{AccessToken: tok, Expiry: time.Now().Add(expiry)}, nil
+	})
+}
+
+func TestClient_TokenSource(t *testing.T) {
+	tests := []struct {
+		name          string
+		expiry        time.Duration
+		expectedCalls int32
+	}{
+		{
+			name:          "valid token is cached",
+			expiry:        time.Hour,
+			expectedCalls: 1,
+		},
+		{
+			name:          "token is refreshed before expiry",
+			expiry:        30 * time.Second,
+			expectedCalls: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			client, mux, teardown := setupClient(WithTokenSource(tokenSequence(&calls, tt.expiry, "token")))
+			defer teardown()
+
+			mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, r *http.Request) {
+				testHeader(t, r, "Authorization", "Bearer token")
+				fmt.Fprint(w, `{"data": {"id": 1}}`)
+			})
+
+			for range 3 {
+				_, _, err := client.Storages.Get(context.Background(), 1)
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedCalls, calls.Load())
+		})
+	}
+}
+
+func TestClient_TokenSource_Unauthorized(t *testing.T) {
+	var calls atomic.Int32
+	client, mux, teardown := setupClient(WithTokenSource(tokenSequence(&calls, time.Hour, "revoked", "fresh")))
+	defer teardown()
+
+	var requests []string
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, r *http.Request) {
+		testBody(t, r, "content")
+		requests = append(requests, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error": {"code": 401, "message": "Unauthorized"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"id": 1}}`)
+	})
+
+	_, _, err := client.Storages.AddReader(context.Background(), "file.txt", strings.NewReader("content"), -1)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Bearer revoked", "Bearer fresh"}, requests)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestClient_TokenSource_UnauthorizedOnce(t *testing.T) {
+	var calls atomic.Int32
+	client, mux, teardown := setupClient(WithTokenSource(tokenSequence(&calls, time.Hour, "token")))
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"error": {"code": 401, "message": "Unauthorized"}}`)
+	})
+
+	_, _, err := client.Storages.Get(context.Background(), 1)
+	require.EqualError(t, err, "401 Unauthorized")
+	assert.True(t, IsUnauthorized(err))
+	assert.Equal(t, 2, requests, "the request should be retried only once")
+}
+
+func TestClient_TokenSource_Concurrent(t *testing.T) {
+	var calls atomic.Int32
+	source := TokenSourceFunc(func(context.Context) (*Token, error) {
+		calls.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		return &Token{AccessToken: "token", Expiry: time.Now().Add(time.Hour)}, nil
+	})
+
+	client, mux, teardown := setupClient(WithTokenSource(source))
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "Bearer token")
+		fmt.Fprint(w, `{"data": {"id": 1}}`)
+	})
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := client.Storages.Get(context.Background(), 1)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestClient_TokenSource_Error(t *testing.T) {
+	source := TokenSourceFunc(func(context.Context) (*Token, error) {
+		return nil, errors.New("invalid refresh token")
+	})
+
+	client, _, teardown := setupClient(WithTokenSource(source))
+	defer teardown()
+
+	_, _, err := client.Storages.Get(context.Background(), 1)
+	require.EqualError(t, err, "client: error getting access token: invalid refresh token")
+}
+
+func TestNewClient_TokenSource(t *testing.T) {
+	_, err := NewClient("")
+	require.EqualError(t, err, "token cannot be empty")
+
+	_, err = NewClient("", WithTokenSource(nil))
+	require.EqualError(t, err, "token source cannot be nil")
+
+	client, err := NewClient("", WithTokenSource(TokenSourceFunc(func(context.Context) (*Token, error) {
+		return &Token{AccessToken: "token"}, nil
+	})))
+	require.NoError(t, err)
+	assert.NotNil(t, client)
+}
+
+func TestToken_Valid(t *testing.T) {
+	var nilToken *Token
+	assert.False(t, nilToken.Valid())
+	assert.False(t, (&Token{}).Valid())
+	assert.True(t, (&Token{AccessToken: "token"}).Valid())
+	assert.True(t, (&Token{AccessToken: "token", Expiry: time.Now().Add(time.Hour)}).Valid())
+	assert.False(t, (&Token{AccessToken: "token", Expiry: time.Now().Add(time.Second)}).Valid())
+}
@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -23,6 +24,7 @@ type TranslationMemoryService struct {
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.get
 func (s *TranslationMemoryService) GetTM(ctx context.Context, tmID int) (*model.TranslationMemory, *Response, error) {
+	ctx = WithOperationName(ctx, "TranslationMemory.GetTM")
 	res := new(model.TranslationMemoryResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/tms/%d", tmID), nil, res)
 
@@ -35,6 +37,7 @@ func (s *TranslationMemoryService) GetTM(ctx context.Context, tmID int) (*model.
 func (s *TranslationMemoryService) ListTMs(ctx context.Context, opts *model.TranslationMemoriesListOptions) (
 	[]*model.TranslationMemory, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationMemory.ListTMs")
 	res := new(model.TranslationMemoriesListResponse)
 	resp, err := s.client.Get(ctx, "/api/v2/tms", opts, res)
 	if err != nil {
@@ -49,12 +52,33 @@ func (s *TranslationMemoryService) ListTMs(ctx context.Context, opts *model.Tran
 	return list, resp, err
 }
 
+// AllTMs returns an iterator over all translation memories.
+// It fetches the pages with ListTMs until a page shorter than the limit is returned.
+func (s *TranslationMemoryService) AllTMs(
+	ctx context.Context, opts *model.TranslationMemoriesListOptions,
+) iter.Seq2[*model.TranslationMemory, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.TranslationMemory, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListTMs(ctx, &pageOpts)
+	})
+}
+
+// ListAllTMs returns all translation memories by fetching every page with ListTMs.
+func (s *TranslationMemoryService) ListAllTMs(ctx context.Context, opts *model.TranslationMemoriesListOptions) (
+	[]*model.TranslationMemory, error,
+) {
+	return Collect(s.AllTMs(ctx, opts))
+}
+
 // AddTM creates a new translation memory.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.post
 func (s *TranslationMemoryService) AddTM(ctx context.Context, req *model.TranslationMemoryAddRequest) (
 	*model.TranslationMemory, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationMemory.AddTM")
 	res := new(model.TranslationMemoryResponse)
 	resp, err := s.client.Post(ctx, "/api/v2/tms", req, res)
 
@@ -72,6 +96,7 @@ func (s *TranslationMemoryService) AddTM(ctx context.Context, req *model.Transla
 func (s *TranslationMemoryService) EditTM(ctx context.Context, tmID int, req []*model.UpdateRequest) (
 	*model.TranslationMemory, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationMemory.EditTM")
 	res := new(model.TranslationMemoryResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/tms/%d", tmID), req, res)
 
@@ -82,6 +107,7 @@ func (s *TranslationMemoryService) EditTM(ctx context.Context, tmID int, req []*
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.delete
 func (s *TranslationMemoryService) DeleteTM(ctx context.Context, tmID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "TranslationMemory.DeleteTM")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/tms/%d", tmID))
 }
 
@@ -91,6 +117,7 @@ func (s *TranslationMemoryService) DeleteTM(ctx context.Context, tmID int) (*Res
 func (s *TranslationMemoryService) ExportTM(ctx context.Context, tmID int, req *model.TranslationMemoryExportRequest) (
 	*model.TranslationMemoryExport, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationMemory.ExportTM")
 	res := new(model.TranslationMemoryExportResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/tms/%d/exports", tmID), req, res)
 
@@ -103,6 +130,7 @@ func (s *TranslationMemoryService) ExportTM(ctx context.Context, tmID int, req *
 func (s *TranslationMemoryService) CheckTMExportStatus(ctx context.Context, tmID int, exportID string) (
 	*model.TranslationMemoryExport, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationMemory.CheckTMExportStatus")
 	res := new(model.TranslationMemoryExportResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/tms/%d/exports/%s", tmID, exportID), nil, res)
 
@@ -115,6 +143,7 @@ func (s *TranslationMemoryService) CheckTMExportStatus(ctx context.Context, tmID
 func (s *TranslationMemoryService) DownloadTM(ctx context.Context, tmID int, exportID string) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationMemory.DownloadTM")
 	res := new(model.DownloadLinkResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/tms/%d/exports/%s/download", tmID, exportID), nil, res)
 
@@ -127,6 +156,7 @@ func (s *TranslationMemoryService) DownloadTM(ctx context.Context, tmID int, exp
 func (s *TranslationMemoryService) ImportTM(ctx context.Context, tmID int, req *model.TranslationMemoryImportRequest) (
 	*model.TranslationMemoryImport, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationMemory.ImportTM")
 	res := new(model.TranslationMemoryImportResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/tms/%d/imports", tmID), req, res)
 
@@ -139,18 +169,40 @@ func (s *TranslationMemoryService) ImportTM(ctx context.Context, tmID int, req *
 func (s *TranslationMemoryService) CheckTMImportStatus(ctx context.Context, tmID int, importID string) (
 	*model.TranslationMemoryImport, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationMemory.CheckTMImportStatus")
 	res := new(model.TranslationMemoryImportResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/tms/%d/imports/%s", tmID, importID), nil, res)
 
 	return res.Data, resp, err
 }
 
+// ImportTMAndWait imports a translation memory and waits until the import is completed.
+func (s *TranslationMemoryService) ImportTMAndWait(
+	ctx context.Context,
+	tmID int,
+	req *model.TranslationMemoryImportRequest,
+	opts ...WaitOption,
+) (*model.TranslationMemoryImport, error) {
+	return startAndWait(ctx,
+		func() (*model.TranslationMemoryImport, *Response, error) {
+			return s.ImportTM(ctx, tmID, req)
+		},
+		func(i *model.TranslationMemoryImport) PollFunc[*model.TranslationMemoryImport] {
+			return func(ctx context.Context) (*model.TranslationMemoryImport, *Response, error) {
+				return s.CheckTMImportStatus(ctx, tmID, i.Identifier)
+			}
+		},
+		opts,
+	)
+}
+
 // ConcordanceSearch searches for concordance in a translation memory.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.tms.concordance.post
 func (s *TranslationMemoryService) ConcordanceSearch(ctx context.Context, projectID int, req *model.TMConcordanceSearchRequest) (
 	[]*model.TMConcordanceSearch, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationMemory.ConcordanceSearch")
 	res := new(model.TMConcordanceSearchResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/tms/concordance", projectID), req, res)
 	if err != nil {
@@ -171,6 +223,7 @@ func (s *TranslationMemoryService) ConcordanceSearch(ctx context.Context, projec
 func (s *TranslationMemoryService) GetTMSegment(ctx context.Context, tmID, segmentID int) (
 	*model.TMSegment, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationMemory.GetTMSegment")
 	res := new(model.TMSegmentResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/tms/%d/segments/%d", tmID, segmentID), nil, res)
 
@@ -183,6 +236,7 @@ func (s *TranslationMemoryService) GetTMSegment(ctx context.Context, tmID, segme
 func (s *TranslationMemoryService) ListTMSegments(ctx context.Context, tmID int, opts *model.TMSegmentsListOptions) (
 	[]*model.TMSegment, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationMemory.ListTMSegments")
 	res := new(model.TMSegmentsListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/tms/%d/segments", tmID), opts, res)
 	if err != nil {
@@ -197,12 +251,33 @@ func (s *TranslationMemoryService) ListTMSegments(ctx context.Context, tmID int,
 	return list, resp, err
 }
 
+// AllTMSegments returns an iterator over all translation memory segments.
+// It fetches the pages with ListTMSegments until a page shorter than the limit is returned.
+func (s *TranslationMemoryService) AllTMSegments(
+	ctx context.Context, tmID int, opts *model.TMSegmentsListOptions,
+) iter.Seq2[*model.TMSegment, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.TMSegment, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListTMSegments(ctx, tmID, &pageOpts)
+	})
+}
+
+// ListAllTMSegments returns all translation memory segments by fetching every page with ListTMSegments.
+func (s *TranslationMemoryService) ListAllTMSegments(ctx context.Context, tmID int, opts *model.TMSegmentsListOptions) (
+	[]*model.TMSegment, error,
+) {
+	return Collect(s.AllTMSegments(ctx, tmID, opts))
+}
+
 // CreateTMSegment creates a new translation memory segment.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.segments.post
 func (s *TranslationMemoryService) CreateTMSegment(ctx context.Context, tmID int, req *model.TMSegmentCreateRequest) (
 	*model.TMSegment, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationMemory.CreateTMSegment")
 	res := new(model.TMSegmentResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/tms/%d/segments", tmID), req, res)
 
@@ -231,6 +306,7 @@ func (s *TranslationMemoryService) CreateTMSegment(ctx context.Context, tmID int
 func (s *TranslationMemoryService) EditTMSegment(ctx context.Context, tmID, segmentID int, req []*model.UpdateRequest) (
 	*model.TMSegment, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationMemory.EditTMSegment")
 	res := new(model.TMSegmentResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/tms/%d/segments/%d", tmID, segmentID), req, res)
 
@@ -241,6 +317,7 @@ func (s *TranslationMemoryService) EditTMSegment(ctx context.Context, tmID, segm
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.segments.delete
 func (s *TranslationMemoryService) DeleteTMSegment(ctx context.Context, tmID, segmentID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "TranslationMemory.DeleteTMSegment")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/tms/%d/segments/%d", tmID, segmentID))
 }
 
@@ -248,5 +325,6 @@ func (s *TranslationMemoryService) DeleteTMSegment(ctx context.Context, tmID, se
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.segments.clear
 func (s *TranslationMemoryService) ClearTM(ctx context.Context, tmID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "TranslationMemory.ClearTM")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/tms/%d/segments", tmID))
 }
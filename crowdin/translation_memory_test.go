@@ -49,7 +49,7 @@ func TestTranslationMemoryService_GetTM(t *testing.T) {
 		DefaultProjectIDs: []int{2},
 		ProjectIDs:        []int{2},
 		WebURL:            "https://crowdin.com/profile/username/resources/traslation-memory/1",
-		CreatedAt:         "2023-09-16T13:42:04+00:00",
+		CreatedAt:         testTime("2023-09-16T13:42:04+00:00"),
 	}
 	assert.Equal(t, expected, tm)
 }
@@ -130,7 +130,7 @@ func TestTranslationMemoryService_ListTMs(t *testing.T) {
 				DefaultProjectIDs: []int{2},
 				ProjectIDs:        []int{2},
 				WebURL:            "https://crowdin.com/profile/username/resources/traslation-memory/1",
-				CreatedAt:         "2023-09-16T13:42:04+00:00",
+				CreatedAt:         testTime("2023-09-16T13:42:04+00:00"),
 			},
 		}
 		assert.Len(t, expected, 1)
@@ -185,7 +185,7 @@ func TestTranslationMemoryService_AddTM(t *testing.T) {
 		DefaultProjectIDs: []int{2},
 		ProjectIDs:        []int{2},
 		WebURL:            "https://crowdin.com/profile/username/resources/traslation-memory/1",
-		CreatedAt:         "2023-09-16T13:42:04+00:00",
+		CreatedAt:         testTime("2023-09-16T13:42:04+00:00"),
 	}
 	assert.Equal(t, expected, tm)
 }
@@ -262,7 +262,7 @@ func TestTranslationMemoryService_EditTM(t *testing.T) {
 		DefaultProjectIDs: []int{2},
 		ProjectIDs:        []int{2},
 		WebURL:            "https://crowdin.com/profile/username/resources/traslation-memory/1",
-		CreatedAt:         "2023-09-16T13:42:04+00:00",
+		CreatedAt:         testTime("2023-09-16T13:42:04+00:00"),
 	}
 	assert.Equal(t, expected, tm)
 }
@@ -351,10 +351,10 @@ func TestTranslationMemoryService_ExportTM(t *testing.T) {
 			TargetLanguageID: "de",
 			Format:           "csv",
 		},
-		CreatedAt:  "2023-09-23T11:26:54+00:00",
-		UpdatedAt:  "2023-09-23T11:26:54+00:00",
-		StartedAt:  "2023-09-23T11:26:54+00:00",
-		FinishedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		StartedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		FinishedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	assert.Equal(t, expected, export)
 }
@@ -424,10 +424,10 @@ func TestTranslationMemoryService_CheckTMExportStatus(t *testing.T) {
 			TargetLanguageID: "de",
 			Format:           "csv",
 		},
-		CreatedAt:  "2023-09-23T11:26:54+00:00",
-		UpdatedAt:  "2023-09-23T11:26:54+00:00",
-		StartedAt:  "2023-09-23T11:26:54+00:00",
-		FinishedAt: "2023-09-23T11:26:54+00:00",
+		CreatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		UpdatedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		StartedAt:  testTime("2023-09-23T11:26:54+00:00"),
+		FinishedAt: testTime("2023-09-23T11:26:54+00:00"),
 	}
 	assert.Equal(t, expected, export)
 }
@@ -457,7 +457,7 @@ func TestTranslationMemoryService_DownloadTM(t *testing.T) {
 
 	expected := &model.DownloadLink{
 		URL:      "https://production-enterprise-importer.downloads.crowdin.com/992000002/2/14.xliff",
-		ExpireIn: "2023-09-20T10:31:21+00:00",
+		ExpireIn: testTime("2023-09-20T10:31:21+00:00"),
 	}
 	assert.Equal(t, expected, downloadLink)
 }
@@ -526,10 +526,9 @@ func TestTranslationMemoryService_ImportTM(t *testing.T) {
 				"de": 2,
 			},
 		},
-		CreatedAt:  "2023-09-23T11:51:08+00:00",
-		UpdatedAt:  "2023-09-23T11:51:08+00:00",
-		StartedAt:  "2023-09-23T11:51:08+00:00",
-		FinishedAt: "",
+		CreatedAt: testTime("2023-09-23T11:51:08+00:00"),
+		UpdatedAt: testTime("2023-09-23T11:51:08+00:00"),
+		StartedAt: testTime("2023-09-23T11:51:08+00:00"),
 	}
 	assert.Equal(t, expected, importData)
 }
@@ -613,10 +612,9 @@ func TestTranslationMemoryService_CheckTMImportStatus(t *testing.T) {
 				"de": 2,
 			},
 		},
-		CreatedAt:  "2023-09-23T11:51:08+00:00",
-		UpdatedAt:  "2023-09-23T11:51:08+00:00",
-		StartedAt:  "2023-09-23T11:51:08+00:00",
-		FinishedAt: "",
+		CreatedAt: testTime("2023-09-23T11:51:08+00:00"),
+		UpdatedAt: testTime("2023-09-23T11:51:08+00:00"),
+		StartedAt: testTime("2023-09-23T11:51:08+00:00"),
 	}
 	assert.Equal(t, expected, importData)
 }
@@ -685,7 +683,7 @@ func TestTranslationMemoryService_ConcordanceSearch(t *testing.T) {
 			Target:      "Ласкаво просимо!",
 			Relevant:    100,
 			Substituted: "62→100",
-			UpdatedAt:   "2023-09-28T12:29:34+00:00",
+			UpdatedAt:   testTime("2023-09-28T12:29:34+00:00"),
 		},
 	}
 	assert.Equal(t, expected, tmList)
@@ -798,8 +796,8 @@ func TestTranslationMemoryService_GetTMSegment(t *testing.T) {
 				UsageCount: 13,
 				CreatedBy:  1,
 				UpdatedBy:  1,
-				CreatedAt:  "2023-09-16T13:48:04+00:00",
-				UpdatedAt:  "2023-09-16T13:48:04+00:00",
+				CreatedAt:  testTime("2023-09-16T13:48:04+00:00"),
+				UpdatedAt:  testTime("2023-09-16T13:48:04+00:00"),
 			},
 		},
 	}
@@ -886,8 +884,8 @@ func TestTranslationMemoryService_ListTMSegments(t *testing.T) {
 						UsageCount: 13,
 						CreatedBy:  1,
 						UpdatedBy:  1,
-						CreatedAt:  "2019-09-16T13:48:04+00:00",
-						UpdatedAt:  "2019-09-16T13:48:04+00:00",
+						CreatedAt:  testTime("2019-09-16T13:48:04+00:00"),
+						UpdatedAt:  testTime("2019-09-16T13:48:04+00:00"),
 					},
 				},
 			},
@@ -951,8 +949,8 @@ func TestTranslationMemoryService_CreateTMSegment(t *testing.T) {
 				UsageCount: 13,
 				CreatedBy:  1,
 				UpdatedBy:  1,
-				CreatedAt:  "2023-09-16T13:48:04+00:00",
-				UpdatedAt:  "2023-09-16T13:48:04+00:00",
+				CreatedAt:  testTime("2023-09-16T13:48:04+00:00"),
+				UpdatedAt:  testTime("2023-09-16T13:48:04+00:00"),
 			},
 		},
 	}
@@ -1062,8 +1060,8 @@ func TestTranslationMemoryService_EditTMSegment(t *testing.T) {
 					UsageCount: 13,
 					CreatedBy:  1,
 					UpdatedBy:  1,
-					CreatedAt:  "2023-09-16T13:48:04+00:00",
-					UpdatedAt:  "2023-09-16T13:48:04+00:00",
+					CreatedAt:  testTime("2023-09-16T13:48:04+00:00"),
+					UpdatedAt:  testTime("2023-09-16T13:48:04+00:00"),
 				},
 			},
 		}
@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -21,36 +22,116 @@ type TranslationStatusService struct {
 func (s *TranslationStatusService) GetBranchProgress(ctx context.Context, projectID, branchID int, opts *model.ListOptions) (
 	[]*model.TranslationProgress, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationStatus.GetBranchProgress")
 	return s.progress(ctx, fmt.Sprintf("/api/v2/projects/%d/branches/%d/languages/progress", projectID, branchID), opts)
 }
 
+// AllBranchProgress returns an iterator over the progress of all languages on a branch level.
+// It fetches the pages with GetBranchProgress until a page shorter than the limit is returned.
+func (s *TranslationStatusService) AllBranchProgress(
+	ctx context.Context, projectID, branchID int, opts *model.ListOptions,
+) iter.Seq2[*model.TranslationProgress, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, *o, func(ctx context.Context, page model.ListOptions) ([]*model.TranslationProgress, *Response, error) {
+		return s.GetBranchProgress(ctx, projectID, branchID, &page)
+	})
+}
+
+// ListAllBranchProgress returns the progress of all languages on a branch level
+// by fetching every page with GetBranchProgress.
+func (s *TranslationStatusService) ListAllBranchProgress(ctx context.Context, projectID, branchID int, opts *model.ListOptions) (
+	[]*model.TranslationProgress, error,
+) {
+	return Collect(s.AllBranchProgress(ctx, projectID, branchID, opts))
+}
+
 // GetDirectoryProgress returns the translation and proofreading progress on a directory level.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.directories.languages.progress.getMany
 func (s *TranslationStatusService) GetDirectoryProgress(ctx context.Context, projectID, directoryID int, opts *model.ListOptions) (
 	[]*model.TranslationProgress, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationStatus.GetDirectoryProgress")
 	return s.progress(ctx, fmt.Sprintf("/api/v2/projects/%d/directories/%d/languages/progress", projectID, directoryID), opts)
 }
 
+// AllDirectoryProgress returns an iterator over the progress of all languages on a directory level.
+// It fetches the pages with GetDirectoryProgress until a page shorter than the limit is returned.
+func (s *TranslationStatusService) AllDirectoryProgress(
+	ctx context.Context, projectID, directoryID int, opts *model.ListOptions,
+) iter.Seq2[*model.TranslationProgress, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, *o, func(ctx context.Context, page model.ListOptions) ([]*model.TranslationProgress, *Response, error) {
+		return s.GetDirectoryProgress(ctx, projectID, directoryID, &page)
+	})
+}
+
+// ListAllDirectoryProgress returns the progress of all languages on a directory level
+// by fetching every page with GetDirectoryProgress.
+func (s *TranslationStatusService) ListAllDirectoryProgress(ctx context.Context, projectID, directoryID int, opts *model.ListOptions) (
+	[]*model.TranslationProgress, error,
+) {
+	return Collect(s.AllDirectoryProgress(ctx, projectID, directoryID, opts))
+}
+
 // GetFileProgress returns the translation and proofreading progress on a file level.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.files.languages.progress.getMany
 func (s *TranslationStatusService) GetFileProgress(ctx context.Context, projectID, fileID int, opts *model.ListOptions) (
 	[]*model.TranslationProgress, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationStatus.GetFileProgress")
 	return s.progress(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d/languages/progress", projectID, fileID), opts)
 }
 
+// AllFileProgress returns an iterator over the progress of all languages on a file level.
+// It fetches the pages with GetFileProgress until a page shorter than the limit is returned.
+func (s *TranslationStatusService) AllFileProgress(
+	ctx context.Context, projectID, fileID int, opts *model.ListOptions,
+) iter.Seq2[*model.TranslationProgress, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, *o, func(ctx context.Context, page model.ListOptions) ([]*model.TranslationProgress, *Response, error) {
+		return s.GetFileProgress(ctx, projectID, fileID, &page)
+	})
+}
+
+// ListAllFileProgress returns the progress of all languages on a file level
+// by fetching every page with GetFileProgress.
+func (s *TranslationStatusService) ListAllFileProgress(ctx context.Context, projectID, fileID int, opts *model.ListOptions) (
+	[]*model.TranslationProgress, error,
+) {
+	return Collect(s.AllFileProgress(ctx, projectID, fileID, opts))
+}
+
 // GetLanguageProgress returns the translation and proofreading progress on a language level.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.languages.files.progress.getMany
 func (s *TranslationStatusService) GetLanguageProgress(ctx context.Context, projectID int, languageID string, opts *model.ListOptions) (
 	[]*model.TranslationProgress, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationStatus.GetLanguageProgress")
 	return s.progress(ctx, fmt.Sprintf("/api/v2/projects/%d/languages/%s/progress", projectID, languageID), opts)
 }
 
+// AllLanguageProgress returns an iterator over the progress of all files on a language level.
+// It fetches the pages with GetLanguageProgress until a page shorter than the limit is returned.
+func (s *TranslationStatusService) AllLanguageProgress(
+	ctx context.Context, projectID int, languageID string, opts *model.ListOptions,
+) iter.Seq2[*model.TranslationProgress, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, *o, func(ctx context.Context, page model.ListOptions) ([]*model.TranslationProgress, *Response, error) {
+		return s.GetLanguageProgress(ctx, projectID, languageID, &page)
+	})
+}
+
+// ListAllLanguageProgress returns the progress of all files on a language level
+// by fetching every page with GetLanguageProgress.
+func (s *TranslationStatusService) ListAllLanguageProgress(ctx context.Context, projectID int, languageID string, opts *model.ListOptions) (
+	[]*model.TranslationProgress, error,
+) {
+	return Collect(s.AllLanguageProgress(ctx, projectID, languageID, opts))
+}
+
 // GetProjectProgress returns the translation and proofreading progress on a project level.
 //
 // Query parameters:
@@ -62,9 +143,31 @@ func (s *TranslationStatusService) GetLanguageProgress(ctx context.Context, proj
 func (s *TranslationStatusService) GetProjectProgress(ctx context.Context, projectID int, opts *model.ProjectProgressListOptions) (
 	[]*model.TranslationProgress, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationStatus.GetProjectProgress")
 	return s.progress(ctx, fmt.Sprintf("/api/v2/projects/%d/languages/progress", projectID), opts)
 }
 
+// AllProjectProgress returns an iterator over the progress of all languages on a project level.
+// It fetches the pages with GetProjectProgress until a page shorter than the limit is returned.
+func (s *TranslationStatusService) AllProjectProgress(
+	ctx context.Context, projectID int, opts *model.ProjectProgressListOptions,
+) iter.Seq2[*model.TranslationProgress, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.TranslationProgress, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.GetProjectProgress(ctx, projectID, &pageOpts)
+	})
+}
+
+// ListAllProjectProgress returns the progress of all languages on a project level
+// by fetching every page with GetProjectProgress.
+func (s *TranslationStatusService) ListAllProjectProgress(ctx context.Context, projectID int, opts *model.ProjectProgressListOptions) (
+	[]*model.TranslationProgress, error,
+) {
+	return Collect(s.AllProjectProgress(ctx, projectID, opts))
+}
+
 // ListQAChecks returns a list of QA check issues.
 //
 // Query parameters:
@@ -78,6 +181,7 @@ func (s *TranslationStatusService) GetProjectProgress(ctx context.Context, proje
 func (s *TranslationStatusService) ListQAChecks(ctx context.Context, projectID int, opts *model.QACheckListOptions) (
 	[]*model.QACheck, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "TranslationStatus.ListQAChecks")
 	res := new(model.QAChecksResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/qa-checks", projectID), opts, res)
 	if err != nil {
@@ -92,6 +196,26 @@ func (s *TranslationStatusService) ListQAChecks(ctx context.Context, projectID i
 	return issues, resp, nil
 }
 
+// AllQAChecks returns an iterator over all QA check issues.
+// It fetches the pages with ListQAChecks until a page shorter than the limit is returned.
+func (s *TranslationStatusService) AllQAChecks(
+	ctx context.Context, projectID int, opts *model.QACheckListOptions,
+) iter.Seq2[*model.QACheck, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.QACheck, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListQAChecks(ctx, projectID, &pageOpts)
+	})
+}
+
+// ListAllQAChecks returns all QA check issues by fetching every page with ListQAChecks.
+func (s *TranslationStatusService) ListAllQAChecks(ctx context.Context, projectID int, opts *model.QACheckListOptions) (
+	[]*model.QACheck, error,
+) {
+	return Collect(s.AllQAChecks(ctx, projectID, opts))
+}
+
 func (s *TranslationStatusService) progress(ctx context.Context, path string, opts ListOptionsProvider) (
 	[]*model.TranslationProgress, *Response, error,
 ) {
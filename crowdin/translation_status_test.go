@@ -350,6 +350,69 @@ func TestTranslationStatusService_GetProjectProgress(t *testing.T) {
 	}
 }
 
+func TestTranslationStatusService_AllProjectProgress(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/projects/1/languages/progress"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		switch r.URL.Query().Get("offset") {
+		case "":
+			testURL(t, r, path+"?languageIds=uk%2Cde&limit=2")
+			fmt.Fprint(w, `{"data": [{"data": {"languageId": "uk"}}, {"data": {"languageId": "de"}}],
+				"pagination": {"offset": 0, "limit": 2}}`)
+		case "2":
+			testURL(t, r, path+"?languageIds=uk%2Cde&limit=2&offset=2")
+			fmt.Fprint(w, `{"data": [{"data": {"languageId": "fr"}}], "pagination": {"offset": 2, "limit": 2}}`)
+		default:
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+	})
+
+	opts := &model.ProjectProgressListOptions{
+		LanguageIDs: []string{"uk", "de"},
+		ListOptions: model.ListOptions{Limit: 2},
+	}
+	var languages []string
+	for progress, err := range client.TranslationStatus.AllProjectProgress(context.Background(), 1, opts) {
+		if err != nil {
+			t.Fatalf("TranslationStatus.AllProjectProgress returned error: %v", err)
+		}
+		languages = append(languages, *progress.LanguageID)
+	}
+
+	if want := []string{"uk", "de", "fr"}; !reflect.DeepEqual(languages, want) {
+		t.Errorf("TranslationStatus.AllProjectProgress returned %v, want %v", languages, want)
+	}
+	if opts.Offset != 0 {
+		t.Errorf("TranslationStatus.AllProjectProgress modified the options: %+v", opts)
+	}
+}
+
+func TestTranslationStatusService_ListAllFileProgress(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/projects/1/files/2/languages/progress"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, path+"?limit=500")
+
+		fmt.Fprint(w, `{"data": [{"data": {"languageId": "uk"}}], "pagination": {"offset": 0, "limit": 500}}`)
+	})
+
+	progress, err := client.TranslationStatus.ListAllFileProgress(context.Background(), 1, 2, nil)
+	if err != nil {
+		t.Fatalf("TranslationStatus.ListAllFileProgress returned error: %v", err)
+	}
+
+	if want := []*model.TranslationProgress{{LanguageID: ToPtr("uk")}}; !reflect.DeepEqual(progress, want) {
+		t.Errorf("TranslationStatus.ListAllFileProgress returned %+v, want %+v", progress, want)
+	}
+}
+
 func TestTranslationStatusService_ListQAChecks(t *testing.T) {
 	client, mux, teardown := setupClient()
 	defer teardown()
@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -25,18 +26,42 @@ type TranslationsService struct {
 func (s *TranslationsService) PreTranslationStatus(ctx context.Context, projectID int, preTranslationID string) (
 	*model.PreTranslation, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Translations.PreTranslationStatus")
 	res := new(model.PreTranslationsResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/pre-translations/%s", projectID, preTranslationID), nil, res)
 
 	return res.Data, resp, err
 }
 
+// ApplyPreTranslationAndWait applies pre-translation to the project and waits
+// until it is completed. The returned error is ErrOperationFailed or
+// ErrOperationCanceled if the pre-translation did not finish successfully.
+func (s *TranslationsService) ApplyPreTranslationAndWait(
+	ctx context.Context,
+	projectID int,
+	req *model.PreTranslationRequest,
+	opts ...WaitOption,
+) (*model.PreTranslation, error) {
+	return startAndWait(ctx,
+		func() (*model.PreTranslation, *Response, error) {
+			return s.ApplyPreTranslation(ctx, projectID, req)
+		},
+		func(p *model.PreTranslation) PollFunc[*model.PreTranslation] {
+			return func(ctx context.Context) (*model.PreTranslation, *Response, error) {
+				return s.PreTranslationStatus(ctx, projectID, p.Identifier)
+			}
+		},
+		opts,
+	)
+}
+
 // ApplyPreTranslation applies pre-translation to the project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.pre-translations.post
 func (s *TranslationsService) ApplyPreTranslation(ctx context.Context, projectID int, req *model.PreTranslationRequest) (
 	*model.PreTranslation, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Translations.ApplyPreTranslation")
 	res := new(model.PreTranslationsResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/pre-translations", projectID), req, res)
 
@@ -51,6 +76,7 @@ func (s *TranslationsService) BuildProjectDirectoryTranslation(
 	projectID, directoryID int,
 	req *model.BuildProjectDirectoryTranslationRequest,
 ) (*model.BuildProjectDirectoryTranslation, *Response, error) {
+	ctx = WithOperationName(ctx, "Translations.BuildProjectDirectoryTranslation")
 	res := struct {
 		Data *model.BuildProjectDirectoryTranslation `json:"data"`
 	}{}
@@ -73,6 +99,7 @@ func (s *TranslationsService) BuildProjectFileTranslation(
 	req *model.BuildProjectFileTranslationRequest,
 	etag string,
 ) (*model.DownloadLink, *Response, error) {
+	ctx = WithOperationName(ctx, "Translations.BuildProjectFileTranslation")
 	path := fmt.Sprintf("/api/v2/projects/%d/translations/builds/files/%d", projectID, fileID)
 	res := new(model.DownloadLinkResponse)
 	resp, err := s.client.Post(ctx, path, req, res, Header("If-None-Match", etag))
@@ -91,6 +118,7 @@ func (s *TranslationsService) BuildProjectFileTranslation(
 func (s *TranslationsService) ListProjectBuilds(ctx context.Context, projectID int, opts *model.TranslationsBuildsListOptions) (
 	[]*model.TranslationsProjectBuild, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Translations.ListProjectBuilds")
 	res := new(model.TranslationsProjectBuildsListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/builds", projectID), opts, res)
 	if err != nil {
@@ -105,6 +133,26 @@ func (s *TranslationsService) ListProjectBuilds(ctx context.Context, projectID i
 	return builds, resp, err
 }
 
+// AllProjectBuilds returns an iterator over all project builds.
+// It fetches the pages with ListProjectBuilds until a page shorter than the limit is returned.
+func (s *TranslationsService) AllProjectBuilds(
+	ctx context.Context, projectID int, opts *model.TranslationsBuildsListOptions,
+) iter.Seq2[*model.TranslationsProjectBuild, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.TranslationsProjectBuild, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListProjectBuilds(ctx, projectID, &pageOpts)
+	})
+}
+
+// ListAllProjectBuilds returns all project builds by fetching every page with ListProjectBuilds.
+func (s *TranslationsService) ListAllProjectBuilds(ctx context.Context, projectID int, opts *model.TranslationsBuildsListOptions) (
+	[]*model.TranslationsProjectBuild, error,
+) {
+	return Collect(s.AllProjectBuilds(ctx, projectID, opts))
+}
+
 // BuildProjectTranslation builds project translations.
 // Request body can be either `model.BuildProjectRequest` or `model.PseudoBuildProjectRequest`.
 //
@@ -112,6 +160,7 @@ func (s *TranslationsService) ListProjectBuilds(ctx context.Context, projectID i
 func (s *TranslationsService) BuildProjectTranslation(ctx context.Context, projectID int, req model.BuildProjectTranslationRequest) (
 	*model.TranslationsProjectBuild, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Translations.BuildProjectTranslation")
 	res := new(model.TranslationsProjectBuildResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/builds", projectID), req, &res)
 
@@ -124,6 +173,7 @@ func (s *TranslationsService) BuildProjectTranslation(ctx context.Context, proje
 func (s *TranslationsService) UploadTranslations(ctx context.Context, projectID int, languageID string, req *model.UploadTranslationsRequest) (
 	*model.UploadTranslations, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Translations.UploadTranslations")
 	res := new(model.UploadTranslationsResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/%s", projectID, languageID), req, res)
 
@@ -136,28 +186,72 @@ func (s *TranslationsService) UploadTranslations(ctx context.Context, projectID
 func (s *TranslationsService) DownloadProjectTranslations(ctx context.Context, projectID, buildID int) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Translations.DownloadProjectTranslations")
 	res := new(model.DownloadLinkResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/builds/%d/download", projectID, buildID), nil, res)
 
 	return res.Data, resp, err
 }
 
+// ExtractProjectTranslations downloads the archive of a specific build and extracts
+// the translation files into dir. The download link is requested again if it expires
+// during the download. See Client.Extract for details.
+func (s *TranslationsService) ExtractProjectTranslations(ctx context.Context, projectID, buildID int, dir string,
+	opts ...ExtractOption,
+) (*ExtractManifest, error) {
+	ctx = WithOperationName(ctx, "Translations.ExtractProjectTranslations")
+	link, _, err := s.DownloadProjectTranslations(ctx, projectID, buildID)
+	if err != nil {
+		return nil, err
+	}
+
+	refresh := RefreshLink(func(ctx context.Context) (*model.DownloadLink, error) {
+		link, _, err := s.DownloadProjectTranslations(ctx, projectID, buildID)
+		return link, err
+	})
+	return s.client.Extract(ctx, link, dir, append([]ExtractOption{ExtractFetchOptions(refresh)}, opts...)...)
+}
+
 // CheckBuildStatus checks the status of a project build by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.translations.builds.get
 func (s *TranslationsService) CheckBuildStatus(ctx context.Context, projectID, buildID int) (
 	*model.TranslationsProjectBuild, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Translations.CheckBuildStatus")
 	res := new(model.TranslationsProjectBuildResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/builds/%d", projectID, buildID), nil, res)
 
 	return res.Data, resp, err
 }
 
+// BuildProjectTranslationAndWait builds project translations and waits until
+// the build is completed. Use DownloadProjectTranslations with the returned
+// build identifier to get the download link.
+func (s *TranslationsService) BuildProjectTranslationAndWait(
+	ctx context.Context,
+	projectID int,
+	req model.BuildProjectTranslationRequest,
+	opts ...WaitOption,
+) (*model.TranslationsProjectBuild, error) {
+	return startAndWait(ctx,
+		func() (*model.TranslationsProjectBuild, *Response, error) {
+			return s.BuildProjectTranslation(ctx, projectID, req)
+		},
+		func(b *model.TranslationsProjectBuild) PollFunc[*model.TranslationsProjectBuild] {
+			return func(ctx context.Context) (*model.TranslationsProjectBuild, *Response, error) {
+				return s.CheckBuildStatus(ctx, projectID, b.ID)
+			}
+		},
+		opts,
+	)
+}
+
 // CancelBuild cancels a build by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.translations.builds.delete
 func (s *TranslationsService) CancelBuild(ctx context.Context, projectID, buildID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Translations.CancelBuild")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/builds/%d", projectID, buildID))
 }
 
@@ -170,6 +264,7 @@ func (s *TranslationsService) CancelBuild(ctx context.Context, projectID, buildI
 func (s *TranslationsService) ExportProjectTranslation(ctx context.Context, projectID int, req *model.ExportTranslationRequest) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Translations.ExportProjectTranslation")
 	res := new(model.DownloadLinkResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/exports", projectID), req, res)
 
@@ -60,10 +60,10 @@ func TestTranslationsService_PreTranslationStatus(t *testing.T) {
 			TranslateUntranslatedOnly:     ToPtr(true),
 			TranslateWithPerfectMatchOnly: ToPtr(true),
 		},
-		CreatedAt:  "2023-09-20T14:05:50+00:00",
-		UpdatedAt:  "2023-09-20T14:05:50+00:00",
-		StartedAt:  "2023-08-24T14:15:22Z",
-		FinishedAt: "2023-08-24T14:15:22Z",
+		CreatedAt:  testTime("2023-09-20T14:05:50+00:00"),
+		UpdatedAt:  testTime("2023-09-20T14:05:50+00:00"),
+		StartedAt:  ToPtr(testTime("2023-08-24T14:15:22Z")),
+		FinishedAt: ToPtr(testTime("2023-08-24T14:15:22Z")),
 	}
 	assert.Equal(t, expected, status)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
@@ -153,10 +153,10 @@ func TestTranslationsService_ApplyPreTranslation(t *testing.T) {
 			TranslateUntranslatedOnly:     ToPtr(false),
 			TranslateWithPerfectMatchOnly: ToPtr(true),
 		},
-		CreatedAt:  "2023-09-20T14:05:50+00:00",
-		UpdatedAt:  "2023-09-20T14:05:50+00:00",
-		StartedAt:  "2023-08-24T14:15:22Z",
-		FinishedAt: "2023-08-24T14:15:22Z",
+		CreatedAt:  testTime("2023-09-20T14:05:50+00:00"),
+		UpdatedAt:  testTime("2023-09-20T14:05:50+00:00"),
+		StartedAt:  ToPtr(testTime("2023-08-24T14:15:22Z")),
+		FinishedAt: ToPtr(testTime("2023-08-24T14:15:22Z")),
 	}
 	assert.Equal(t, expected, preTranslation)
 	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
@@ -253,9 +253,9 @@ func TestTranslationsService_BuildProjectDirectoryTranslation(t *testing.T) {
 		ProjectID:  2,
 		Status:     "finished",
 		Progress:   100,
-		CreatedAt:  "2023-09-19T15:10:43+00:00",
-		UpdatedAt:  "2023-09-19T15:10:46+00:00",
-		FinishedAt: "2023-09-19T15:10:46+00:00",
+		CreatedAt:  testTime("2023-09-19T15:10:43+00:00"),
+		UpdatedAt:  testTime("2023-09-19T15:10:46+00:00"),
+		FinishedAt: ToPtr(testTime("2023-09-19T15:10:46+00:00")),
 	}
 	assert.Equal(t, expected, buildTranslation)
 	assert.Equal(t, http.StatusCreated, resp.StatusCode)
@@ -336,7 +336,7 @@ func TestTranslationsService_BuildProjectFileTranslation(t *testing.T) {
 
 	expected := &model.DownloadLink{
 		URL:      "https://production-enterprise-importer.downloads.crowdin.com/992000002/2/14.xliff?response-content-disposition=attachment",
-		ExpireIn: "2023-09-20T10:31:21+00:00",
+		ExpireIn: testTime("2023-09-20T10:31:21+00:00"),
 		Etag:     ToPtr(etag),
 	}
 	assert.Equal(t, expected, downloadLink)
@@ -468,9 +468,9 @@ func TestTranslationsService_ListProjectBuilds(t *testing.T) {
 					ProjectID:  2,
 					Status:     "finished",
 					Progress:   100,
-					CreatedAt:  "2023-09-19T15:10:43+00:00",
-					UpdatedAt:  "2023-09-19T15:10:46+00:00",
-					FinishedAt: "2023-09-19T15:10:46+00:00",
+					CreatedAt:  testTime("2023-09-19T15:10:43+00:00"),
+					UpdatedAt:  testTime("2023-09-19T15:10:46+00:00"),
+					FinishedAt: ToPtr(testTime("2023-09-19T15:10:46+00:00")),
 					Attributes: &model.BuildAttributes{
 						BranchID:                        ToPtr(1),
 						TargetLanguageIDs:               []string{"en"},
@@ -579,9 +579,9 @@ func TestTranslationsService_BuildProjectTranslation(t *testing.T) {
 				ProjectID:  2,
 				Status:     "finished",
 				Progress:   100,
-				CreatedAt:  "2023-09-19T15:10:43+00:00",
-				UpdatedAt:  "2023-09-19T15:10:46+00:00",
-				FinishedAt: "2023-09-19T15:10:46+00:00",
+				CreatedAt:  testTime("2023-09-19T15:10:43+00:00"),
+				UpdatedAt:  testTime("2023-09-19T15:10:46+00:00"),
+				FinishedAt: ToPtr(testTime("2023-09-19T15:10:46+00:00")),
 
 				Attributes: &model.BuildAttributes{
 					BranchID:                        ToPtr(1),
@@ -743,7 +743,7 @@ func TestTranslationsService_DownloadProjectTranslations(t *testing.T) {
 
 	expected := &model.DownloadLink{
 		URL:      "https://production-enterprise-importer.downloads.crowdin.com/992000002/2/14.xliff?response-content-disposition=attachment",
-		ExpireIn: "2023-09-20T10:31:21+00:00",
+		ExpireIn: testTime("2023-09-20T10:31:21+00:00"),
 	}
 	assert.Equal(t, expected, downloadLink)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
@@ -787,9 +787,9 @@ func TestTranslationsService_CheckBuildStatus(t *testing.T) {
 		ProjectID:  2,
 		Status:     "finished",
 		Progress:   100,
-		CreatedAt:  "2023-09-19T15:10:43+00:00",
-		UpdatedAt:  "2023-09-19T15:10:46+00:00",
-		FinishedAt: "2023-09-19T15:10:46+00:00",
+		CreatedAt:  testTime("2023-09-19T15:10:43+00:00"),
+		UpdatedAt:  testTime("2023-09-19T15:10:46+00:00"),
+		FinishedAt: ToPtr(testTime("2023-09-19T15:10:46+00:00")),
 		Attributes: &model.BuildAttributes{
 			BranchID:                        ToPtr(1),
 			TargetLanguageIDs:               []string{"en"},
@@ -867,7 +867,7 @@ func TestTranslationsService_ExportProjectTranslation(t *testing.T) {
 
 	expected := &model.DownloadLink{
 		URL:      "https://production-enterprise-importer.downloads.crowdin.com/992000002/2/14.xliff?response-content-disposition",
-		ExpireIn: "2023-09-20T10:31:21+00:00",
+		ExpireIn: testTime("2023-09-20T10:31:21+00:00"),
 	}
 	assert.Equal(t, expected, downloadLink)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
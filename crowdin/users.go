@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
 )
@@ -24,6 +25,7 @@ type UsersService struct {
 func (s *UsersService) GetProjectMember(ctx context.Context, projectID, memberID int) (
 	*model.ProjectMember, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Users.GetProjectMember")
 	res := new(model.ProjectMemberResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/members/%d", projectID, memberID), nil, res)
 
@@ -36,6 +38,7 @@ func (s *UsersService) GetProjectMember(ctx context.Context, projectID, memberID
 func (s *UsersService) ListProjectMembers(ctx context.Context, projectID int, opts *model.ProjectMembersListOptions) (
 	[]*model.ProjectMember, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Users.ListProjectMembers")
 	res := new(model.ProjectMembersListResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/members", projectID), opts, res)
 	if err != nil {
@@ -50,6 +53,26 @@ func (s *UsersService) ListProjectMembers(ctx context.Context, projectID int, op
 	return list, resp, err
 }
 
+// AllProjectMembers returns an iterator over all project members.
+// It fetches the pages with ListProjectMembers until a page shorter than the limit is returned.
+func (s *UsersService) AllProjectMembers(
+	ctx context.Context, projectID int, opts *model.ProjectMembersListOptions,
+) iter.Seq2[*model.ProjectMember, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.ProjectMember, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListProjectMembers(ctx, projectID, &pageOpts)
+	})
+}
+
+// ListAllProjectMembers returns all project members by fetching every page with ListProjectMembers.
+func (s *UsersService) ListAllProjectMembers(ctx context.Context, projectID int, opts *model.ProjectMembersListOptions) (
+	[]*model.ProjectMember, error,
+) {
+	return Collect(s.AllProjectMembers(ctx, projectID, opts))
+}
+
 // AddProjectMember adds a new member to the project.
 // Returns a list of added and skipped members.
 //
@@ -57,6 +80,7 @@ func (s *UsersService) ListProjectMembers(ctx context.Context, projectID int, op
 func (s *UsersService) AddProjectMember(ctx context.Context, projectID int, req *model.ProjectMemberAddRequest) (
 	map[string][]*model.ProjectMember, *Response, error,
 ) {
+	ctx = WithOperationName(ctx, "Users.AddProjectMember")
 	res := new(model.ProjectMemberAddResponse)
 	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/members", projectID), req, res)
 	if err != nil {
@@ -85,6 +109,7 @@ func (s *UsersService) AddProjectMember(ctx context.Context, projectID int, req
 func (s *UsersService) ReplaceProjectMemberPermissions(
 	ctx context.Context, projectID, memberID int, req *model.ProjectMemberReplaceRequest,
 ) (*model.ProjectMember, *Response, error) {
+	ctx = WithOperationName(ctx, "Users.ReplaceProjectMemberPermissions")
 	res := new(model.ProjectMemberResponse)
 	resp, err := s.client.Put(ctx, fmt.Sprintf("/api/v2/projects/%d/members/%d", projectID, memberID), req, res)
 
@@ -95,6 +120,7 @@ func (s *UsersService) ReplaceProjectMemberPermissions(
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.members.delete
 func (s *UsersService) DeleteProjectMember(ctx context.Context, projectID, memberID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Users.DeleteProjectMember")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/members/%d", projectID, memberID), nil, nil)
 }
 
@@ -102,6 +128,7 @@ func (s *UsersService) DeleteProjectMember(ctx context.Context, projectID, membe
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.users.getById
 func (s *UsersService) Get(ctx context.Context, userID int) (*model.User, *Response, error) {
+	ctx = WithOperationName(ctx, "Users.Get")
 	res := new(model.UserResponse)
 	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/users/%d", userID), nil, res)
 
@@ -112,6 +139,7 @@ func (s *UsersService) Get(ctx context.Context, userID int) (*model.User, *Respo
 //
 // https://developer.crowdin.com/api/v2/#operation/api.user.get
 func (s *UsersService) GetAuthenticated(ctx context.Context) (*model.User, *Response, error) {
+	ctx = WithOperationName(ctx, "Users.GetAuthenticated")
 	res := new(model.UserResponse)
 	resp, err := s.client.Get(ctx, "/api/v2/user", nil, res)
 
@@ -122,6 +150,7 @@ func (s *UsersService) GetAuthenticated(ctx context.Context) (*model.User, *Resp
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.users.getMany
 func (s *UsersService) List(ctx context.Context, opts *model.UsersListOptions) ([]*model.User, *Response, error) {
+	ctx = WithOperationName(ctx, "Users.List")
 	res := new(model.UsersListResponse)
 	resp, err := s.client.Get(ctx, "/api/v2/users", opts, res)
 	if err != nil {
@@ -136,10 +165,27 @@ func (s *UsersService) List(ctx context.Context, opts *model.UsersListOptions) (
 	return list, resp, err
 }
 
+// All returns an iterator over all users.
+// It fetches the pages with List until a page shorter than the limit is returned.
+func (s *UsersService) All(ctx context.Context, opts *model.UsersListOptions) iter.Seq2[*model.User, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) ([]*model.User, *Response, error) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.List(ctx, &pageOpts)
+	})
+}
+
+// ListAll returns all users by fetching every page with List.
+func (s *UsersService) ListAll(ctx context.Context, opts *model.UsersListOptions) ([]*model.User, error) {
+	return Collect(s.All(ctx, opts))
+}
+
 // Invite sends an invitation to a new user.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.users.post
 func (s *UsersService) Invite(ctx context.Context, req *model.InviteUserRequest) (*model.User, *Response, error) {
+	ctx = WithOperationName(ctx, "Users.Invite")
 	res := new(model.UserResponse)
 	resp, err := s.client.Post(ctx, "/api/v2/users", req, res)
 
@@ -156,6 +202,7 @@ func (s *UsersService) Invite(ctx context.Context, req *model.InviteUserRequest)
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.users.patch
 func (s *UsersService) Edit(ctx context.Context, userID int, req []*model.UpdateRequest) (*model.User, *Response, error) {
+	ctx = WithOperationName(ctx, "Users.Edit")
 	res := new(model.UserResponse)
 	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/users/%d", userID), req, res)
 
@@ -166,5 +213,6 @@ func (s *UsersService) Edit(ctx context.Context, userID int, req []*model.Update
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.users.delete
 func (s *UsersService) Delete(ctx context.Context, userID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Users.Delete")
 	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/users/%d", userID), nil, nil)
 }
@@ -127,7 +127,7 @@ func TestUsersService_GetProjectMember(t *testing.T) {
 			},
 		},
 		AvatarURL: ToPtr(""),
-		JoinedAt:  ToPtr("2023-07-11T07:40:22+00:00"),
+		JoinedAt:  ToPtr(testTime("2023-07-11T07:40:22+00:00")),
 		Timezone:  ToPtr("Europe/Kyiv"),
 	}
 	assert.Equal(t, expected, member)
@@ -272,7 +272,7 @@ func TestUsersService_GetProjectMember_EnterpriseAPI(t *testing.T) {
 				"workflowStepIds": []any{313.0},
 			},
 		},
-		GivenAccessAt: ToPtr("2023-10-23T11:44:02+00:00"),
+		GivenAccessAt: ToPtr(testTime("2023-10-23T11:44:02+00:00")),
 	}
 	assert.Equal(t, expected, member)
 	assert.Nil(t, member.FullName)
@@ -629,7 +629,7 @@ func TestUsersService_AddProjectMember(t *testing.T) {
 					},
 				},
 				AvatarURL: ToPtr(""),
-				JoinedAt:  ToPtr("2023-07-11T07:40:22+00:00"),
+				JoinedAt:  ToPtr(testTime("2023-07-11T07:40:22+00:00")),
 				Timezone:  ToPtr("Europe/Kyiv"),
 			},
 		},
@@ -682,7 +682,7 @@ func TestUsersService_AddProjectMember(t *testing.T) {
 					},
 				},
 				AvatarURL: ToPtr(""),
-				JoinedAt:  ToPtr("2023-07-11T07:40:22+00:00"),
+				JoinedAt:  ToPtr(testTime("2023-07-11T07:40:22+00:00")),
 				Timezone:  ToPtr("Europe/Kyiv"),
 			},
 		},
@@ -930,7 +930,7 @@ func TestUsersService_ReplaceProjectMemberPermissions(t *testing.T) {
 				"workflowStepIds": []any{313.0},
 			},
 		},
-		GivenAccessAt: ToPtr("2023-10-23T11:44:02+00:00"),
+		GivenAccessAt: ToPtr(testTime("2023-10-23T11:44:02+00:00")),
 	}
 	assert.Equal(t, expected, member)
 }
@@ -994,8 +994,8 @@ func TestUsersService_Get(t *testing.T) {
 		LastName:  ToPtr("Smith"),
 		Status:    ToPtr("active"),
 		AvatarURL: "",
-		CreatedAt: "2023-07-11T07:40:22+00:00",
-		LastSeen:  "2023-10-23T11:44:02+00:00",
+		CreatedAt: testTime("2023-07-11T07:40:22+00:00"),
+		LastSeen:  ToPtr(testTime("2023-10-23T11:44:02+00:00")),
 		TwoFactor: "enabled",
 		IsAdmin:   ToPtr(true),
 		Timezone:  "Europe/Kyiv",
@@ -1110,8 +1110,8 @@ func TestUsersService_GetAuthenticated(t *testing.T) {
 				Email:     "jsmith@example.com",
 				FullName:  ToPtr("John Smith"),
 				AvatarURL: "",
-				CreatedAt: "2023-07-11T07:40:22+00:00",
-				LastSeen:  "2023-10-23T11:44:02+00:00",
+				CreatedAt: testTime("2023-07-11T07:40:22+00:00"),
+				LastSeen:  ToPtr(testTime("2023-10-23T11:44:02+00:00")),
 				TwoFactor: "enabled",
 				Timezone:  "Europe/Kyiv",
 			},
@@ -1145,8 +1145,8 @@ func TestUsersService_GetAuthenticated(t *testing.T) {
 				LastName:  ToPtr("Smith"),
 				Status:    ToPtr("active"),
 				AvatarURL: "",
-				CreatedAt: "2023-07-11T07:40:22+00:00",
-				LastSeen:  "2023-10-23T11:44:02+00:00",
+				CreatedAt: testTime("2023-07-11T07:40:22+00:00"),
+				LastSeen:  ToPtr(testTime("2023-10-23T11:44:02+00:00")),
 				TwoFactor: "enabled",
 				IsAdmin:   ToPtr(true),
 				Timezone:  "Europe/Kyiv",
@@ -1226,8 +1226,8 @@ func TestUsersService_Invite(t *testing.T) {
 		LastName:  ToPtr("Smith"),
 		Status:    ToPtr("active"),
 		AvatarURL: "",
-		CreatedAt: "2023-07-11T07:40:22+00:00",
-		LastSeen:  "2023-10-23T11:44:02+00:00",
+		CreatedAt: testTime("2023-07-11T07:40:22+00:00"),
+		LastSeen:  ToPtr(testTime("2023-10-23T11:44:02+00:00")),
 		TwoFactor: "enabled",
 		IsAdmin:   ToPtr(true),
 		Timezone:  "Europe/Kyiv",
@@ -1309,8 +1309,8 @@ func TestUsersService_Edit(t *testing.T) {
 		LastName:  ToPtr("Smith"),
 		Status:    ToPtr("active"),
 		AvatarURL: "",
-		CreatedAt: "2023-07-11T07:40:22+00:00",
-		LastSeen:  "2023-10-23T11:44:02+00:00",
+		CreatedAt: testTime("2023-07-11T07:40:22+00:00"),
+		LastSeen:  ToPtr(testTime("2023-10-23T11:44:02+00:00")),
 		TwoFactor: "enabled",
 		IsAdmin:   ToPtr(true),
 		Timezone:  "Europe/Kyiv",
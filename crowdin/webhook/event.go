@@ -0,0 +1,206 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+// Event is a decoded webhook event.
+//
+// The resources are taken from the payload, including the nested ones,
+// e.g. the project of a translated file is set to Project. The resources
+// which are not in the payload of the event are nil.
+type Event struct {
+	// Name of the event, e.g. "file.translated".
+	Name model.WebhookEvent
+	// Raw is the JSON of the event as it was received.
+	Raw json.RawMessage
+
+	Project        *model.Project
+	File           *model.File
+	String         *model.SourceString
+	Translation    *model.Translation
+	Task           *model.Task
+	Build          *Build
+	TargetLanguage *model.Language
+	User           *model.User
+}
+
+// Build represents a project build of the "project.built" event.
+type Build struct {
+	ID           int    `json:"id"`
+	DownloadLink string `json:"downloadLink"`
+}
+
+type (
+	envelope struct {
+		Event          model.WebhookEvent `json:"event"`
+		Project        *model.Project     `json:"project"`
+		File           *file              `json:"file"`
+		String         *sourceString      `json:"string"`
+		Translation    *translation       `json:"translation"`
+		Task           *task              `json:"task"`
+		Build          *build             `json:"build"`
+		TargetLanguage *model.Language    `json:"targetLanguage"`
+		User           *model.User        `json:"user"`
+	}
+
+	file struct {
+		model.File
+		Project *model.Project `json:"project"`
+	}
+
+	sourceString struct {
+		model.SourceString
+		Project *model.Project `json:"project"`
+		File    *file          `json:"file"`
+	}
+
+	translation struct {
+		model.Translation
+		String         *sourceString   `json:"string"`
+		TargetLanguage *model.Language `json:"targetLanguage"`
+	}
+
+	task struct {
+		model.Task
+		Project *model.Project `json:"project"`
+	}
+
+	build struct {
+		Build
+		Project *model.Project `json:"project"`
+	}
+)
+
+// Decode decodes the body of a webhook request. A batched body,
+// i.e. {"events": [...]}, is decoded into several events.
+//
+// Crowdin sends the identifiers as strings in some payloads, e.g. "id": "1",
+// so the string values of "id" and of the keys ending with "Id" are decoded
+// as numbers if they are integers.
+func Decode(data []byte) ([]*Event, error) {
+	var batch struct {
+		Events []json.RawMessage `json:"events"`
+	}
+	if err := json.Unmarshal(data, &batch); err != nil {
+		return nil, fmt.Errorf("webhook: invalid payload: %w", err)
+	}
+	raws := batch.Events
+	if batch.Events == nil {
+		raws = []json.RawMessage{data}
+	}
+
+	events := make([]*Event, 0, len(raws))
+	for _, raw := range raws {
+		e, err := decodeEvent(raw)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	return events, nil
+}
+
+func decodeEvent(raw json.RawMessage) (*Event, error) {
+	data, err := normalizeIDs(raw)
+	if err != nil {
+		return nil, fmt.Errorf("webhook: invalid payload: %w", err)
+	}
+
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, fmt.Errorf("webhook: invalid payload: %w", err)
+	}
+	if env.Event == "" {
+		return nil, errors.New("webhook: invalid payload: event is required")
+	}
+
+	e := &Event{
+		Name:           env.Event,
+		Raw:            bytes.Clone(raw),
+		Project:        env.Project,
+		TargetLanguage: env.TargetLanguage,
+		User:           env.User,
+	}
+	if env.Translation != nil {
+		e.Translation = &env.Translation.Translation
+		e.TargetLanguage = first(e.TargetLanguage, env.Translation.TargetLanguage)
+		env.String = first(env.String, env.Translation.String)
+	}
+	if env.String != nil {
+		e.String = &env.String.SourceString
+		e.Project = first(e.Project, env.String.Project)
+		env.File = first(env.File, env.String.File)
+	}
+	if env.File != nil {
+		e.File = &env.File.File
+		e.Project = first(e.Project, env.File.Project)
+	}
+	if env.Task != nil {
+		e.Task = &env.Task.Task
+		e.Project = first(e.Project, env.Task.Project)
+	}
+	if env.Build != nil {
+		e.Build = &env.Build.Build
+		e.Project = first(e.Project, env.Build.Project)
+	}
+
+	return e, nil
+}
+
+// first returns the first non-nil value.
+func first[T any](values ...*T) *T {
+	for _, v := range values {
+		if v != nil {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// normalizeIDs replaces the integer strings of the identifiers with numbers.
+func normalizeIDs(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if _, ok := v.(map[string]any); !ok {
+		return nil, errors.New("event must be an object")
+	}
+
+	return json.Marshal(normalize(v))
+}
+
+func normalize(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			s, ok := value.(string)
+			if !ok || (key != "id" && !strings.HasSuffix(key, "Id")) {
+				v[key] = normalize(value)
+				continue
+			}
+			if _, err := strconv.Atoi(s); err == nil {
+				v[key] = json.Number(s)
+			}
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = normalize(value)
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,197 @@
+// Package webhook receives Crowdin webhooks.
+//
+// Handler is an http.Handler which decodes the webhook requests into
+// typed events and dispatches them to the functions registered for
+// the event names:
+//
+//	h, err := webhook.NewHandler(webhook.WithSecret("X-Crowdin-Secret", secret))
+//	if err != nil {
+//		return err
+//	}
+//	h.On(model.WebhookEventFileTranslated, func(ctx context.Context, e *webhook.Event) error {
+//		log.Printf("file %q is translated to %s", e.File.Name, e.TargetLanguage.ID)
+//		return nil
+//	})
+//	http.Handle("/hooks/crowdin", h)
+//
+// The webhooks must use the POST request type and the JSON content type.
+// Add the secret to the headers of the webhook, e.g. with
+// model.WebhookAddRequest.Headers.
+package webhook
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+// DefaultMaxBodySize is the default maximum size of a request body.
+const DefaultMaxBodySize = 10 << 20
+
+// HandlerFunc handles a webhook event. If it returns an error,
+// the request is answered with 500 Internal Server Error, so Crowdin
+// retries the delivery.
+type HandlerFunc func(ctx context.Context, e *Event) error
+
+// Option configures a Handler.
+type Option func(*Handler) error
+
+// WithSecret makes the handler reject the requests whose header
+// does not contain the secret with 401 Unauthorized.
+func WithSecret(header, secret string) Option {
+	return func(h *Handler) error {
+		if header == "" {
+			return errors.New("webhook: secret header cannot be empty")
+		}
+		if secret == "" {
+			return errors.New("webhook: secret cannot be empty")
+		}
+
+		h.header = http.CanonicalHeaderKey(header)
+		h.secret = []byte(secret)
+		return nil
+	}
+}
+
+// WithMaxBodySize sets the maximum size of a request body.
+// Larger requests are rejected with 413 Request Entity Too Large.
+// Default: DefaultMaxBodySize.
+func WithMaxBodySize(n int64) Option {
+	return func(h *Handler) error {
+		if n <= 0 {
+			return errors.New("webhook: max body size must be positive")
+		}
+
+		h.maxBodySize = n
+		return nil
+	}
+}
+
+// WithDefault sets the function which handles the events without
+// a function registered with On. By default, such events are ignored.
+func WithDefault(fn HandlerFunc) Option {
+	return func(h *Handler) error {
+		if fn == nil {
+			return errors.New("webhook: default handler cannot be nil")
+		}
+
+		h.fallback = fn
+		return nil
+	}
+}
+
+// Handler is an http.Handler which receives Crowdin webhooks.
+// It is safe for concurrent use.
+type Handler struct {
+	header      string
+	secret      []byte
+	maxBodySize int64
+	fallback    HandlerFunc
+
+	mu       sync.RWMutex
+	handlers map[model.WebhookEvent][]HandlerFunc
+}
+
+// NewHandler returns a new webhook handler.
+func NewHandler(opts ...Option) (*Handler, error) {
+	h := &Handler{
+		maxBodySize: DefaultMaxBodySize,
+		handlers:    make(map[model.WebhookEvent][]HandlerFunc),
+	}
+	for _, opt := range opts {
+		if err := opt(h); err != nil {
+			return nil, err
+		}
+	}
+
+	return h, nil
+}
+
+// On registers the function which handles the event. The functions
+// of the same event are called in the order of registration.
+func (h *Handler) On(event model.WebhookEvent, fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.handlers[event] = append(h.handlers[event], fn)
+}
+
+// ServeHTTP decodes the events of the request and dispatches them
+// in the order of the payload. The request is answered with:
+//   - 200 OK if all events are handled;
+//   - 400 Bad Request if the payload is invalid;
+//   - 401 Unauthorized if the secret header does not match;
+//   - 405 Method Not Allowed if the method is not POST;
+//   - 415 Unsupported Media Type if the content is not JSON;
+//   - 500 Internal Server Error if a handler returns an error.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if h.secret != nil &&
+		subtle.ConstantTimeCompare([]byte(r.Header.Get(h.header)), h.secret) != 1 {
+		http.Error(w, "invalid secret", http.StatusUnauthorized)
+		return
+	}
+	if ct := r.Header.Get("Content-Type"); ct != "" && !isJSON(ct) {
+		http.Error(w, "unsupported content type", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.maxBodySize))
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "cannot read request body", http.StatusBadRequest)
+		return
+	}
+
+	events, err := Decode(data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, e := range events {
+		if err := h.dispatch(r.Context(), e); err != nil {
+			http.Error(w, "cannot handle event "+string(e.Name), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) dispatch(ctx context.Context, e *Event) error {
+	h.mu.RLock()
+	handlers := h.handlers[e.Name]
+	h.mu.RUnlock()
+
+	if len(handlers) == 0 && h.fallback != nil {
+		return h.fallback(ctx, e)
+	}
+	for _, fn := range handlers {
+		if err := fn(ctx, e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
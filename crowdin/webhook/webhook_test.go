@@ -0,0 +1,302 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fileTranslated = `{
+	"event": "file.translated",
+	"file": {
+		"id": "48",
+		"name": "strings.xml",
+		"title": "Main",
+		"type": "android",
+		"path": "/main/strings.xml",
+		"status": "active",
+		"revisionId": "2",
+		"branchId": "34",
+		"directoryId": null,
+		"project": {
+			"id": "1",
+			"userId": "6",
+			"sourceLanguageId": "en",
+			"targetLanguageIds": ["uk", "de"],
+			"identifier": "umbrella-app",
+			"name": "Umbrella App",
+			"createdAt": "2023-09-20T11:34:40+00:00"
+		}
+	},
+	"targetLanguage": {
+		"id": "uk",
+		"name": "Ukrainian",
+		"locale": "uk-UA"
+	}
+}`
+
+const batch = `{
+	"events": [
+		{
+			"event": "suggestion.added",
+			"translation": {
+				"id": "190695",
+				"text": "Привіт",
+				"pluralCategoryName": "",
+				"rating": 0,
+				"createdAt": "2023-09-23T11:26:54+00:00",
+				"user": {"id": "12", "username": "john_smith"},
+				"targetLanguage": {"id": "uk", "name": "Ukrainian"},
+				"string": {
+					"id": "2814",
+					"identifier": "hello",
+					"text": "Hello",
+					"type": "text",
+					"project": {"id": "1", "name": "Umbrella App"},
+					"file": {"id": "48", "name": "strings.xml"}
+				}
+			}
+		},
+		{
+			"event": "task.statusChanged",
+			"task": {
+				"id": 2,
+				"projectId": 1,
+				"status": "done",
+				"title": "French",
+				"deadline": "2023-09-27T07:00:14+00:00",
+				"project": {"id": 1, "name": "Umbrella App"}
+			}
+		},
+		{
+			"event": "project.built",
+			"build": {
+				"id": "2",
+				"downloadLink": "https://production-enterprise-importer.downloads.crowdin.com/992000002/2/14.xliff",
+				"project": {"id": "1", "name": "Umbrella App"}
+			}
+		}
+	]
+}`
+
+func newServer(t *testing.T, opts ...Option) (*Handler, *httptest.Server) {
+	t.Helper()
+
+	h, err := NewHandler(opts...)
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	return h, srv
+}
+
+func post(t *testing.T, url, body string, header http.Header) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	return resp
+}
+
+func TestHandler_FileTranslated(t *testing.T) {
+	h, srv := newServer(t)
+
+	var got *Event
+	h.On(model.WebhookEventFileTranslated, func(_ context.Context, e *Event) error {
+		got = e
+		return nil
+	})
+
+	resp := post(t, srv.URL, fileTranslated, nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.NotNil(t, got)
+	assert.Equal(t, model.WebhookEventFileTranslated, got.Name)
+	assert.JSONEq(t, fileTranslated, string(got.Raw))
+
+	require.NotNil(t, got.File)
+	assert.Equal(t, 48, got.File.ID)
+	assert.Equal(t, "strings.xml", got.File.Name)
+	assert.Equal(t, 2, got.File.RevisionID)
+	assert.Equal(t, 34, *got.File.BranchID)
+	assert.Nil(t, got.File.DirectoryID)
+
+	require.NotNil(t, got.Project)
+	assert.Equal(t, 1, got.Project.ID)
+	assert.Equal(t, 6, got.Project.UserID)
+	assert.Equal(t, "en", got.Project.SourceLanguageID)
+	assert.Equal(t, []string{"uk", "de"}, got.Project.TargetLanguageIDs)
+	assert.Equal(t, "2023-09-20T11:34:40+00:00", got.Project.CreatedAt.String())
+
+	require.NotNil(t, got.TargetLanguage)
+	assert.Equal(t, "uk", got.TargetLanguage.ID)
+	assert.Equal(t, "uk-UA", got.TargetLanguage.Locale)
+
+	assert.Nil(t, got.String)
+	assert.Nil(t, got.Translation)
+	assert.Nil(t, got.Task)
+	assert.Nil(t, got.Build)
+}
+
+func TestHandler_Batch(t *testing.T) {
+	h, srv := newServer(t)
+
+	var got []*Event
+	handle := func(_ context.Context, e *Event) error {
+		got = append(got, e)
+		return nil
+	}
+	h.On(model.WebhookEventSuggestionAdded, handle)
+	h.On(model.WebhookEventTaskStatusChanged, handle)
+	h.On(model.WebhookEventProjectBuilt, handle)
+
+	resp := post(t, srv.URL, batch, nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Len(t, got, 3)
+
+	suggestion := got[0]
+	assert.Equal(t, model.WebhookEventSuggestionAdded, suggestion.Name)
+	assert.Equal(t, 190695, suggestion.Translation.ID)
+	assert.Equal(t, "Привіт", suggestion.Translation.Text)
+	assert.Equal(t, 12, suggestion.Translation.User.ID)
+	assert.Equal(t, 2814, suggestion.String.ID)
+	assert.Equal(t, "Hello", suggestion.String.Text)
+	assert.Equal(t, 48, suggestion.File.ID)
+	assert.Equal(t, "Umbrella App", suggestion.Project.Name)
+	assert.Equal(t, "uk", suggestion.TargetLanguage.ID)
+
+	task := got[1]
+	assert.Equal(t, model.WebhookEventTaskStatusChanged, task.Name)
+	assert.Equal(t, 2, task.Task.ID)
+	assert.Equal(t, model.TaskStatusDone, task.Task.Status)
+	assert.Equal(t, "2023-09-27T07:00:14+00:00", task.Task.Deadline.String())
+	assert.Equal(t, 1, task.Project.ID)
+
+	build := got[2]
+	assert.Equal(t, model.WebhookEventProjectBuilt, build.Name)
+	assert.Equal(t, &Build{
+		ID:           2,
+		DownloadLink: "https://production-enterprise-importer.downloads.crowdin.com/992000002/2/14.xliff",
+	}, build.Build)
+	assert.Equal(t, 1, build.Project.ID)
+}
+
+func TestHandler_Secret(t *testing.T) {
+	h, srv := newServer(t, WithSecret("X-Hook-Token", "s3cret"))
+
+	var calls int
+	h.On(model.WebhookEventFileTranslated, func(context.Context, *Event) error {
+		calls++
+		return nil
+	})
+
+	tests := []struct {
+		name     string
+		header   http.Header
+		expected int
+	}{
+		{name: "missing", header: nil, expected: http.StatusUnauthorized},
+		{name: "wrong", header: http.Header{"X-Hook-Token": {"secret"}}, expected: http.StatusUnauthorized},
+		{name: "other header", header: http.Header{"X-Crowdin-Secret": {"s3cret"}}, expected: http.StatusUnauthorized},
+		{name: "valid", header: http.Header{"X-Hook-Token": {"s3cret"}}, expected: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := post(t, srv.URL, fileTranslated, tt.header)
+			assert.Equal(t, tt.expected, resp.StatusCode)
+		})
+	}
+
+	assert.Equal(t, 1, calls)
+}
+
+func TestHandler_Errors(t *testing.T) {
+	h, srv := newServer(t, WithMaxBodySize(1024))
+	h.On(model.WebhookEventFileTranslated, func(context.Context, *Event) error {
+		return errors.New("database is down")
+	})
+
+	tests := []struct {
+		name     string
+		body     string
+		expected int
+	}{
+		{name: "handler error", body: fileTranslated, expected: http.StatusInternalServerError},
+		{name: "invalid JSON", body: `{"event":`, expected: http.StatusBadRequest},
+		{name: "missing event", body: `{"file": {"id": 1}}`, expected: http.StatusBadRequest},
+		{name: "not an object", body: `{"events": [1]}`, expected: http.StatusBadRequest},
+		{
+			name:     "too large",
+			body:     `{"event": "` + strings.Repeat("a", 2048) + `"}`,
+			expected: http.StatusRequestEntityTooLarge,
+		},
+		{name: "unhandled event", body: `{"event": "string.added", "string": {"id": 1}}`, expected: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := post(t, srv.URL, tt.body, nil)
+			assert.Equal(t, tt.expected, resp.StatusCode)
+		})
+	}
+
+	resp, err := http.Get(srv.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	resp, err = http.Post(srv.URL, "application/x-www-form-urlencoded", strings.NewReader("event=file.added"))
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+}
+
+func TestHandler_Default(t *testing.T) {
+	var names []model.WebhookEvent
+	h, srv := newServer(t, WithDefault(func(_ context.Context, e *Event) error {
+		names = append(names, e.Name)
+		return nil
+	}))
+	h.On(model.WebhookEventSuggestionAdded, func(context.Context, *Event) error {
+		return nil
+	})
+
+	resp := post(t, srv.URL, batch, nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []model.WebhookEvent{model.WebhookEventTaskStatusChanged, model.WebhookEventProjectBuilt}, names)
+}
+
+func TestNewHandler_Errors(t *testing.T) {
+	tests := []struct {
+		opt         Option
+		expectedErr string
+	}{
+		{opt: WithSecret("", "s3cret"), expectedErr: "webhook: secret header cannot be empty"},
+		{opt: WithSecret("X-Hook-Token", ""), expectedErr: "webhook: secret cannot be empty"},
+		{opt: WithMaxBodySize(0), expectedErr: "webhook: max body size must be positive"},
+		{opt: WithDefault(nil), expectedErr: "webhook: default handler cannot be nil"},
+	}
+
+	for _, tt := range tests {
+		_, err := NewHandler(tt.opt)
+		assert.EqualError(t, err, tt.expectedErr)
+	}
+}
@@ -0,0 +1,108 @@
+package crowdin
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+// WebhooksService provides access to the Webhooks API.
+// Webhooks notify your service about the events in a project.
+//
+// Crowdin API docs: https://developer.crowdin.com/api/v2/#tag/Webhooks
+type WebhooksService struct {
+	client *Client
+}
+
+// List returns a list of webhooks in the project.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.webhooks.getMany
+func (s *WebhooksService) List(ctx context.Context, projectID int, opts *model.ListOptions) (
+	[]*model.Webhook, *Response, error,
+) {
+	ctx = WithOperationName(ctx, "Webhooks.List")
+	res := new(model.WebhooksListResponse)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/webhooks", projectID), opts, res)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	list := make([]*model.Webhook, 0, len(res.Data))
+	for _, webhook := range res.Data {
+		list = append(list, webhook.Data)
+	}
+
+	return list, resp, err
+}
+
+// All returns an iterator over all webhooks in the project.
+// It fetches the pages with List until a page shorter than the limit is returned.
+func (s *WebhooksService) All(
+	ctx context.Context, projectID int, opts *model.ListOptions,
+) iter.Seq2[*model.Webhook, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, *o, func(ctx context.Context, page model.ListOptions) ([]*model.Webhook, *Response, error) {
+		return s.List(ctx, projectID, &page)
+	})
+}
+
+// ListAll returns all webhooks in the project by fetching every page with List.
+func (s *WebhooksService) ListAll(ctx context.Context, projectID int, opts *model.ListOptions) (
+	[]*model.Webhook, error,
+) {
+	return Collect(s.All(ctx, projectID, opts))
+}
+
+// Get returns a webhook by its identifier.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.webhooks.get
+func (s *WebhooksService) Get(ctx context.Context, projectID, webhookID int) (*model.Webhook, *Response, error) {
+	ctx = WithOperationName(ctx, "Webhooks.Get")
+	res := new(model.WebhookResponse)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/webhooks/%d", projectID, webhookID), nil, res)
+
+	return res.Data, resp, err
+}
+
+// Add creates a new webhook in the project.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.webhooks.post
+func (s *WebhooksService) Add(ctx context.Context, projectID int, req *model.WebhookAddRequest) (
+	*model.Webhook, *Response, error,
+) {
+	ctx = WithOperationName(ctx, "Webhooks.Add")
+	res := new(model.WebhookResponse)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/webhooks", projectID), req, res)
+
+	return res.Data, resp, err
+}
+
+// Edit updates a webhook by its identifier.
+//
+// Request body:
+// - op - operation to perform with the webhook. Enum: replace, test.
+// - path (json-pointer) - path to the field to update.
+// Enum: "/name", "/url", "/isActive", "/batchingEnabled", "/contentType",
+// "/events", "/headers", "/payload", "/requestType".
+// - value - new value for the field.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.webhooks.patch
+func (s *WebhooksService) Edit(ctx context.Context, projectID, webhookID int, req []*model.UpdateRequest) (
+	*model.Webhook, *Response, error,
+) {
+	ctx = WithOperationName(ctx, "Webhooks.Edit")
+	res := new(model.WebhookResponse)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/webhooks/%d", projectID, webhookID), req, res)
+
+	return res.Data, resp, err
+}
+
+// Delete removes a webhook by its identifier.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.webhooks.delete
+func (s *WebhooksService) Delete(ctx context.Context, projectID, webhookID int) (*Response, error) {
+	ctx = WithOperationName(ctx, "Webhooks.Delete")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/webhooks/%d", projectID, webhookID))
+}
@@ -0,0 +1,303 @@
+package crowdin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const webhookJSON = `{
+	"data": {
+		"id": 4,
+		"projectId": 1,
+		"name": "Proofread",
+		"url": "https://example.com/hooks/crowdin",
+		"events": ["file.translated", "file.approved"],
+		"headers": {"Authorization": "Bearer token"},
+		"payload": {
+			"file.translated": {"event": "{{event}}", "file": "{{fileName}}"}
+		},
+		"isActive": true,
+		"batchingEnabled": false,
+		"requestType": "POST",
+		"contentType": "application/json",
+		"createdAt": "2023-09-23T09:19:07+00:00",
+		"updatedAt": "2023-09-23T09:19:07+00:00"
+	}
+}`
+
+func expectedWebhook() *model.Webhook {
+	return &model.Webhook{
+		ID:        4,
+		ProjectID: 1,
+		Name:      "Proofread",
+		URL:       "https://example.com/hooks/crowdin",
+		Events:    []model.WebhookEvent{model.WebhookEventFileTranslated, model.WebhookEventFileApproved},
+		Headers:   map[string]string{"Authorization": "Bearer token"},
+		Payload: map[model.WebhookEvent]any{
+			model.WebhookEventFileTranslated: map[string]any{"event": "{{event}}", "file": "{{fileName}}"},
+		},
+		IsActive:    true,
+		RequestType: model.WebhookRequestTypePOST,
+		ContentType: model.WebhookContentTypeJSON,
+		CreatedAt:   testTime("2023-09-23T09:19:07+00:00"),
+		UpdatedAt:   testTime("2023-09-23T09:19:07+00:00"),
+	}
+}
+
+func TestWebhooksService_List(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/projects/1/webhooks"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, path+"?limit=10&offset=5")
+
+		fmt.Fprintf(w, `{
+			"data": [%s],
+			"pagination": {
+				"offset": 5,
+				"limit": 10
+			}
+		}`, webhookJSON)
+	})
+
+	webhooks, resp, err := client.Webhooks.List(context.Background(), 1, &model.ListOptions{Offset: 5, Limit: 10})
+	require.NoError(t, err)
+
+	assert.Equal(t, []*model.Webhook{expectedWebhook()}, webhooks)
+	assert.Equal(t, 5, resp.Pagination.Offset)
+	assert.Equal(t, 10, resp.Pagination.Limit)
+}
+
+func TestWebhooksService_Get(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/projects/1/webhooks/4"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, path)
+
+		fmt.Fprint(w, webhookJSON)
+	})
+
+	webhook, resp, err := client.Webhooks.Get(context.Background(), 1, 4)
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	assert.Equal(t, expectedWebhook(), webhook)
+}
+
+func TestWebhooksService_Get_NotFound(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/projects/1/webhooks/4"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, path)
+
+		http.Error(w, `{"error": {"code": 404, "message": "Webhook Not Found"}}`, http.StatusNotFound)
+	})
+
+	webhook, resp, err := client.Webhooks.Get(context.Background(), 1, 4)
+	require.Error(t, err)
+
+	var errResponse *model.ErrorResponse
+	assert.ErrorAs(t, err, &errResponse)
+	assert.Equal(t, "404 Webhook Not Found", errResponse.Error())
+
+	assert.Nil(t, webhook)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestWebhooksService_Add(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/projects/1/webhooks"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testURL(t, r, path)
+		testBody(t, r, `{"name":"Proofread","url":"https://example.com/hooks/crowdin",`+
+			`"events":["file.translated","file.approved"],"requestType":"POST","isActive":true,`+
+			`"contentType":"application/json","headers":{"Authorization":"Bearer token"},`+
+			`"payload":{"file.translated":{"event":"{{event}}","file":"{{fileName}}"}}}`+"\n")
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, webhookJSON)
+	})
+
+	req := &model.WebhookAddRequest{
+		Name:        "Proofread",
+		URL:         "https://example.com/hooks/crowdin",
+		Events:      []model.WebhookEvent{model.WebhookEventFileTranslated, model.WebhookEventFileApproved},
+		RequestType: model.WebhookRequestTypePOST,
+		IsActive:    ToPtr(true),
+		ContentType: model.WebhookContentTypeJSON,
+		Headers:     map[string]string{"Authorization": "Bearer token"},
+		Payload: map[model.WebhookEvent]any{
+			model.WebhookEventFileTranslated: map[string]any{"event": "{{event}}", "file": "{{fileName}}"},
+		},
+	}
+	webhook, resp, err := client.Webhooks.Add(context.Background(), 1, req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	assert.Equal(t, expectedWebhook(), webhook)
+}
+
+func TestWebhooksService_Add_WithValidationErrors(t *testing.T) {
+	valid := func() *model.WebhookAddRequest {
+		return &model.WebhookAddRequest{
+			Name:        "Proofread",
+			URL:         "https://example.com/hooks/crowdin",
+			Events:      []model.WebhookEvent{model.WebhookEventProjectBuilt},
+			RequestType: model.WebhookRequestTypeGET,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		req         func() *model.WebhookAddRequest
+		expectedErr string
+	}{
+		{
+			name:        "nil request",
+			req:         func() *model.WebhookAddRequest { return nil },
+			expectedErr: "request cannot be nil",
+		},
+		{
+			name: "missing name",
+			req: func() *model.WebhookAddRequest {
+				r := valid()
+				r.Name = ""
+				return r
+			},
+			expectedErr: "name is required",
+		},
+		{
+			name: "missing url",
+			req: func() *model.WebhookAddRequest {
+				r := valid()
+				r.URL = ""
+				return r
+			},
+			expectedErr: "url is required",
+		},
+		{
+			name: "relative url",
+			req: func() *model.WebhookAddRequest {
+				r := valid()
+				r.URL = "/hooks/crowdin"
+				return r
+			},
+			expectedErr: `url "/hooks/crowdin" must be an absolute URL`,
+		},
+		{
+			name: "empty events",
+			req: func() *model.WebhookAddRequest {
+				r := valid()
+				r.Events = nil
+				return r
+			},
+			expectedErr: "events cannot be empty",
+		},
+		{
+			name: "empty event",
+			req: func() *model.WebhookAddRequest {
+				r := valid()
+				r.Events = append(r.Events, "")
+				return r
+			},
+			expectedErr: "events cannot contain an empty event",
+		},
+		{
+			name: "missing request type",
+			req: func() *model.WebhookAddRequest {
+				r := valid()
+				r.RequestType = ""
+				return r
+			},
+			expectedErr: `requestType is required and must be one of "GET", "POST"`,
+		},
+		{
+			name: "payload with GET",
+			req: func() *model.WebhookAddRequest {
+				r := valid()
+				r.Payload = map[model.WebhookEvent]any{model.WebhookEventProjectBuilt: map[string]any{}}
+				return r
+			},
+			expectedErr: "contentType and payload can be used only with the POST request type",
+		},
+		{
+			name: "payload of another event",
+			req: func() *model.WebhookAddRequest {
+				r := valid()
+				r.RequestType = model.WebhookRequestTypePOST
+				r.Payload = map[model.WebhookEvent]any{model.WebhookEventStringAdded: map[string]any{}}
+				return r
+			},
+			expectedErr: `payload: event "string.added" is not in events`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, tt.req().Validate(), tt.expectedErr)
+		})
+	}
+
+	assert.NoError(t, valid().Validate())
+}
+
+func TestWebhooksService_Edit(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/projects/1/webhooks/4"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testURL(t, r, path)
+		testBody(t, r, `[{"op":"replace","path":"/name","value":"Proofread"}]`+"\n")
+
+		fmt.Fprint(w, webhookJSON)
+	})
+
+	req := []*model.UpdateRequest{
+		{
+			Op:    "replace",
+			Path:  "/name",
+			Value: "Proofread",
+		},
+	}
+	webhook, resp, err := client.Webhooks.Edit(context.Background(), 1, 4, req)
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	assert.Equal(t, expectedWebhook(), webhook)
+}
+
+func TestWebhooksService_Delete(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/projects/1/webhooks/4"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testURL(t, r, path)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.Webhooks.Delete(context.Background(), 1, 4)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
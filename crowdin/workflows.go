@@ -0,0 +1,194 @@
+package crowdin
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+)
+
+// WorkflowsService provides access to the Workflows API.
+// Workflows define the steps, e.g. translation, proofreading or machine
+// translation, which the strings of a project pass.
+//
+// The methods are available only for Enterprise clients created with the
+// WithOrganization option. Other clients get the model.ErrEnterpriseOnly error
+// without sending a request.
+//
+// Crowdin API docs: https://developer.crowdin.com/enterprise/api/v2/#tag/Workflows
+type WorkflowsService struct {
+	client *Client
+}
+
+// ListTemplates returns a list of workflow templates in the organization.
+//
+// https://developer.crowdin.com/enterprise/api/v2/#operation/api.workflow-templates.getMany
+func (s *WorkflowsService) ListTemplates(ctx context.Context, opts *model.WorkflowTemplatesListOptions) (
+	[]*model.WorkflowTemplate, *Response, error,
+) {
+	ctx = WithOperationName(ctx, "Workflows.ListTemplates")
+	if !s.client.isEnterprise() {
+		return nil, nil, model.ErrEnterpriseOnly
+	}
+
+	res := new(model.WorkflowTemplatesListResponse)
+	resp, err := s.client.Get(ctx, "/api/v2/workflow-templates", opts, res)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	list := make([]*model.WorkflowTemplate, 0, len(res.Data))
+	for _, template := range res.Data {
+		list = append(list, template.Data)
+	}
+
+	return list, resp, err
+}
+
+// AllTemplates returns an iterator over all workflow templates in the organization.
+// It fetches the pages with ListTemplates until a page shorter than the limit is returned.
+func (s *WorkflowsService) AllTemplates(
+	ctx context.Context, opts *model.WorkflowTemplatesListOptions,
+) iter.Seq2[*model.WorkflowTemplate, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) (
+		[]*model.WorkflowTemplate, *Response, error,
+	) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListTemplates(ctx, &pageOpts)
+	})
+}
+
+// ListAllTemplates returns all workflow templates in the organization
+// by fetching every page with ListTemplates.
+func (s *WorkflowsService) ListAllTemplates(ctx context.Context, opts *model.WorkflowTemplatesListOptions) (
+	[]*model.WorkflowTemplate, error,
+) {
+	return Collect(s.AllTemplates(ctx, opts))
+}
+
+// GetTemplate returns a workflow template by its identifier.
+//
+// https://developer.crowdin.com/enterprise/api/v2/#operation/api.workflow-templates.get
+func (s *WorkflowsService) GetTemplate(ctx context.Context, templateID int) (
+	*model.WorkflowTemplate, *Response, error,
+) {
+	ctx = WithOperationName(ctx, "Workflows.GetTemplate")
+	if !s.client.isEnterprise() {
+		return nil, nil, model.ErrEnterpriseOnly
+	}
+
+	res := new(model.WorkflowTemplateResponse)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/workflow-templates/%d", templateID), nil, res)
+
+	return res.Data, resp, err
+}
+
+// ListSteps returns a list of workflow steps in the project.
+//
+// https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.workflow-steps.getMany
+func (s *WorkflowsService) ListSteps(ctx context.Context, projectID int, opts *model.ListOptions) (
+	[]*model.WorkflowStep, *Response, error,
+) {
+	ctx = WithOperationName(ctx, "Workflows.ListSteps")
+	if !s.client.isEnterprise() {
+		return nil, nil, model.ErrEnterpriseOnly
+	}
+
+	res := new(model.WorkflowStepsListResponse)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/workflow-steps", projectID), opts, res)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	list := make([]*model.WorkflowStep, 0, len(res.Data))
+	for _, step := range res.Data {
+		list = append(list, step.Data)
+	}
+
+	return list, resp, err
+}
+
+// AllSteps returns an iterator over all workflow steps in the project.
+// It fetches the pages with ListSteps until a page shorter than the limit is returned.
+func (s *WorkflowsService) AllSteps(
+	ctx context.Context, projectID int, opts *model.ListOptions,
+) iter.Seq2[*model.WorkflowStep, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, *o, func(ctx context.Context, page model.ListOptions) ([]*model.WorkflowStep, *Response, error) {
+		return s.ListSteps(ctx, projectID, &page)
+	})
+}
+
+// ListAllSteps returns all workflow steps in the project by fetching every page with ListSteps.
+func (s *WorkflowsService) ListAllSteps(ctx context.Context, projectID int, opts *model.ListOptions) (
+	[]*model.WorkflowStep, error,
+) {
+	return Collect(s.AllSteps(ctx, projectID, opts))
+}
+
+// GetStep returns a workflow step of the project by its identifier.
+//
+// https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.workflow-steps.get
+func (s *WorkflowsService) GetStep(ctx context.Context, projectID, stepID int) (*model.WorkflowStep, *Response, error) {
+	ctx = WithOperationName(ctx, "Workflows.GetStep")
+	if !s.client.isEnterprise() {
+		return nil, nil, model.ErrEnterpriseOnly
+	}
+
+	res := new(model.WorkflowStepResponse)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/workflow-steps/%d", projectID, stepID), nil, res)
+
+	return res.Data, resp, err
+}
+
+// ListStepStrings returns a list of source strings on the workflow step.
+//
+// https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.workflow-steps.strings.getMany
+func (s *WorkflowsService) ListStepStrings(
+	ctx context.Context, projectID, stepID int, opts *model.WorkflowStepStringsListOptions,
+) ([]*model.SourceString, *Response, error) {
+	ctx = WithOperationName(ctx, "Workflows.ListStepStrings")
+	if !s.client.isEnterprise() {
+		return nil, nil, model.ErrEnterpriseOnly
+	}
+
+	res := new(model.SourceStringsListResponse)
+	path := fmt.Sprintf("/api/v2/projects/%d/workflow-steps/%d/strings", projectID, stepID)
+	resp, err := s.client.Get(ctx, path, opts, res)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	list := make([]*model.SourceString, 0, len(res.Data))
+	for _, str := range res.Data {
+		list = append(list, str.Data)
+	}
+
+	return list, resp, err
+}
+
+// AllStepStrings returns an iterator over all source strings on the workflow step.
+// It fetches the pages with ListStepStrings until a page shorter than the limit is returned.
+func (s *WorkflowsService) AllStepStrings(
+	ctx context.Context, projectID, stepID int, opts *model.WorkflowStepStringsListOptions,
+) iter.Seq2[*model.SourceString, error] {
+	o := cloneOptions(opts)
+	return Paginate(ctx, o.ListOptions, func(ctx context.Context, page model.ListOptions) (
+		[]*model.SourceString, *Response, error,
+	) {
+		pageOpts := *o
+		pageOpts.ListOptions = page
+		return s.ListStepStrings(ctx, projectID, stepID, &pageOpts)
+	})
+}
+
+// ListAllStepStrings returns all source strings on the workflow step
+// by fetching every page with ListStepStrings.
+func (s *WorkflowsService) ListAllStepStrings(
+	ctx context.Context, projectID, stepID int, opts *model.WorkflowStepStringsListOptions,
+) ([]*model.SourceString, error) {
+	return Collect(s.AllStepStrings(ctx, projectID, stepID, opts))
+}
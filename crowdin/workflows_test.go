@@ -0,0 +1,291 @@
+package crowdin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/chenshone/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const workflowTemplateJSON = `{
+	"data": {
+		"id": 10,
+		"title": "In-house + MT",
+		"description": "Machine translation with in-house proofreading",
+		"groupId": 2,
+		"isDefault": false,
+		"webUrl": "https://acme.crowdin.com/u/workflows/10",
+		"steps": [
+			{
+				"id": 313,
+				"languages": ["uk", "de"],
+				"assignees": [12],
+				"config": {
+					"assignees": {"uk": [12]}
+				}
+			},
+			{
+				"id": 314,
+				"languages": ["uk", "de"],
+				"assignees": [],
+				"mtId": 3
+			}
+		]
+	}
+}`
+
+func TestWorkflowsService_ListTemplates(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     *model.WorkflowTemplatesListOptions
+		expected string
+	}{
+		{
+			name:     "nil options",
+			opts:     nil,
+			expected: "",
+		},
+		{
+			name: "with options",
+			opts: &model.WorkflowTemplatesListOptions{
+				GroupID:     2,
+				ListOptions: model.ListOptions{Offset: 10, Limit: 10},
+			},
+			expected: "?groupId=2&limit=10&offset=10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux, teardown := setupClient()
+			defer teardown()
+
+			const path = "/api/v2/workflow-templates"
+			mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testURL(t, r, path+tt.expected)
+
+				fmt.Fprintf(w, `{"data": [%s], "pagination": {"offset": 10, "limit": 10}}`, workflowTemplateJSON)
+			})
+
+			templates, resp, err := client.Workflows.ListTemplates(context.Background(), tt.opts)
+			require.NoError(t, err)
+			assert.Equal(t, 10, resp.Pagination.Limit)
+
+			require.Len(t, templates, 1)
+			assert.Equal(t, 10, templates[0].ID)
+		})
+	}
+}
+
+func TestWorkflowsService_GetTemplate(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/workflow-templates/10"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, path)
+
+		fmt.Fprint(w, workflowTemplateJSON)
+	})
+
+	template, resp, err := client.Workflows.GetTemplate(context.Background(), 10)
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	expected := &model.WorkflowTemplate{
+		ID:          10,
+		Title:       "In-house + MT",
+		Description: "Machine translation with in-house proofreading",
+		GroupID:     ToPtr(2),
+		WebURL:      "https://acme.crowdin.com/u/workflows/10",
+		Steps: []*model.WorkflowTemplateStep{
+			{
+				ID:        313,
+				Languages: []string{"uk", "de"},
+				Assignees: []int{12},
+				Config: &model.WorkflowStepConfig{
+					Assignees: map[string][]int{"uk": {12}},
+				},
+			},
+			{
+				ID:        314,
+				Languages: []string{"uk", "de"},
+				Assignees: []int{},
+				MTID:      ToPtr(3),
+			},
+		},
+	}
+	assert.Equal(t, expected, template)
+}
+
+func TestWorkflowsService_ListSteps(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/projects/1/workflow-steps"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, path)
+
+		fmt.Fprint(w, `{
+			"data": [
+				{
+					"data": {
+						"id": 313,
+						"title": "Translation",
+						"type": "Translate",
+						"languages": ["uk", "de"],
+						"config": {"assignees": {"uk": [12]}}
+					}
+				},
+				{
+					"data": {
+						"id": 314,
+						"title": "Machine translation",
+						"type": "MTPreTranslate",
+						"languages": ["uk", "de"]
+					}
+				}
+			],
+			"pagination": {"offset": 0, "limit": 25}
+		}`)
+	})
+
+	steps, resp, err := client.Workflows.ListSteps(context.Background(), 1, nil)
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	expected := []*model.WorkflowStep{
+		{
+			ID:        313,
+			Title:     "Translation",
+			Type:      model.WorkflowStepTypeTranslate,
+			Languages: []string{"uk", "de"},
+			Config:    &model.WorkflowStepConfig{Assignees: map[string][]int{"uk": {12}}},
+		},
+		{
+			ID:        314,
+			Title:     "Machine translation",
+			Type:      model.WorkflowStepTypeMTPreTranslate,
+			Languages: []string{"uk", "de"},
+		},
+	}
+	assert.Equal(t, expected, steps)
+}
+
+func TestWorkflowsService_GetStep(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/projects/1/workflow-steps/315"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, path)
+
+		fmt.Fprint(w, `{
+			"data": {
+				"id": 315,
+				"title": "Proofreading",
+				"type": "Proofread",
+				"languages": ["uk"]
+			}
+		}`)
+	})
+
+	step, resp, err := client.Workflows.GetStep(context.Background(), 1, 315)
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	assert.Equal(t, &model.WorkflowStep{
+		ID:        315,
+		Title:     "Proofreading",
+		Type:      model.WorkflowStepTypeProofread,
+		Languages: []string{"uk"},
+	}, step)
+}
+
+func TestWorkflowsService_ListStepStrings(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const path = "/api/v2/projects/1/workflow-steps/313/strings"
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, path+"?languageIds=uk%2Cde&limit=25&orderBy=createdAt+desc&status=todo")
+
+		fmt.Fprint(w, `{
+			"data": [
+				{
+					"data": {
+						"id": 2814,
+						"projectId": 1,
+						"fileId": 48,
+						"identifier": "hello",
+						"text": "Hello",
+						"type": "text",
+						"context": "",
+						"maxLength": 0,
+						"labelIds": [],
+						"createdAt": "2023-09-20T12:43:57+00:00"
+					}
+				}
+			],
+			"pagination": {"offset": 0, "limit": 25}
+		}`)
+	})
+
+	opts := &model.WorkflowStepStringsListOptions{
+		LanguageIDs: []string{"uk", "de"},
+		OrderBy:     "createdAt desc",
+		Status:      model.WorkflowStepStringStatusTodo,
+		ListOptions: model.ListOptions{Limit: 25},
+	}
+	strings, resp, err := client.Workflows.ListStepStrings(context.Background(), 1, 313, opts)
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	expected := []*model.SourceString{
+		{
+			ID:         2814,
+			ProjectID:  1,
+			FileID:     ToPtr(48),
+			Identifier: "hello",
+			Text:       "Hello",
+			Type:       "text",
+			LabelIDs:   []int{},
+			CreatedAt:  ToPtr(testTime("2023-09-20T12:43:57+00:00")),
+		},
+	}
+	assert.Equal(t, expected, strings)
+}
+
+func TestWorkflowsService_NotEnterprise(t *testing.T) {
+	client, mux, teardown := setupClient(WithOrganization(""))
+	defer teardown()
+
+	mux.HandleFunc("/", func(http.ResponseWriter, *http.Request) {
+		t.Error("request sent by a non-Enterprise client")
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Workflows.ListTemplates(ctx, nil)
+	require.ErrorIs(t, err, model.ErrEnterpriseOnly)
+
+	_, _, err = client.Workflows.GetTemplate(ctx, 10)
+	require.ErrorIs(t, err, model.ErrEnterpriseOnly)
+
+	_, err = client.Workflows.ListAllSteps(ctx, 1, nil)
+	require.ErrorIs(t, err, model.ErrEnterpriseOnly)
+
+	_, _, err = client.Workflows.GetStep(ctx, 1, 313)
+	require.ErrorIs(t, err, model.ErrEnterpriseOnly)
+
+	_, err = client.Workflows.ListAllStepStrings(ctx, 1, 313, nil)
+	require.ErrorIs(t, err, model.ErrEnterpriseOnly)
+}